@@ -1,8 +1,12 @@
 package main
 
 import (
+	"net"
 	"net/http"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -22,25 +26,181 @@ func main() {
 	// Set log output to stdout for containerized environments
 	logger.SetOutput(os.Stdout)
 
+	// Truncate wallet addresses and round GPS coordinates in log output
+	if getEnvBool("LOG_REDACT_PII", false) {
+		logger.AddHook(services.NewPIIRedactionHook())
+	}
+
 	logger.Info("Starting OYAH Backend server initialization")
 
 	// Initialize services
 	storageService := services.NewStorageService()
+	storageService.SetDuplicateWindow(getEnvDuration("SUBMISSION_DUPLICATE_WINDOW", services.DefaultDuplicateWindow))
+	storageService.SetSubmissionHistoryLimit(getEnvInt("SUBMISSION_HISTORY_LIMIT", services.DefaultSubmissionHistoryLimit))
 	validationService := services.NewValidationService(storageService)
 	consensusService := services.NewConsensusService(storageService, logger)
 	consensusRecoveryService := services.NewConsensusRecoveryService(storageService, consensusService, logger)
 	tallyService := services.NewTallyService(storageService, logger)
+	if err := tallyService.SetPercentageBasis(os.Getenv("TALLY_PERCENTAGE_BASIS")); err != nil {
+		logger.WithError(err).Fatal("Invalid TALLY_PERCENTAGE_BASIS")
+	}
 	webSocketService := services.NewWebSocketService(tallyService, logger)
 	errorHandler := services.NewErrorHandler(logger)
+	retentionService := services.NewRetentionService(storageService, logger)
+	walletActivityService := services.NewWalletActivityService(storageService, logger)
+	auditService := services.NewAuditService(logger)
 
 	// Wire WebSocket service with consensus service for real-time updates
 	consensusService.SetWebSocketService(webSocketService)
 
+	// Optionally group near-identical results to absorb small counting and OCR errors
+	consensusService.SetMinAgreeingWallets(getEnvInt("CONSENSUS_MIN_AGREEING_WALLETS", 0))
+	consensusService.SetTolerance(getEnvInt("CONSENSUS_TOLERANCE_ABSOLUTE", 0), getEnvFloat("CONSENSUS_TOLERANCE_PERCENT", 0))
+	consensusService.SetClusterRadius(getEnvFloat("CONSENSUS_CLUSTER_RADIUS_METERS", services.DefaultClusterRadiusMeters))
+	consensusService.SetStabilityWindow(getEnvDuration("CONSENSUS_STABILITY_WINDOW", 0))
+	consensusService.SetConfidenceTieBreak(getEnvBool("CONSENSUS_CONFIDENCE_TIE_BREAK", false))
+	consensusService.SetThresholdScaling(getEnvFloat("CONSENSUS_THRESHOLD_SCALING", 0))
+	if err := consensusService.SetPostVerificationPolicy(os.Getenv("CONSENSUS_POST_VERIFICATION_POLICY")); err != nil {
+		logger.WithError(err).Fatal("Invalid CONSENSUS_POST_VERIFICATION_POLICY")
+	}
+	consensusService.SetConfidencePrecision(getEnvInt("CONSENSUS_CONFIDENCE_PRECISION", services.DefaultConfidencePrecision))
+
+	// Notify voting process webhooks when stations are verified
+	webhookService := services.NewWebhookService(logger)
+	webhookService.SetSecret(os.Getenv("WEBHOOK_SECRET"))
+	webhookService.SetTimeout(getEnvDuration("WEBHOOK_TIMEOUT", 5*time.Second))
+	webhookService.SetRetryPolicy(getEnvInt("WEBHOOK_MAX_RETRIES", services.DefaultNotifyMaxAttempts-1), getEnvDuration("WEBHOOK_RETRY_DELAY", services.DefaultNotifyInitialBackoff))
+	webhookService.SetRetryBackoff(getEnvDuration("WEBHOOK_RETRY_MAX_DELAY", services.DefaultNotifyMaxBackoff), getEnvFloat("WEBHOOK_RETRY_JITTER", services.DefaultNotifyJitter))
+	consensusService.SetWebhookService(webhookService)
+
+	// Append every consensus decision to a dedicated audit log for post-election reviews
+	auditLogPath := os.Getenv("CONSENSUS_AUDIT_LOG")
+	switch auditLogPath {
+	case "":
+	case "stdout":
+		consensusService.SetAuditLogger(services.NewConsensusAuditLogger(os.Stdout, logger))
+	case "stderr":
+		consensusService.SetAuditLogger(services.NewConsensusAuditLogger(os.Stderr, logger))
+	default:
+		auditLogFile, err := os.OpenFile(auditLogPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0640)
+		if err != nil {
+			logger.WithError(err).WithField("path", auditLogPath).Fatal("Failed to open CONSENSUS_AUDIT_LOG")
+		}
+		defer auditLogFile.Close()
+		consensusService.SetAuditLogger(services.NewConsensusAuditLogger(auditLogFile, logger))
+		logger.WithField("path", auditLogPath).Info("Consensus audit logging enabled")
+	}
+
+	// Register service metrics
+	metricsRegistry := services.NewMetricsRegistry()
+	metricsRegistry.Register(consensusService.Metrics()...)
+	metricsRegistry.RegisterVec(validationService.Metrics())
+
+	// Wire consensus service with tally service for provisional tallies
+	tallyService.SetConsensusService(consensusService)
+
+	// Compact raw submissions of completed voting processes after the retention delay
+	retentionService.SetRetentionDelay(getEnvDuration("SUBMISSION_RETENTION_DELAY", 24*time.Hour))
+	retentionService.Start(getEnvDuration("SUBMISSION_RETENTION_SWEEP_INTERVAL", time.Hour))
+
+	// Start voting processes automatically at their scheduled start time
+	schedulerService := services.NewSchedulerService(storageService, logger)
+	schedulerService.Start(getEnvDuration("VOTING_SCHEDULER_INTERVAL", 5*time.Second))
+
+	// Flag wallets that submit to more polling stations than expected
+	walletMaxStations := getEnvInt("WALLET_MAX_STATIONS", 3)
+	walletActivityService.SetMaxStationsPerWallet(walletMaxStations)
+	walletMinStationInterval := getEnvDuration("WALLET_MIN_STATION_INTERVAL", services.DefaultMinStationInterval)
+	walletActivityService.SetMinStationInterval(walletMinStationInterval)
+
 	// Initialize handlers
 	submissionHandler := handlers.NewSubmissionHandler(storageService, validationService, consensusService, consensusRecoveryService, errorHandler, logger)
+
+	// Optionally derive submission IDs from content so client retries share an ID
+	submissionIDGenerator := services.NewSubmissionIDGenerator()
+	submissionIDGenerator.SetDeterministic(getEnvBool("DETERMINISTIC_SUBMISSION_IDS", false))
+	submissionHandler.SetIDGenerator(submissionIDGenerator)
+	submissionHandler.SetConsensusRetryAfter(getEnvDuration("CONSENSUS_RETRY_AFTER", services.DefaultConsensusRetryAfter))
+
+	// Admin-toggled switch that closes submissions during an emergency freeze
+	maintenanceMode := services.NewMaintenanceMode()
+	submissionHandler.SetMaintenanceMode(maintenanceMode)
+
+	// Optionally process consensus on a bounded worker pool instead of the request goroutine
+	consensusWorkers := getEnvInt("CONSENSUS_WORKERS", 0)
+	consensusQueueSize := getEnvInt("CONSENSUS_QUEUE_SIZE", 1000)
+	if consensusWorkers > 0 {
+		consensusPool := services.NewConsensusWorkerPool(consensusService, consensusRecoveryService, logger, consensusWorkers, consensusQueueSize)
+		consensusPool.Start()
+		submissionHandler.SetConsensusPool(consensusPool)
+	}
+
+	// Optionally bound in-flight submissions, answering 503 with Retry-After when nearly full
+	intakeCapacity := getEnvInt("SUBMISSION_INTAKE_CAPACITY", 0)
+	if intakeCapacity > 0 {
+		submissionIntake := services.NewSubmissionIntake(intakeCapacity, getEnvDuration("SUBMISSION_INTAKE_RETRY_AFTER", services.DefaultIntakeRetryAfter))
+		metricsRegistry.Register(submissionIntake.Metrics()...)
+		submissionHandler.SetIntake(submissionIntake)
+	}
+
+	// Optionally warn when a submission's client IP resolves far from its GPS coordinates
+	if geolocationURL := os.Getenv("IP_GEOLOCATION_URL"); geolocationURL != "" {
+		geolocationProvider := services.NewHTTPGeolocationProvider(geolocationURL, getEnvDuration("IP_GEOLOCATION_TIMEOUT", 2*time.Second))
+		submissionHandler.SetGeolocationChecker(services.NewIPGeolocationChecker(geolocationProvider, getEnvFloat("IP_GEOLOCATION_MAX_DISTANCE_KM", services.DefaultGeolocationMaxDistanceKm)))
+	}
+
+	// Optionally check submitted evidence hashes against the evidence they reference
+	evidenceVerification := getEnvBool("EVIDENCE_VERIFICATION", false)
+	evidenceMaxBytes := 0
+	if evidenceVerification {
+		evidenceMaxBytes = getEnvInt("EVIDENCE_MAX_BYTES", services.DefaultEvidenceMaxBytes)
+		evidenceFetcher := services.NewHTTPEvidenceFetcher(getEnvDuration("EVIDENCE_FETCH_TIMEOUT", 10*time.Second), int64(evidenceMaxBytes))
+		submissionHandler.SetEvidenceVerifier(services.NewEvidenceVerifier(evidenceFetcher))
+	}
+
 	votingProcessHandler := handlers.NewVotingProcessHandler(storageService, logger)
+	minCandidates := getEnvInt("MIN_CANDIDATES", 2)
+	votingProcessHandler.SetMinimumCandidates(minCandidates)
+	votingProcessHandler.SetAuditService(auditService)
+	votingProcessHandler.SetConsensusService(consensusService)
+	if pattern, ok := os.LookupEnv("CANDIDATE_ID_PATTERN"); ok {
+		if err := votingProcessHandler.SetCandidateIDPattern(pattern); err != nil {
+			logger.WithError(err).Fatal("Invalid CANDIDATE_ID_PATTERN")
+		}
+	}
 	tallyHandler := handlers.NewTallyHandler(tallyService, errorHandler, logger)
+	electionPackageService := services.NewElectionPackageService(storageService, tallyService, logger)
+	electionPackageService.SetAuditService(auditService)
+	tallyHandler.SetElectionPackageService(electionPackageService)
 	webSocketHandler := handlers.NewWebSocketHandler(webSocketService, logger)
+	metricsHandler := handlers.NewMetricsHandler(metricsRegistry, logger)
+	pollingStationHandler := handlers.NewPollingStationHandler(storageService, tallyService, errorHandler, logger)
+
+	// Optionally replace wallet addresses with salted hashes in public responses (admin endpoints keep full addresses)
+	walletAnonymizer := services.NewWalletAnonymizer(os.Getenv("WALLET_HASH_SALT"))
+	walletAnonymizer.SetEnabled(getEnvBool("ANONYMIZE_WALLETS", false))
+	if walletAnonymizer.IsEnabled() && os.Getenv("WALLET_HASH_SALT") == "" {
+		logger.Warn("Wallet anonymization enabled without WALLET_HASH_SALT - identifiers can be reversed by hashing known addresses")
+	}
+	pollingStationHandler.SetWalletAnonymizer(walletAnonymizer)
+	pollingStationHandler.SetConsensusService(consensusService)
+	adminHandler := handlers.NewAdminHandler(storageService, retentionService, walletActivityService, errorHandler, logger)
+	adminCredentials, err := middleware.ParseAdminCredentials(os.Getenv("ADMIN_API_TOKEN"), os.Getenv("ADMIN_OPERATOR_TOKENS"))
+	if err != nil {
+		logger.WithError(err).Fatal("Invalid ADMIN_OPERATOR_TOKENS")
+	}
+	if len(adminCredentials) == 0 {
+		logger.Warn("No ADMIN_API_TOKEN or ADMIN_OPERATOR_TOKENS configured - admin endpoints deny every request, while creating and starting voting processes is open to anyone")
+	}
+	adminHandler.SetAuditService(auditService)
+	adminHandler.SetConsensusRecoveryService(consensusRecoveryService)
+	adminHandler.SetConsensusService(consensusService)
+	adminHandler.SetMaintenanceMode(maintenanceMode)
+	seedEnabled := getEnvBool("ENABLE_SEED_ENDPOINT", false)
+	if seedEnabled {
+		logger.Warn("Seed endpoint enabled - never enable it in production")
+		adminHandler.SetSeedService(services.NewSeedService(storageService, consensusService, logger))
+	}
 
 	// Create Gin router
 	r := gin.New()
@@ -70,22 +230,65 @@ func main() {
 		})
 	})
 
+	// Metrics endpoint for Prometheus scraping
+	r.GET("/metrics", metricsHandler.GetMetrics)
+
 	// WebSocket endpoint (outside of API versioning)
-	r.GET("/ws", webSocketHandler.HandleWebSocket)
+	// The WebSocket connection is long-lived, so it is exempt from the server write timeout
+	r.GET("/ws", middleware.DisableWriteTimeout(), webSocketHandler.HandleWebSocket)
+	// Server-sent events are long-lived too, and are registered outside /api/v1 so the response envelope never buffers them
+	r.GET("/api/v1/voting-process/:id/events", middleware.DisableWriteTimeout(), webSocketHandler.StreamTallyEvents)
 
 	// API v1 routes group
 	v1 := r.Group("/api/v1")
+	// Wrap successful responses as {success, data, timestamp}; off by default to keep existing response shapes
+	responseEnvelope := getEnvBool("RESPONSE_ENVELOPE", false)
+	if responseEnvelope {
+		v1.Use(middleware.ResponseEnvelopeMiddleware())
+	}
+	// Translate error messages for clients whose Accept-Language prefers a configured locale; codes never change
+	if locales := os.Getenv("ERROR_MESSAGE_LOCALES"); locales != "" {
+		translator := services.NewErrorTranslator(strings.Split(locales, ","))
+		logger.WithField("locales", translator.Locales()).Info("Error message localization enabled")
+		v1.Use(middleware.ErrorLocalizationMiddleware(translator))
+	}
 	{
 		// Submission endpoints
 		v1.POST("/submitResult", submissionHandler.SubmitResult)
+		v1.POST("/submitResult/compact", submissionHandler.SubmitCompactResult)
+		v1.GET("/submission/:id/ack", submissionHandler.GetSubmissionAck)
+		v1.GET("/submissions/diff", submissionHandler.DiffSubmissions)
 		
 		// Voting process management endpoints
-		v1.POST("/voting-process", votingProcessHandler.CreateVotingProcess)
-		v1.PUT("/voting-process/:id/start", votingProcessHandler.StartVotingProcess)
 		v1.GET("/voting-process/:id", votingProcessHandler.GetVotingProcess)
+		v1.GET("/voting-process/:id/candidates", votingProcessHandler.GetCandidates)
+		v1.GET("/voting-process/:id/timeline", votingProcessHandler.GetVotingProcessTimeline)
+		v1.GET("/voting-process/:id/submission-counts", votingProcessHandler.GetSubmissionCounts)
+		v1.GET("/voting-process/:id/stations", votingProcessHandler.GetStationsByConfidence)
+		v1.GET("/voting-process/:id/progress", tallyHandler.GetProgress)
+		v1.GET("/voting-process/:id/trend", tallyHandler.GetTrend)
+		v1.GET("/voting-process/:id/projection", tallyHandler.GetProjection)
+		v1.GET("/voting-process/:id/confidence-distribution", tallyHandler.GetConfidenceDistribution)
+		v1.GET("/voting-process/:id/package", tallyHandler.GetElectionPackage)
 		
 		// Tally endpoints
 		v1.GET("/getTally/:votingProcessId", tallyHandler.GetTally)
+		v1.POST("/getTally/batch", tallyHandler.GetTallyBatch)
+		
+		// Polling station endpoints
+		v1.GET("/polling-station/:id/submissions", pollingStationHandler.GetStationSubmissions)
+		v1.GET("/polling-station/:id/sheet", pollingStationHandler.GetStationSheet)
+		v1.GET("/polling-station/:id/witnesses", pollingStationHandler.GetStationWitnesses)
+		v1.GET("/polling-station/:id/type-breakdown", pollingStationHandler.GetStationTypeBreakdown)
+		v1.GET("/polling-station/:id/result", pollingStationHandler.GetStationResult)
+		v1.GET("/polling-station/:id/status", pollingStationHandler.GetStationStatus)
+		v1.GET("/polling-station/:id/consensus", pollingStationHandler.GetStationConsensus)
+		v1.GET("/polling-station/:id/verify-conditions", pollingStationHandler.GetVerifyConditions)
+		v1.GET("/polling-station/:id/adjudication", middleware.RequireAdminCredentials(adminCredentials), pollingStationHandler.GetAdjudication)
+		v1.POST("/polling-station/:id/challenge", pollingStationHandler.ChallengeStation)
+		
+		// Admin endpoints
+		registerAdminRoutes(v1, adminCredentials, adminHandler, votingProcessHandler, seedEnabled)
 		
 		// WebSocket stats endpoint
 		v1.GET("/websocket/stats", webSocketHandler.GetWebSocketStats)
@@ -97,8 +300,132 @@ func main() {
 		port = "8080"
 	}
 
-	logger.WithField("port", port).Info("Starting OYAH Backend server")
-	if err := r.Run(":" + port); err != nil {
+	timeouts := loadServerTimeouts()
+	server := newHTTPServer(":"+port, r, timeouts)
+
+	tlsSettings := loadTLSSettings()
+
+	// Report what was actually loaded on the config endpoint, never the secrets themselves
+	adminHandler.SetEffectiveConfig(services.EffectiveConfig{
+		Server: services.ServerConfig{
+			Port:              port,
+			ReadTimeout:       timeouts.ReadTimeout.String(),
+			WriteTimeout:      timeouts.WriteTimeout.String(),
+			IdleTimeout:       timeouts.IdleTimeout.String(),
+			ReadHeaderTimeout: timeouts.ReadHeaderTimeout.String(),
+			TLSEnabled:        tlsSettings.Enabled(),
+			TLSRedirectPort:   tlsSettings.RedirectPort,
+		},
+		CORS: services.CORSConfig{
+			AllowedOrigins: corsConfig.AllowOrigins,
+			AllowedMethods: corsConfig.AllowMethods,
+			AllowedHeaders: corsConfig.AllowHeaders,
+		},
+		Consensus: services.ConsensusConfig{
+			Defaults:  consensusService.VerificationCriteria(nil),
+			Workers:   consensusWorkers,
+			QueueSize: consensusQueueSize,
+			AuditLog:  auditLogPath,
+		},
+		Limits: services.LimitsConfig{
+			MinCandidates:             minCandidates,
+			WalletMaxStations:         walletMaxStations,
+			WalletMinStationInterval:  walletMinStationInterval.String(),
+			SubmissionIntakeCapacity:  intakeCapacity,
+			SubmissionDuplicateWindow: storageService.DuplicateWindow().String(),
+			SubmissionHistoryLimit:    storageService.SubmissionHistoryLimit(),
+			EvidenceMaxBytes:          evidenceMaxBytes,
+		},
+		Features: map[string]bool{
+			"responseEnvelope":           responseEnvelope,
+			"errorMessageLocalization":   os.Getenv("ERROR_MESSAGE_LOCALES") != "",
+			"evidenceVerification":       evidenceVerification,
+			"walletAnonymization":        walletAnonymizer.IsEnabled(),
+			"deterministicSubmissionIds": getEnvBool("DETERMINISTIC_SUBMISSION_IDS", false),
+			"seedEndpoint":               seedEnabled,
+		},
+		Secrets: services.RedactedSecrets(services.SecretSettings...),
+	})
+	if tlsSettings.Enabled() && tlsSettings.RedirectPort != "" {
+		redirectServer := newHTTPServer(":"+tlsSettings.RedirectPort, newHTTPSRedirectHandler(port), timeouts)
+		go func() {
+			logger.WithField("port", tlsSettings.RedirectPort).Info("Starting HTTP to HTTPS redirect server")
+			if err := redirectServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				logger.WithError(err).Error("HTTP to HTTPS redirect server stopped")
+			}
+		}()
+	}
+
+	listener, err := net.Listen("tcp", server.Addr)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to listen")
+	}
+
+	logger.WithFields(logrus.Fields{
+		"port":                port,
+		"tls":                 tlsSettings.Enabled(),
+		"read_timeout":        timeouts.ReadTimeout.String(),
+		"write_timeout":       timeouts.WriteTimeout.String(),
+		"idle_timeout":        timeouts.IdleTimeout.String(),
+		"read_header_timeout": timeouts.ReadHeaderTimeout.String(),
+	}).Info("Starting OYAH Backend server")
+	if err := serve(server, listener, tlsSettings); err != nil && err != http.ErrServerClosed {
 		logger.WithError(err).Fatal("Failed to start server")
 	}
-}
\ No newline at end of file
+}
+
+// getEnvDuration reads a duration (e.g. "30s", "24h") from the environment, falling back to a default
+func getEnvDuration(key string, fallback time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return fallback
+	}
+	return duration
+}
+
+// getEnvInt reads an integer from the environment, falling back to a default
+func getEnvInt(key string, fallback int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return fallback
+	}
+	return number
+}
+
+// getEnvFloat reads a floating point number from the environment, falling back to a default
+func getEnvFloat(key string, fallback float64) float64 {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return fallback
+	}
+	return number
+}
+
+// getEnvBool reads a boolean (e.g. "true", "1") from the environment, falling back to a default
+func getEnvBool(key string, fallback bool) bool {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+
+	enabled, err := strconv.ParseBool(value)
+	if err != nil {
+		return fallback
+	}
+	return enabled
+}
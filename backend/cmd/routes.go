@@ -0,0 +1,42 @@
+package main
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"oyah-backend/internal/handlers"
+	"oyah-backend/internal/middleware"
+)
+
+// registerAdminRoutes registers the administrative endpoints behind the admin credentials, so only callers holding an
+// admin token can change server state or read admin-only data, and audited actions record the authenticated actor.
+// Creating and starting a voting process were public before admin tokens existed, so they stay open while no
+// credentials are configured. The seed endpoint is only registered when seedEnabled is set.
+func registerAdminRoutes(v1 *gin.RouterGroup, credentials middleware.AdminCredentials, adminHandler *handlers.AdminHandler, votingProcessHandler *handlers.VotingProcessHandler, seedEnabled bool) {
+	requireAdmin := middleware.RequireAdminCredentials(credentials)
+	requireAdminIfConfigured := middleware.RequireAdminCredentialsIfConfigured(credentials)
+
+	// Voting process management
+	v1.POST("/voting-process", requireAdminIfConfigured, votingProcessHandler.CreateVotingProcess)
+	v1.PUT("/voting-process/:id/start", requireAdminIfConfigured, votingProcessHandler.StartVotingProcess)
+	v1.POST("/voting-process/:id/stations", requireAdmin, votingProcessHandler.AddPollingStations)
+
+	v1.POST("/polling-station/:id/mark-empty", requireAdmin, adminHandler.MarkStationEmpty)
+	v1.POST("/polling-station/:id/challenge/resolve", requireAdmin, adminHandler.ResolveChallenge)
+
+	admin := v1.Group("/admin", requireAdmin)
+	{
+		admin.POST("/voting-process/:id/compact", adminHandler.CompactVotingProcess)
+		admin.POST("/voting-process/:id/repair", adminHandler.RepairVotingProcess)
+		admin.POST("/submission/:id/flag", adminHandler.FlagSubmission)
+		admin.DELETE("/submission/:id", adminHandler.RemoveSubmission)
+		admin.GET("/wallet/:address/activity", adminHandler.GetWalletActivity)
+		admin.GET("/audit", adminHandler.GetAuditLog)
+		admin.GET("/config", adminHandler.GetConfig)
+		admin.POST("/maintenance", adminHandler.UpdateMaintenanceMode)
+
+		// Synthetic load test data, only in test and development deployments
+		if seedEnabled {
+			admin.POST("/seed", adminHandler.Seed)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oyah-backend/internal/handlers"
+	"oyah-backend/internal/middleware"
+	"oyah-backend/internal/services"
+)
+
+const (
+	testAdminToken    = "test-admin-token"
+	testOperatorToken = "test-operator-token"
+)
+
+var testAdminCredentials = middleware.AdminCredentials{testAdminToken: middleware.AdminActor, testOperatorToken: "alice"}
+
+func setupAdminRoutesTestRouter(credentials middleware.AdminCredentials) (*gin.Engine, *services.AuditService) {
+	gin.SetMode(gin.TestMode)
+
+	storage := services.NewStorageService()
+	logger := logrus.New()
+	logger.SetLevel(logrus.PanicLevel)
+	auditService := services.NewAuditService(logger)
+
+	adminHandler := handlers.NewAdminHandler(storage, services.NewRetentionService(storage, logger), services.NewWalletActivityService(storage, logger), services.NewErrorHandler(logger), logger)
+	adminHandler.SetMaintenanceMode(services.NewMaintenanceMode())
+	adminHandler.SetAuditService(auditService)
+	votingProcessHandler := handlers.NewVotingProcessHandler(storage, logger)
+	votingProcessHandler.SetAuditService(auditService)
+
+	router := gin.New()
+	registerAdminRoutes(router.Group("/api/v1"), credentials, adminHandler, votingProcessHandler, true)
+	return router, auditService
+}
+
+func TestRegisterAdminRoutes_RequireAdminToken(t *testing.T) {
+	router, _ := setupAdminRoutesTestRouter(testAdminCredentials)
+
+	routes := []struct {
+		method string
+		path   string
+		body   string
+	}{
+		{"POST", "/api/v1/voting-process", `{"title": "Election"}`},
+		{"PUT", "/api/v1/voting-process/vp-1/start", ""},
+		{"POST", "/api/v1/voting-process/vp-1/stations", `{"pollingStations": ["STATION_002"]}`},
+		{"POST", "/api/v1/polling-station/STATION_001/mark-empty", ""},
+		{"POST", "/api/v1/polling-station/STATION_001/challenge/resolve", `{"resolution": "recount confirmed the result"}`},
+		{"POST", "/api/v1/admin/voting-process/vp-1/compact", ""},
+		{"POST", "/api/v1/admin/voting-process/vp-1/repair", ""},
+		{"POST", "/api/v1/admin/submission/sub-1/flag", `{"reason": "fabricated image"}`},
+		{"DELETE", "/api/v1/admin/submission/sub-1", ""},
+		{"GET", "/api/v1/admin/wallet/5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY/activity", ""},
+		{"GET", "/api/v1/admin/audit", ""},
+		{"GET", "/api/v1/admin/config", ""},
+		{"POST", "/api/v1/admin/maintenance", `{"enabled": true}`},
+		{"POST", "/api/v1/admin/seed", `{"votingProcesses": 1}`},
+	}
+
+	for _, route := range routes {
+		// No header, a wrong token, and the right token without the Bearer scheme
+		for _, authorization := range []string{"", "Bearer wrong-token", testAdminToken} {
+			t.Run(route.method+" "+route.path+" "+authorization, func(t *testing.T) {
+				req, err := http.NewRequest(route.method, route.path, bytes.NewBufferString(route.body))
+				require.NoError(t, err)
+				req.Header.Set("Content-Type", "application/json")
+				if authorization != "" {
+					req.Header.Set("Authorization", authorization)
+				}
+
+				w := httptest.NewRecorder()
+				router.ServeHTTP(w, req)
+				assert.Equal(t, http.StatusForbidden, w.Code, "callers without the admin token are denied")
+			})
+		}
+	}
+}
+
+func TestRegisterAdminRoutes_AdminTokenToggleMaintenance(t *testing.T) {
+	router, _ := setupAdminRoutesTestRouter(testAdminCredentials)
+
+	req, err := http.NewRequest("POST", "/api/v1/admin/maintenance", bytes.NewBufferString(`{"enabled": true}`))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+testAdminToken)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+}
+
+func TestRegisterAdminRoutes_OperatorTokenRecordsOperator(t *testing.T) {
+	router, auditService := setupAdminRoutesTestRouter(testAdminCredentials)
+
+	for i, token := range []string{testOperatorToken, testAdminToken} {
+		body := fmt.Sprintf(`{"enabled": %t}`, i == 0)
+		req, err := http.NewRequest("POST", "/api/v1/admin/maintenance", bytes.NewBufferString(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	}
+
+	entries := auditService.Query(services.AuditFilter{Action: services.AuditActionSetMaintenanceMode})
+	require.Len(t, entries, 2)
+	assert.Equal(t, "alice", entries[0].Actor, "operator tokens are recorded under the operator's name")
+	assert.Equal(t, middleware.AdminActor, entries[1].Actor)
+}
+
+func TestRegisterAdminRoutes_WithoutCredentials(t *testing.T) {
+	router, auditService := setupAdminRoutesTestRouter(middleware.AdminCredentials{})
+
+	send := func(method, path, body string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(method, path, bytes.NewBufferString(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("CreateAndStartStayOpen", func(t *testing.T) {
+		w := send("POST", "/api/v1/voting-process", `{
+			"title": "Open Election",
+			"position": "Mayor",
+			"candidates": [{"id": "c1", "name": "Alice"}, {"id": "c2", "name": "Bob"}],
+			"pollingStations": ["OPEN001"]
+		}`)
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+		entries := auditService.Query(services.AuditFilter{Action: services.AuditActionCreateVotingProcess})
+		require.Len(t, entries, 1)
+		assert.Equal(t, "anonymous", entries[0].Actor)
+
+		w = send("PUT", "/api/v1/voting-process/"+entries[0].TargetID+"/start", "")
+		assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	})
+
+	t.Run("AdminRoutesDenied", func(t *testing.T) {
+		w := send("POST", "/api/v1/admin/maintenance", `{"enabled": true}`)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+
+		w = send("POST", "/api/v1/voting-process/vp-1/stations", `{"pollingStations": ["STATION_002"]}`)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
+func TestParseAdminCredentials(t *testing.T) {
+	credentials, err := middleware.ParseAdminCredentials("shared-token", " alice:token-a , bob:token-b,")
+	require.NoError(t, err)
+	assert.Equal(t, middleware.AdminCredentials{"shared-token": middleware.AdminActor, "token-a": "alice", "token-b": "bob"}, credentials)
+
+	credentials, err = middleware.ParseAdminCredentials("", "")
+	require.NoError(t, err)
+	assert.Empty(t, credentials)
+
+	for _, operatorTokens := range []string{"alice", "alice:", ":token-a", "alice:token-a,bob:token-a", "alice:shared-token"} {
+		_, err := middleware.ParseAdminCredentials("shared-token", operatorTokens)
+		assert.Error(t, err, operatorTokens)
+	}
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"net"
+	"net/http"
+	"os"
+	"time"
+)
+
+// serverTimeouts holds the timeouts applied to the HTTP server
+type serverTimeouts struct {
+	ReadTimeout       time.Duration
+	WriteTimeout      time.Duration
+	IdleTimeout       time.Duration
+	ReadHeaderTimeout time.Duration
+}
+
+// loadServerTimeouts reads the HTTP server timeouts from the environment with safe defaults
+func loadServerTimeouts() serverTimeouts {
+	return serverTimeouts{
+		ReadTimeout:       getEnvDuration("HTTP_READ_TIMEOUT", 15*time.Second),
+		WriteTimeout:      getEnvDuration("HTTP_WRITE_TIMEOUT", 30*time.Second),
+		IdleTimeout:       getEnvDuration("HTTP_IDLE_TIMEOUT", 120*time.Second),
+		ReadHeaderTimeout: getEnvDuration("HTTP_READ_HEADER_TIMEOUT", 5*time.Second),
+	}
+}
+
+// newHTTPServer creates an HTTP server for the given handler with the configured timeouts
+func newHTTPServer(addr string, handler http.Handler, timeouts serverTimeouts) *http.Server {
+	return &http.Server{
+		Addr:              addr,
+		Handler:           handler,
+		ReadTimeout:       timeouts.ReadTimeout,
+		WriteTimeout:      timeouts.WriteTimeout,
+		IdleTimeout:       timeouts.IdleTimeout,
+		ReadHeaderTimeout: timeouts.ReadHeaderTimeout,
+	}
+}
+
+// tlsSettings configures direct TLS termination for deployments that are not behind a TLS-terminating proxy
+type tlsSettings struct {
+	CertFile     string
+	KeyFile      string
+	RedirectPort string // Optional plain HTTP port redirecting every request to HTTPS
+}
+
+// loadTLSSettings reads the TLS certificate, key and HTTP redirect port from the environment
+func loadTLSSettings() tlsSettings {
+	return tlsSettings{
+		CertFile:     os.Getenv("OYAH_TLS_CERT"),
+		KeyFile:      os.Getenv("OYAH_TLS_KEY"),
+		RedirectPort: os.Getenv("OYAH_TLS_REDIRECT_PORT"),
+	}
+}
+
+// Enabled reports whether both a certificate and a key are configured
+func (t tlsSettings) Enabled() bool {
+	return t.CertFile != "" && t.KeyFile != ""
+}
+
+// serve accepts connections on the listener, over TLS when it is enabled
+func serve(server *http.Server, listener net.Listener, tls tlsSettings) error {
+	if tls.Enabled() {
+		return server.ServeTLS(listener, tls.CertFile, tls.KeyFile)
+	}
+	return server.Serve(listener)
+}
+
+// newHTTPSRedirectHandler redirects plain HTTP requests to the same host and path on the HTTPS port.
+// 308 keeps the method and body, so redirected POST submissions are not turned into GETs.
+func newHTTPSRedirectHandler(httpsPort string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host := r.Host
+		if h, _, err := net.SplitHostPort(r.Host); err == nil {
+			host = h
+		}
+		if httpsPort != "443" {
+			host = net.JoinHostPort(host, httpsPort)
+		}
+		http.Redirect(w, r, "https://"+host+r.URL.RequestURI(), http.StatusPermanentRedirect)
+	})
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadServerTimeouts_Defaults(t *testing.T) {
+	t.Setenv("HTTP_READ_TIMEOUT", "")
+	t.Setenv("HTTP_WRITE_TIMEOUT", "")
+	t.Setenv("HTTP_IDLE_TIMEOUT", "")
+	t.Setenv("HTTP_READ_HEADER_TIMEOUT", "")
+
+	timeouts := loadServerTimeouts()
+
+	assert.Equal(t, 15*time.Second, timeouts.ReadTimeout)
+	assert.Equal(t, 30*time.Second, timeouts.WriteTimeout)
+	assert.Equal(t, 120*time.Second, timeouts.IdleTimeout)
+	assert.Equal(t, 5*time.Second, timeouts.ReadHeaderTimeout)
+}
+
+func TestLoadServerTimeouts_FromEnvironment(t *testing.T) {
+	t.Setenv("HTTP_READ_TIMEOUT", "10s")
+	t.Setenv("HTTP_WRITE_TIMEOUT", "20s")
+	t.Setenv("HTTP_IDLE_TIMEOUT", "1m")
+	t.Setenv("HTTP_READ_HEADER_TIMEOUT", "invalid") // Falls back to the default
+
+	timeouts := loadServerTimeouts()
+
+	assert.Equal(t, 10*time.Second, timeouts.ReadTimeout)
+	assert.Equal(t, 20*time.Second, timeouts.WriteTimeout)
+	assert.Equal(t, time.Minute, timeouts.IdleTimeout)
+	assert.Equal(t, 5*time.Second, timeouts.ReadHeaderTimeout)
+}
+
+func TestNewHTTPServer(t *testing.T) {
+	handler := http.NewServeMux()
+	timeouts := serverTimeouts{
+		ReadTimeout:       11 * time.Second,
+		WriteTimeout:      22 * time.Second,
+		IdleTimeout:       33 * time.Second,
+		ReadHeaderTimeout: 4 * time.Second,
+	}
+
+	server := newHTTPServer(":9090", handler, timeouts)
+
+	assert.Equal(t, ":9090", server.Addr)
+	assert.Equal(t, handler, server.Handler)
+	assert.Equal(t, 11*time.Second, server.ReadTimeout)
+	assert.Equal(t, 22*time.Second, server.WriteTimeout)
+	assert.Equal(t, 33*time.Second, server.IdleTimeout)
+	assert.Equal(t, 4*time.Second, server.ReadHeaderTimeout)
+}
+
+// writeSelfSignedCert writes a self-signed certificate for 127.0.0.1 and its key to a temporary directory
+func writeSelfSignedCert(t *testing.T) (certFile, keyFile string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{Organization: []string{"OYAH Test"}},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	certFile = filepath.Join(dir, "cert.pem")
+	keyFile = filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+	return certFile, keyFile
+}
+
+func TestLoadTLSSettings(t *testing.T) {
+	t.Setenv("OYAH_TLS_CERT", "/etc/oyah/cert.pem")
+	t.Setenv("OYAH_TLS_KEY", "")
+	t.Setenv("OYAH_TLS_REDIRECT_PORT", "80")
+
+	settings := loadTLSSettings()
+	assert.False(t, settings.Enabled(), "TLS requires both a certificate and a key")
+	assert.Equal(t, "80", settings.RedirectPort)
+
+	t.Setenv("OYAH_TLS_KEY", "/etc/oyah/key.pem")
+	assert.True(t, loadTLSSettings().Enabled())
+}
+
+func TestServe_TLS(t *testing.T) {
+	certFile, keyFile := writeSelfSignedCert(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "ok")
+	})
+	server := newHTTPServer("127.0.0.1:0", handler, loadServerTimeouts())
+	listener, err := net.Listen("tcp", server.Addr)
+	require.NoError(t, err)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- serve(server, listener, tlsSettings{CertFile: certFile, KeyFile: keyFile})
+	}()
+	defer func() {
+		require.NoError(t, server.Close())
+		assert.ErrorIs(t, <-done, http.ErrServerClosed)
+	}()
+
+	certPEM, err := os.ReadFile(certFile)
+	require.NoError(t, err)
+	roots := x509.NewCertPool()
+	require.True(t, roots.AppendCertsFromPEM(certPEM))
+	client := &http.Client{
+		Timeout:   5 * time.Second,
+		Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: roots}},
+	}
+
+	resp, err := client.Get("https://" + listener.Addr().String() + "/health")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.NotNil(t, resp.TLS, "response should be served over TLS")
+	assert.Equal(t, "ok", string(body))
+}
+
+func TestNewHTTPSRedirectHandler(t *testing.T) {
+	tests := []struct {
+		name      string
+		httpsPort string
+		host      string
+		target    string
+		expected  string
+	}{
+		{"DefaultPort", "443", "oyah.example:80", "/api/v1/getTally/p1?x=1", "https://oyah.example/api/v1/getTally/p1?x=1"},
+		{"CustomPort", "8443", "oyah.example", "/health", "https://oyah.example:8443/health"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", test.target, nil)
+			req.Host = test.host
+			w := httptest.NewRecorder()
+
+			newHTTPSRedirectHandler(test.httpsPort).ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusPermanentRedirect, w.Code)
+			assert.Equal(t, test.expected, w.Header().Get("Location"))
+		})
+	}
+}
@@ -0,0 +1,647 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"oyah-backend/internal/models"
+	"oyah-backend/internal/services"
+)
+
+// actorContextKey is the gin context key under which an authentication middleware stores the authenticated actor
+const actorContextKey = "actor"
+
+// requestActor returns the authenticated actor of a request, or "anonymous" when no authentication middleware set one
+func requestActor(c *gin.Context) string {
+	if actor := c.GetString(actorContextKey); actor != "" {
+		return actor
+	}
+	return "anonymous"
+}
+
+// AdminHandler handles administrative HTTP requests
+type AdminHandler struct {
+	storageService        *services.StorageService
+	retentionService      *services.RetentionService
+	walletActivityService *services.WalletActivityService
+	auditService          *services.AuditService
+	recoveryService       *services.ConsensusRecoveryService
+	consensusService      *services.ConsensusService
+	seedService           *services.SeedService
+	maintenance           *services.MaintenanceMode
+	effectiveConfig       services.EffectiveConfig
+	errorHandler          *services.ErrorHandler
+	logger                *logrus.Logger
+}
+
+// NewAdminHandler creates a new admin handler
+func NewAdminHandler(storage *services.StorageService, retention *services.RetentionService, walletActivity *services.WalletActivityService, errorHandler *services.ErrorHandler, logger *logrus.Logger) *AdminHandler {
+	return &AdminHandler{
+		storageService:        storage,
+		retentionService:      retention,
+		walletActivityService: walletActivity,
+		errorHandler:          errorHandler,
+		logger:                logger,
+	}
+}
+
+// SetAuditService sets the audit log that records admin actions and backs the audit endpoint
+func (h *AdminHandler) SetAuditService(auditService *services.AuditService) {
+	h.auditService = auditService
+}
+
+// SetConsensusRecoveryService sets the recovery service that backs the data integrity repair endpoint
+func (h *AdminHandler) SetConsensusRecoveryService(recoveryService *services.ConsensusRecoveryService) {
+	h.recoveryService = recoveryService
+}
+
+// SetConsensusService sets the consensus service that recomputes a station after a submission is flagged or removed
+func (h *AdminHandler) SetConsensusService(consensusService *services.ConsensusService) {
+	h.consensusService = consensusService
+}
+
+// SetSeedService sets the service that backs the load test seed endpoint
+func (h *AdminHandler) SetSeedService(seedService *services.SeedService) {
+	h.seedService = seedService
+}
+
+// SetMaintenanceMode sets the switch that backs the maintenance mode endpoint
+func (h *AdminHandler) SetMaintenanceMode(maintenance *services.MaintenanceMode) {
+	h.maintenance = maintenance
+}
+
+// SetEffectiveConfig sets the configuration the server loaded, reported by the config endpoint
+func (h *AdminHandler) SetEffectiveConfig(config services.EffectiveConfig) {
+	h.effectiveConfig = config
+}
+
+// CompactVotingProcess handles POST /api/v1/admin/voting-process/{id}/compact requests
+func (h *AdminHandler) CompactVotingProcess(c *gin.Context) {
+	// Generate request ID for tracing
+	requestID := uuid.New().String()
+	c.Set("request_id", requestID)
+
+	// Get voting process ID from URL parameter
+	processID := c.Param("id")
+
+	// Create logger with request context
+	logger := h.logger.WithFields(logrus.Fields{
+		"request_id":        requestID,
+		"endpoint":          "compactVotingProcess",
+		"method":            c.Request.Method,
+		"client_ip":         c.ClientIP(),
+		"voting_process_id": processID,
+	})
+
+	logger.Info("Processing compact voting process request")
+
+	// Check if voting process exists
+	votingProcess, err := h.storageService.GetVotingProcess(processID)
+	if err != nil {
+		h.errorHandler.HandleNotFoundError(c, "voting process", processID)
+		return
+	}
+
+	// Only completed voting processes can be compacted
+	if votingProcess.Status != models.ProcessStatusComplete {
+		h.errorHandler.HandleError(c, services.NewAPIError(
+			services.ErrorTypeConflict,
+			"Voting process is not complete",
+			fmt.Sprintf("Voting process must be in 'Complete' status to be compacted (current status: %s)", votingProcess.Status),
+			http.StatusConflict,
+		), map[string]interface{}{"voting_process_id": processID})
+		return
+	}
+
+	compactedStations, err := h.retentionService.CompactVotingProcess(processID)
+	if err != nil {
+		h.errorHandler.HandleServiceError(c, err, "retention", "compact_voting_process")
+		return
+	}
+
+	logger.WithField("compacted_stations", compactedStations).Info("Voting process compacted successfully")
+
+	if h.auditService != nil {
+		h.auditService.Record(requestActor(c), services.AuditActionCompactVotingProcess, "voting_process", processID, map[string]interface{}{
+			"compacted_stations": compactedStations,
+		})
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":            true,
+		"voting_process_id":  processID,
+		"compacted_stations": compactedStations,
+		"message":            "Voting process submissions compacted successfully",
+	})
+}
+
+// RepairVotingProcess handles POST /api/v1/admin/voting-process/{id}/repair requests
+func (h *AdminHandler) RepairVotingProcess(c *gin.Context) {
+	// Generate request ID for tracing
+	requestID := uuid.New().String()
+	c.Set("request_id", requestID)
+
+	// Get voting process ID from URL parameter
+	processID := c.Param("id")
+
+	// Create logger with request context
+	logger := h.logger.WithFields(logrus.Fields{
+		"request_id":        requestID,
+		"endpoint":          "repairVotingProcess",
+		"method":            c.Request.Method,
+		"client_ip":         c.ClientIP(),
+		"voting_process_id": processID,
+	})
+
+	logger.Info("Processing repair voting process request")
+
+	if h.recoveryService == nil {
+		h.errorHandler.HandleServiceError(c, fmt.Errorf("consensus recovery service not configured"), "consensus_recovery", "repair_voting_process")
+		return
+	}
+
+	// Check if voting process exists
+	if _, err := h.storageService.GetVotingProcess(processID); err != nil {
+		h.errorHandler.HandleNotFoundError(c, "voting process", processID)
+		return
+	}
+
+	report, err := h.recoveryService.RepairVotingProcess(processID)
+	if err != nil {
+		h.errorHandler.HandleServiceError(c, err, "consensus_recovery", "repair_voting_process")
+		return
+	}
+
+	logger.WithFields(logrus.Fields{
+		"stations_checked":  report.StationsChecked,
+		"stations_repaired": report.StationsRepaired,
+		"issues_found":      report.IssuesFound,
+	}).Info("Voting process repaired successfully")
+
+	if h.auditService != nil {
+		h.auditService.Record(requestActor(c), services.AuditActionRepairVotingProcess, "voting_process", processID, map[string]interface{}{
+			"stations_repaired": report.StationsRepaired,
+			"issues_found":      report.IssuesFound,
+		})
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"report":  report,
+	})
+}
+
+// Seed handles POST /api/v1/admin/seed requests.
+// The route is only registered in test and development deployments.
+func (h *AdminHandler) Seed(c *gin.Context) {
+	// Generate request ID for tracing
+	requestID := uuid.New().String()
+	c.Set("request_id", requestID)
+
+	// Create logger with request context
+	logger := h.logger.WithFields(logrus.Fields{
+		"request_id": requestID,
+		"endpoint":   "seed",
+		"method":     c.Request.Method,
+		"client_ip":  c.ClientIP(),
+	})
+
+	logger.Info("Processing seed request")
+
+	if h.seedService == nil {
+		h.errorHandler.HandleServiceError(c, fmt.Errorf("seed service not configured"), "seed", "seed")
+		return
+	}
+
+	var req models.SeedRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.errorHandler.HandleValidationError(c, err, "json_payload")
+		return
+	}
+
+	report, err := h.seedService.Seed(req)
+	if err != nil {
+		h.errorHandler.HandleServiceError(c, err, "seed", "seed")
+		return
+	}
+
+	logger.WithFields(logrus.Fields{
+		"processes_created":   report.ProcessesCreated,
+		"stations_created":    report.StationsCreated,
+		"submissions_created": report.SubmissionsCreated,
+	}).Info("Load test data seeded successfully")
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"report":  report,
+	})
+}
+
+// MarkStationEmpty handles POST /api/v1/polling-station/{id}/mark-empty requests.
+// It verifies a station with zero votes when it legitimately had no turnout and no witnesses will ever report it.
+func (h *AdminHandler) MarkStationEmpty(c *gin.Context) {
+	// Generate request ID for tracing
+	requestID := uuid.New().String()
+	c.Set("request_id", requestID)
+
+	// Get polling station ID from URL parameter
+	stationID := c.Param("id")
+
+	// Create logger with request context
+	logger := h.logger.WithFields(logrus.Fields{
+		"request_id":         requestID,
+		"endpoint":           "markStationEmpty",
+		"method":             c.Request.Method,
+		"client_ip":          c.ClientIP(),
+		"polling_station_id": stationID,
+	})
+
+	logger.Info("Processing mark station empty request")
+
+	station, err := h.storageService.GetPollingStation(stationID)
+	if err != nil {
+		h.errorHandler.HandleNotFoundError(c, "polling station", stationID)
+		return
+	}
+
+	// Never overwrite votes that witnesses already verified
+	if station.Status == models.StationStatusVerified && !station.VerifiedEmpty {
+		h.errorHandler.HandleError(c, services.NewAPIError(
+			services.ErrorTypeConflict,
+			"Polling station is already verified",
+			"A station whose results were verified by consensus cannot be marked empty",
+			http.StatusConflict,
+		), map[string]interface{}{"polling_station_id": stationID})
+		return
+	}
+	if station.VotingProcessID == "" {
+		h.errorHandler.HandleError(c, services.NewAPIError(
+			services.ErrorTypeConflict,
+			"Polling station is not part of a voting process",
+			"Only stations registered to a voting process can be marked empty",
+			http.StatusConflict,
+		), map[string]interface{}{"polling_station_id": stationID})
+		return
+	}
+
+	if err := h.storageService.MarkPollingStationEmpty(stationID); err != nil {
+		h.errorHandler.HandleServiceError(c, err, "storage", "mark_station_empty")
+		return
+	}
+
+	station, err = h.storageService.GetPollingStation(stationID)
+	if err != nil {
+		h.errorHandler.HandleServiceError(c, err, "storage", "get_polling_station")
+		return
+	}
+
+	logger.WithField("voting_process_id", station.VotingProcessID).Info("Polling station marked empty")
+
+	if h.auditService != nil {
+		h.auditService.Record(requestActor(c), services.AuditActionMarkStationEmpty, "polling_station", stationID, map[string]interface{}{
+			"voting_process_id": station.VotingProcessID,
+		})
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":            true,
+		"polling_station_id": stationID,
+		"status":             station.Status,
+		"verified_results":   station.VerifiedResults,
+		"verified_empty":     station.VerifiedEmpty,
+		"message":            "Polling station marked empty and verified with zero votes",
+	})
+}
+
+// ResolveChallenge handles POST /api/v1/polling-station/{id}/challenge/resolve requests.
+// It closes the station's open challenge so the station is reported as Verified again.
+func (h *AdminHandler) ResolveChallenge(c *gin.Context) {
+	// Generate request ID for tracing
+	requestID := uuid.New().String()
+	c.Set("request_id", requestID)
+
+	// Get polling station ID from URL parameter
+	stationID := c.Param("id")
+
+	// Create logger with request context
+	logger := h.logger.WithFields(logrus.Fields{
+		"request_id":         requestID,
+		"endpoint":           "resolveChallenge",
+		"method":             c.Request.Method,
+		"client_ip":          c.ClientIP(),
+		"polling_station_id": stationID,
+	})
+
+	logger.Info("Processing resolve challenge request")
+
+	var req models.ResolveChallengeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.errorHandler.HandleValidationError(c, err, "json_payload")
+		return
+	}
+
+	station, err := h.storageService.GetPollingStation(stationID)
+	if err != nil {
+		h.errorHandler.HandleNotFoundError(c, "polling station", stationID)
+		return
+	}
+	if !station.Challenge.Open() {
+		h.errorHandler.HandleError(c, services.NewAPIError(
+			services.ErrorTypeConflict,
+			"Polling station has no open challenge",
+			"Only a challenged polling station can have its challenge resolved",
+			http.StatusConflict,
+		), map[string]interface{}{"polling_station_id": stationID})
+		return
+	}
+
+	challenge, err := h.storageService.ResolveStationChallenge(stationID, req.Resolution)
+	if err != nil {
+		h.errorHandler.HandleServiceError(c, err, "storage", "resolve_station_challenge")
+		return
+	}
+
+	logger.WithField("challenge_id", challenge.ID).Info("Polling station challenge resolved")
+
+	if h.auditService != nil {
+		h.auditService.Record(requestActor(c), services.AuditActionResolveChallenge, "polling_station", stationID, map[string]interface{}{
+			"challenge_id": challenge.ID,
+			"resolution":   req.Resolution,
+		})
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":            true,
+		"polling_station_id": stationID,
+		"status":             station.Status,
+		"challenge":          challenge,
+	})
+}
+
+// FlagSubmission handles POST /api/v1/admin/submission/{id}/flag requests.
+// The flagged submission stays on record but no longer counts towards consensus, which is recomputed.
+func (h *AdminHandler) FlagSubmission(c *gin.Context) {
+	// Generate request ID for tracing
+	requestID := uuid.New().String()
+	c.Set("request_id", requestID)
+
+	// Get submission ID from URL parameter
+	submissionID := c.Param("id")
+
+	// Create logger with request context
+	logger := h.logger.WithFields(logrus.Fields{
+		"request_id":    requestID,
+		"endpoint":      "flagSubmission",
+		"method":        c.Request.Method,
+		"client_ip":     c.ClientIP(),
+		"submission_id": submissionID,
+	})
+
+	logger.Info("Processing flag submission request")
+
+	var req models.FlagSubmissionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.errorHandler.HandleValidationError(c, err, "json_payload")
+		return
+	}
+
+	submission, err := h.storageService.FlagSubmission(submissionID, req.Reason)
+	if err != nil {
+		h.errorHandler.HandleNotFoundError(c, "submission", submissionID)
+		return
+	}
+
+	logger.WithField("polling_station_id", submission.PollingStationID).Info("Submission flagged")
+
+	if h.auditService != nil {
+		h.auditService.Record(requestActor(c), services.AuditActionFlagSubmission, "submission", submissionID, map[string]interface{}{
+			"polling_station_id": submission.PollingStationID,
+			"reason":             req.Reason,
+		})
+	}
+
+	h.respondRecomputed(c, submission, "Submission flagged and consensus recomputed", logger)
+}
+
+// RemoveSubmission handles DELETE /api/v1/admin/submission/{id} requests and recomputes the station's consensus
+func (h *AdminHandler) RemoveSubmission(c *gin.Context) {
+	// Generate request ID for tracing
+	requestID := uuid.New().String()
+	c.Set("request_id", requestID)
+
+	// Get submission ID from URL parameter
+	submissionID := c.Param("id")
+
+	// Create logger with request context
+	logger := h.logger.WithFields(logrus.Fields{
+		"request_id":    requestID,
+		"endpoint":      "removeSubmission",
+		"method":        c.Request.Method,
+		"client_ip":     c.ClientIP(),
+		"submission_id": submissionID,
+	})
+
+	logger.Info("Processing remove submission request")
+
+	submission, err := h.storageService.RemoveSubmission(submissionID)
+	if err != nil {
+		h.errorHandler.HandleNotFoundError(c, "submission", submissionID)
+		return
+	}
+
+	logger.WithField("polling_station_id", submission.PollingStationID).Info("Submission removed")
+
+	if h.auditService != nil {
+		h.auditService.Record(requestActor(c), services.AuditActionRemoveSubmission, "submission", submissionID, map[string]interface{}{
+			"polling_station_id": submission.PollingStationID,
+			"wallet_address":     submission.WalletAddress,
+		})
+	}
+
+	h.respondRecomputed(c, submission, "Submission removed and consensus recomputed", logger)
+}
+
+// respondRecomputed recomputes the consensus of a flagged or removed submission's station and reports the outcome
+func (h *AdminHandler) respondRecomputed(c *gin.Context, submission *models.Submission, message string, logger *logrus.Entry) {
+	response := gin.H{
+		"success":            true,
+		"submission_id":      submission.ID,
+		"polling_station_id": submission.PollingStationID,
+		"message":            message,
+	}
+
+	if h.consensusService != nil {
+		result, err := h.consensusService.RecomputeConsensus(submission.PollingStationID)
+		if err != nil {
+			h.errorHandler.HandleServiceError(c, err, "consensus", "recompute_consensus")
+			return
+		}
+		logger.WithField("consensus_status", result.Status).Info("Consensus recomputed")
+		response["consensus_status"] = result.Status
+		response["consensus"] = result
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetWalletActivity handles GET /api/v1/admin/wallet/{address}/activity requests
+func (h *AdminHandler) GetWalletActivity(c *gin.Context) {
+	// Generate request ID for tracing
+	requestID := uuid.New().String()
+	c.Set("request_id", requestID)
+
+	// Get wallet address from URL parameter
+	walletAddress := c.Param("address")
+
+	// Create logger with request context
+	logger := h.logger.WithFields(logrus.Fields{
+		"request_id":     requestID,
+		"endpoint":       "getWalletActivity",
+		"method":         c.Request.Method,
+		"client_ip":      c.ClientIP(),
+		"wallet_address": walletAddress,
+	})
+
+	logger.Info("Processing get wallet activity request")
+
+	activity := h.walletActivityService.GetWalletActivity(walletAddress)
+
+	logger.WithFields(logrus.Fields{
+		"station_count": activity.StationCount,
+		"flagged":       activity.Flagged,
+	}).Info("Wallet activity retrieved successfully")
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":  true,
+		"activity": activity,
+	})
+}
+
+// GetAuditLog handles GET /api/v1/admin/audit requests, optionally filtered by actor, action and target
+func (h *AdminHandler) GetAuditLog(c *gin.Context) {
+	// Generate request ID for tracing
+	requestID := uuid.New().String()
+	c.Set("request_id", requestID)
+
+	filter := services.AuditFilter{
+		Actor:    c.Query("actor"),
+		Action:   c.Query("action"),
+		TargetID: c.Query("target"),
+	}
+
+	// Create logger with request context
+	logger := h.logger.WithFields(logrus.Fields{
+		"request_id": requestID,
+		"endpoint":   "getAuditLog",
+		"method":     c.Request.Method,
+		"client_ip":  c.ClientIP(),
+		"actor":      filter.Actor,
+		"action":     filter.Action,
+		"target_id":  filter.TargetID,
+	})
+
+	logger.Info("Processing get audit log request")
+
+	entries := []models.AuditEntry{}
+	if h.auditService != nil {
+		entries = h.auditService.Query(filter)
+	}
+
+	logger.WithField("entry_count", len(entries)).Info("Audit log retrieved successfully")
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"entries": entries,
+	})
+}
+
+// GetConfig handles GET /api/v1/admin/config requests.
+// It reports the effective non-secret configuration; secret settings only show whether they are set.
+func (h *AdminHandler) GetConfig(c *gin.Context) {
+	// Generate request ID for tracing
+	requestID := uuid.New().String()
+	c.Set("request_id", requestID)
+
+	// Create logger with request context
+	logger := h.logger.WithFields(logrus.Fields{
+		"request_id": requestID,
+		"endpoint":   "getConfig",
+		"method":     c.Request.Method,
+		"client_ip":  c.ClientIP(),
+	})
+
+	logger.Info("Processing get config request")
+
+	config := h.effectiveConfig
+	config.StorageBackend = h.storageService.Backend()
+
+	// Never report a secret value, even if one was configured by mistake
+	secrets := make(map[string]string, len(config.Secrets))
+	for name, value := range config.Secrets {
+		if value != "" {
+			value = services.RedactedValue
+		}
+		secrets[name] = value
+	}
+	config.Secrets = secrets
+
+	logger.Info("Config retrieved successfully")
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"config":  config,
+	})
+}
+
+// UpdateMaintenanceMode handles POST /api/v1/admin/maintenance requests.
+// While maintenance mode is on, submissions are rejected with 503 MAINTENANCE_MODE and read endpoints keep working.
+func (h *AdminHandler) UpdateMaintenanceMode(c *gin.Context) {
+	// Generate request ID for tracing
+	requestID := uuid.New().String()
+	c.Set("request_id", requestID)
+
+	// Create logger with request context
+	logger := h.logger.WithFields(logrus.Fields{
+		"request_id": requestID,
+		"endpoint":   "updateMaintenanceMode",
+		"method":     c.Request.Method,
+		"client_ip":  c.ClientIP(),
+	})
+
+	logger.Info("Processing update maintenance mode request")
+
+	if h.maintenance == nil {
+		h.errorHandler.HandleServiceError(c, fmt.Errorf("maintenance mode not configured"), "maintenance", "update_maintenance_mode")
+		return
+	}
+
+	var req models.MaintenanceModeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.errorHandler.HandleValidationError(c, err, "json_payload")
+		return
+	}
+
+	enabled := *req.Enabled
+	changed := h.maintenance.SetEnabled(enabled)
+
+	logger.WithFields(logrus.Fields{
+		"maintenance_enabled": enabled,
+		"changed":             changed,
+	}).Warn("Maintenance mode updated")
+
+	if changed && h.auditService != nil {
+		h.auditService.Record(requestActor(c), services.AuditActionSetMaintenanceMode, "server", "maintenance", map[string]interface{}{
+			"enabled": enabled,
+		})
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":             true,
+		"maintenance_enabled": enabled,
+		"changed_at":          h.maintenance.ChangedAt(),
+	})
+}
@@ -0,0 +1,1057 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oyah-backend/internal/middleware"
+	"oyah-backend/internal/models"
+	"oyah-backend/internal/services"
+)
+
+func setupAdminTestRouter() (*gin.Engine, *services.StorageService) {
+	gin.SetMode(gin.TestMode)
+
+	storage := services.NewStorageService()
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel) // Reduce log noise in tests
+
+	errorHandler := services.NewErrorHandler(logger)
+	retentionService := services.NewRetentionService(storage, logger)
+	walletActivityService := services.NewWalletActivityService(storage, logger)
+	adminHandler := NewAdminHandler(storage, retentionService, walletActivityService, errorHandler, logger)
+	consensusService := services.NewConsensusService(storage, logger)
+	adminHandler.SetConsensusRecoveryService(services.NewConsensusRecoveryService(storage, consensusService, logger))
+	adminHandler.SetConsensusService(consensusService)
+	adminHandler.SetSeedService(services.NewSeedService(storage, consensusService, logger))
+	pollingStationHandler := NewPollingStationHandler(storage, services.NewTallyService(storage, logger), errorHandler, logger)
+
+	router := gin.New()
+	api := router.Group("/api/v1")
+	{
+		api.GET("/polling-station/:id/submissions", pollingStationHandler.GetStationSubmissions)
+		api.POST("/admin/voting-process/:id/compact", adminHandler.CompactVotingProcess)
+		api.POST("/admin/voting-process/:id/repair", adminHandler.RepairVotingProcess)
+		api.POST("/admin/seed", adminHandler.Seed)
+		api.POST("/polling-station/:id/mark-empty", adminHandler.MarkStationEmpty)
+		api.POST("/polling-station/:id/challenge", pollingStationHandler.ChallengeStation)
+		api.POST("/polling-station/:id/challenge/resolve", adminHandler.ResolveChallenge)
+		api.POST("/admin/submission/:id/flag", adminHandler.FlagSubmission)
+		api.DELETE("/admin/submission/:id", adminHandler.RemoveSubmission)
+		api.GET("/admin/wallet/:address/activity", adminHandler.GetWalletActivity)
+	}
+
+	return router, storage
+}
+
+func TestAdminHandler_CompactVotingProcess(t *testing.T) {
+	router, storage := setupAdminTestRouter()
+
+	votingProcess := models.VotingProcess{
+		ID:       "vp-compact",
+		Title:    "Compaction Election",
+		Position: "Governor",
+		Candidates: []models.Candidate{
+			{ID: "c1", Name: "Alice"},
+			{ID: "c2", Name: "Bob"},
+		},
+		PollingStations: []string{"CMP001"},
+		Status:          "Active",
+		CreatedAt:       time.Now(),
+	}
+	require.NoError(t, storage.StoreVotingProcess(votingProcess))
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, storage.StoreSubmission(models.Submission{
+			ID:               fmt.Sprintf("cmp-sub-%d", i),
+			WalletAddress:    fmt.Sprintf("5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKut%02d", i),
+			PollingStationID: "CMP001",
+			Timestamp:        time.Now(),
+			Results:          map[string]int{"Alice": 50, "Bob": 40},
+			SubmissionType:   "audio_stt",
+		}))
+	}
+
+	t.Run("ProcessNotComplete", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/api/v1/admin/voting-process/vp-compact/compact", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("ProcessNotFound", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/api/v1/admin/voting-process/missing/compact", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("RawSubmissionsBeforeCompaction", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/polling-station/CMP001/submissions", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, false, response["compacted"])
+		assert.Len(t, response["submissions"], 3)
+	})
+
+	t.Run("CompactCompletedProcess", func(t *testing.T) {
+		require.NoError(t, storage.UpdateVotingProcessStatus("vp-compact", "Complete"))
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/api/v1/admin/voting-process/vp-compact/compact", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, true, response["success"])
+		assert.Equal(t, float64(1), response["compacted_stations"])
+
+		// Raw submission retrieval now reports the compaction
+		w = httptest.NewRecorder()
+		req, _ = http.NewRequest("GET", "/api/v1/polling-station/CMP001/submissions", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		response = map[string]interface{}{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, true, response["compacted"])
+		assert.Nil(t, response["submissions"])
+
+		summary := response["summary"].(map[string]interface{})
+		assert.Equal(t, float64(3), summary["totalSubmissions"])
+	})
+
+	t.Run("UnknownStation", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/polling-station/UNKNOWN/submissions", nil)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestAdminHandler_RepairVotingProcess(t *testing.T) {
+	router, storage := setupAdminTestRouter()
+
+	require.NoError(t, storage.StoreVotingProcess(models.VotingProcess{
+		ID:       "vp-repair",
+		Title:    "Repair Election",
+		Position: "Governor",
+		Candidates: []models.Candidate{
+			{ID: "c1", Name: "Alice"},
+			{ID: "c2", Name: "Bob"},
+		},
+		PollingStations: []string{"REP001"},
+		Status:          "Active",
+		CreatedAt:       time.Now(),
+	}))
+
+	// A duplicated wallet whose older submission carries a negative count
+	now := time.Now()
+	require.NoError(t, storage.ReplaceStationSubmissions("REP001", []models.Submission{
+		{ID: "rep-1", WalletAddress: "wallet-1", PollingStationID: "REP001", Results: map[string]int{"Alice": -1, "Bob": 40}, ProcessedAt: now.Add(-time.Minute)},
+		{ID: "rep-2", WalletAddress: "wallet-1", PollingStationID: "REP001", Results: map[string]int{"Alice": 30, "Bob": 40}, ProcessedAt: now},
+		{ID: "rep-3", WalletAddress: "wallet-2", PollingStationID: "REP001", Results: map[string]int{"Alice": 30, "Bob": 40}, ProcessedAt: now},
+		{ID: "rep-4", WalletAddress: "wallet-3", PollingStationID: "REP001", Results: map[string]int{"Alice": 30, "Bob": 40}, ProcessedAt: now},
+	}))
+
+	t.Run("RepairsAndRecomputesConsensus", func(t *testing.T) {
+		req, err := http.NewRequest("POST", "/api/v1/admin/voting-process/vp-repair/repair", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response struct {
+			Success bool                           `json:"success"`
+			Report  services.IntegrityRepairReport `json:"report"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.True(t, response.Success)
+		assert.Equal(t, 2, response.Report.IssuesFound)
+		assert.Equal(t, 1, response.Report.StationsRepaired)
+		require.Len(t, response.Report.Stations, 1)
+		assert.Equal(t, "Verified", response.Report.Stations[0].ConsensusStatus)
+
+		assert.Len(t, storage.GetSubmissionsByStation("REP001"), 3)
+		station, err := storage.GetPollingStation("REP001")
+		require.NoError(t, err)
+		assert.Equal(t, "Verified", station.Status)
+		assert.Equal(t, map[string]int{"Alice": 30, "Bob": 40}, station.VerifiedResults)
+	})
+
+	t.Run("ProcessNotFound", func(t *testing.T) {
+		req, err := http.NewRequest("POST", "/api/v1/admin/voting-process/non-existent/repair", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestAdminHandler_MarkStationEmpty(t *testing.T) {
+	router, storage := setupAdminTestRouter()
+
+	require.NoError(t, storage.StoreVotingProcess(models.VotingProcess{
+		ID:       "vp-empty",
+		Title:    "Empty Station Election",
+		Position: "Governor",
+		Candidates: []models.Candidate{
+			{ID: "c1", Name: "Alice"},
+			{ID: "c2", Name: "Bob"},
+		},
+		PollingStations: []string{"EMPTY001", "FULL001"},
+		Status:          "Active",
+		CreatedAt:       time.Now(),
+	}))
+	require.NoError(t, storage.UpdatePollingStationStatus("FULL001", "Verified", map[string]int{"Alice": 30, "Bob": 20}, 0.9))
+
+	t.Run("MarksStationVerifiedWithZeroVotes", func(t *testing.T) {
+		req, err := http.NewRequest("POST", "/api/v1/polling-station/EMPTY001/mark-empty", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, true, response["success"])
+		assert.Equal(t, "Verified", response["status"])
+		assert.Equal(t, true, response["verified_empty"])
+
+		station, err := storage.GetPollingStation("EMPTY001")
+		require.NoError(t, err)
+		assert.Equal(t, "Verified", station.Status)
+		assert.True(t, station.VerifiedEmpty)
+		assert.Equal(t, map[string]int{"Alice": 0, "Bob": 0, models.SpoiltVotesKey: 0}, station.VerifiedResults)
+
+		logger := logrus.New()
+		logger.SetLevel(logrus.ErrorLevel)
+		tally, err := services.NewTallyService(storage, logger).GetTallyData("vp-empty")
+		require.NoError(t, err)
+		assert.Equal(t, 30, tally.AggregatedTally["Alice"])
+		assert.Equal(t, 20, tally.AggregatedTally["Bob"])
+		for _, status := range tally.PollingStations {
+			if status.ID == "EMPTY001" {
+				assert.Equal(t, "Verified", status.Status)
+				assert.True(t, status.Empty)
+				assert.Equal(t, 0, status.Results["Alice"])
+			}
+		}
+	})
+
+	t.Run("ConsensusClearsEmptyFlag", func(t *testing.T) {
+		require.NoError(t, storage.UpdatePollingStationStatus("EMPTY001", "Verified", map[string]int{"Alice": 5, "Bob": 3}, 0.8))
+
+		station, err := storage.GetPollingStation("EMPTY001")
+		require.NoError(t, err)
+		assert.False(t, station.VerifiedEmpty)
+	})
+
+	t.Run("AlreadyVerifiedStation", func(t *testing.T) {
+		req, err := http.NewRequest("POST", "/api/v1/polling-station/FULL001/mark-empty", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusConflict, w.Code)
+
+		station, err := storage.GetPollingStation("FULL001")
+		require.NoError(t, err)
+		assert.Equal(t, 30, station.VerifiedResults["Alice"])
+	})
+
+	t.Run("StationNotFound", func(t *testing.T) {
+		req, err := http.NewRequest("POST", "/api/v1/polling-station/non-existent/mark-empty", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestAdminHandler_ChallengeStation(t *testing.T) {
+	router, storage := setupAdminTestRouter()
+
+	require.NoError(t, storage.StoreVotingProcess(models.VotingProcess{
+		ID:       "vp-challenge",
+		Title:    "Challenge Election",
+		Position: "Governor",
+		Candidates: []models.Candidate{
+			{ID: "c1", Name: "Alice"},
+			{ID: "c2", Name: "Bob"},
+		},
+		PollingStations: []string{"CHAL001", "PEND001"},
+		Status:          "Active",
+		CreatedAt:       time.Now(),
+	}))
+	require.NoError(t, storage.UpdatePollingStationStatus("CHAL001", "Verified", map[string]int{"Alice": 30, "Bob": 20}, 0.9))
+
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+	tallyService := services.NewTallyService(storage, logger)
+
+	stationStatus := func(t *testing.T, stationID string) services.StationStatus {
+		tally, err := tallyService.GetTallyData("vp-challenge")
+		require.NoError(t, err)
+		assert.Equal(t, 30, tally.AggregatedTally["Alice"])
+		assert.Equal(t, 20, tally.AggregatedTally["Bob"])
+		for _, status := range tally.PollingStations {
+			if status.ID == stationID {
+				return status
+			}
+		}
+		t.Fatalf("Station %s missing from tally", stationID)
+		return services.StationStatus{}
+	}
+
+	post := func(path, body string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest("POST", path, bytes.NewBufferString(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("ResolveWithoutChallenge", func(t *testing.T) {
+		w := post("/api/v1/polling-station/CHAL001/challenge/resolve", `{"resolution": "Nothing to resolve"}`)
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("PendingStationCannotBeChallenged", func(t *testing.T) {
+		w := post("/api/v1/polling-station/PEND001/challenge", `{"challenger": "observer-1", "reason": "Wrong count"}`)
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("MissingReason", func(t *testing.T) {
+		w := post("/api/v1/polling-station/CHAL001/challenge", `{"challenger": "observer-1"}`)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("ChallengeFlagsStationInTally", func(t *testing.T) {
+		w := post("/api/v1/polling-station/CHAL001/challenge",
+			`{"challenger": "observer-1", "reason": "Form 34A shows different counts", "disputedResults": {"Alice": 20, "Bob": 30}}`)
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		var response struct {
+			Status    string                  `json:"status"`
+			Embargoed bool                    `json:"embargoed"`
+			Challenge models.StationChallenge `json:"challenge"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "Challenged", response.Status)
+		assert.False(t, response.Embargoed)
+		assert.Equal(t, map[string]int{"Alice": 30, "Bob": 20}, response.Challenge.VerifiedResults)
+
+		station, err := storage.GetPollingStation("CHAL001")
+		require.NoError(t, err)
+		require.NotNil(t, station.Challenge)
+		assert.True(t, station.Challenge.Open())
+		assert.Equal(t, "observer-1", station.Challenge.Challenger)
+		assert.Equal(t, map[string]int{"Alice": 20, "Bob": 30}, station.Challenge.DisputedResults)
+		assert.Equal(t, map[string]int{"Alice": 30, "Bob": 20}, station.Challenge.VerifiedResults)
+		assert.Equal(t, "Verified", station.Status)
+
+		// The result still counts, but the station is flagged
+		status := stationStatus(t, "CHAL001")
+		assert.Equal(t, "Challenged", status.Status)
+		assert.Equal(t, station.Challenge.ID, status.ChallengeID)
+		assert.Equal(t, 30, status.Results["Alice"])
+	})
+
+	t.Run("SecondChallengeWhileOpen", func(t *testing.T) {
+		w := post("/api/v1/polling-station/CHAL001/challenge", `{"challenger": "observer-2", "reason": "Also disputed"}`)
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("ResolveReturnsStationToVerified", func(t *testing.T) {
+		w := post("/api/v1/polling-station/CHAL001/challenge/resolve", `{"resolution": "Recount confirmed the verified result"}`)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "Verified", response["status"])
+
+		station, err := storage.GetPollingStation("CHAL001")
+		require.NoError(t, err)
+		require.NotNil(t, station.Challenge)
+		assert.False(t, station.Challenge.Open())
+		assert.Equal(t, "Recount confirmed the verified result", station.Challenge.Resolution)
+
+		status := stationStatus(t, "CHAL001")
+		assert.Equal(t, "Verified", status.Status)
+		assert.Empty(t, status.ChallengeID)
+	})
+
+	t.Run("EmbargoWithholdsVerifiedResults", func(t *testing.T) {
+		require.NoError(t, storage.StoreVotingProcess(models.VotingProcess{
+			ID:                   "vp-challenge-embargo",
+			Title:                "Embargoed Challenge Election",
+			Position:             "Governor",
+			Candidates:           []models.Candidate{{ID: "c1", Name: "Alice"}, {ID: "c2", Name: "Bob"}},
+			PollingStations:      []string{"CHAL002"},
+			Status:               "Active",
+			EmbargoUntilComplete: true,
+			CreatedAt:            time.Now(),
+		}))
+		require.NoError(t, storage.UpdatePollingStationStatus("CHAL002", "Verified", map[string]int{"Alice": 40, "Bob": 10}, 0.9))
+
+		w := post("/api/v1/polling-station/CHAL002/challenge", `{"challenger": "observer-1", "reason": "Wrong count"}`)
+		require.Equal(t, http.StatusCreated, w.Code)
+		assert.NotContains(t, w.Body.String(), `"Alice"`)
+
+		var response struct {
+			Embargoed bool                    `json:"embargoed"`
+			Challenge models.StationChallenge `json:"challenge"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.True(t, response.Embargoed)
+		assert.Nil(t, response.Challenge.VerifiedResults)
+
+		// The stored challenge keeps the verified result for the administrator resolving it
+		station, err := storage.GetPollingStation("CHAL002")
+		require.NoError(t, err)
+		require.NotNil(t, station.Challenge)
+		assert.Equal(t, map[string]int{"Alice": 40, "Bob": 10}, station.Challenge.VerifiedResults)
+	})
+
+	t.Run("StationNotFound", func(t *testing.T) {
+		w := post("/api/v1/polling-station/non-existent/challenge", `{"challenger": "observer-1", "reason": "Wrong count"}`)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestAdminHandler_FlagAndRemoveSubmission(t *testing.T) {
+	router, storage := setupAdminTestRouter()
+
+	require.NoError(t, storage.StoreVotingProcess(models.VotingProcess{
+		ID:       "vp-flag",
+		Title:    "Flag Election",
+		Position: "Governor",
+		Candidates: []models.Candidate{
+			{ID: "c1", Name: "Alice"},
+			{ID: "c2", Name: "Bob"},
+		},
+		PollingStations: []string{"FLAG001"},
+		Status:          "Active",
+		CreatedAt:       time.Now(),
+	}))
+	for i := 1; i <= 3; i++ {
+		require.NoError(t, storage.StoreSubmission(models.Submission{
+			ID:               fmt.Sprintf("flag-%d", i),
+			WalletAddress:    fmt.Sprintf("wallet-%d", i),
+			PollingStationID: "FLAG001",
+			Timestamp:        time.Now(),
+			Results:          map[string]int{"Alice": 30, "Bob": 40},
+			SubmissionType:   "image_ocr",
+		}))
+	}
+	require.NoError(t, storage.UpdatePollingStationStatus("FLAG001", "Verified", map[string]int{"Alice": 30, "Bob": 40}, 1.0))
+
+	t.Run("FlagRevertsStationToPending", func(t *testing.T) {
+		body, err := json.Marshal(models.FlagSubmissionRequest{Reason: "Photo shows a different station"})
+		require.NoError(t, err)
+
+		req, err := http.NewRequest("POST", "/api/v1/admin/submission/flag-1/flag", bytes.NewBuffer(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "FLAG001", response["polling_station_id"])
+		assert.Equal(t, "Pending", response["consensus_status"])
+
+		submission, err := storage.GetSubmissionByID("flag-1")
+		require.NoError(t, err)
+		assert.True(t, submission.Flagged)
+		assert.Equal(t, "Photo shows a different station", submission.FlagReason)
+
+		station, err := storage.GetPollingStation("FLAG001")
+		require.NoError(t, err)
+		assert.Equal(t, "Pending", station.Status)
+	})
+
+	t.Run("FlagRequiresReason", func(t *testing.T) {
+		req, err := http.NewRequest("POST", "/api/v1/admin/submission/flag-2/flag", bytes.NewBufferString(`{}`))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("RemoveSubmission", func(t *testing.T) {
+		req, err := http.NewRequest("DELETE", "/api/v1/admin/submission/flag-2", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		assert.Len(t, storage.GetSubmissionsByStation("FLAG001"), 2)
+		assert.Empty(t, storage.GetSubmissionsByWallet("wallet-2"))
+	})
+
+	t.Run("SubmissionNotFound", func(t *testing.T) {
+		req, err := http.NewRequest("DELETE", "/api/v1/admin/submission/non-existent", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestAdminHandler_Seed(t *testing.T) {
+	router, storage := setupAdminTestRouter()
+
+	seed := func(body string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest("POST", "/api/v1/admin/seed", bytes.NewBufferString(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("SeedsSmallDataset", func(t *testing.T) {
+		w := seed(`{"processes": 2, "stationsPerProcess": 3, "submissionsPerStation": 4, "randomSeed": 42, "processConsensus": true}`)
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+		var response struct {
+			Success bool                `json:"success"`
+			Report  services.SeedReport `json:"report"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.True(t, response.Success)
+		assert.Equal(t, 2, response.Report.ProcessesCreated)
+		assert.Equal(t, 6, response.Report.StationsCreated)
+		assert.Equal(t, 24, response.Report.SubmissionsCreated)
+		require.Len(t, response.Report.VotingProcessIDs, 2)
+
+		assert.Len(t, storage.GetAllVotingProcesses(), 2)
+		for _, processID := range response.Report.VotingProcessIDs {
+			stations, err := storage.GetPollingStationsByVotingProcess(processID)
+			require.NoError(t, err)
+			require.Len(t, stations, 3)
+			for _, station := range stations {
+				assert.Len(t, storage.GetSubmissionsByStation(station.ID), 4)
+			}
+		}
+	})
+
+	t.Run("RepeatedRunsDoNotCollide", func(t *testing.T) {
+		w := seed(`{"processes": 1, "stationsPerProcess": 2, "submissionsPerStation": 1}`)
+		require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+		assert.Len(t, storage.GetAllVotingProcesses(), 3)
+	})
+
+	t.Run("RejectsOversizedRequest", func(t *testing.T) {
+		w := seed(fmt.Sprintf(`{"processes": %d, "stationsPerProcess": 1}`, services.MaxSeedProcesses+1))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestAdminHandler_GetWalletActivity(t *testing.T) {
+	router, storage := setupAdminTestRouter()
+
+	busyWallet := "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY"
+	honestWallet := "5FHneW46xGXgs5mUiveU4sbTyGBzmstUspZC92UhjJM694ty"
+
+	// The busy wallet submits to five stations, the honest wallet to one
+	for i := 1; i <= 5; i++ {
+		require.NoError(t, storage.StoreSubmission(models.Submission{
+			ID:               fmt.Sprintf("busy-sub-%d", i),
+			WalletAddress:    busyWallet,
+			PollingStationID: fmt.Sprintf("WAL00%d", i),
+			Timestamp:        time.Now(),
+			Results:          map[string]int{"Alice": 10, "Bob": 5},
+			SubmissionType:   "image_ocr",
+		}))
+	}
+	require.NoError(t, storage.StoreSubmission(models.Submission{
+		ID:               "honest-sub-1",
+		WalletAddress:    honestWallet,
+		PollingStationID: "WAL001",
+		Timestamp:        time.Now(),
+		Results:          map[string]int{"Alice": 10, "Bob": 5},
+		SubmissionType:   "image_ocr",
+	}))
+
+	getActivity := func(t *testing.T, address string) services.WalletActivity {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/admin/wallet/"+address+"/activity", nil)
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response struct {
+			Success  bool                    `json:"success"`
+			Activity services.WalletActivity `json:"activity"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.True(t, response.Success)
+		return response.Activity
+	}
+
+	t.Run("MultiStationWalletFlagged", func(t *testing.T) {
+		activity := getActivity(t, busyWallet)
+
+		assert.Equal(t, 5, activity.StationCount)
+		assert.Len(t, activity.Stations, 5)
+		assert.Equal(t, "WAL001", activity.Stations[0].PollingStationID)
+		assert.True(t, activity.Flagged)
+		assert.NotEmpty(t, activity.Reason)
+	})
+
+	t.Run("SingleStationWalletNotFlagged", func(t *testing.T) {
+		activity := getActivity(t, honestWallet)
+
+		assert.Equal(t, 1, activity.StationCount)
+		assert.False(t, activity.Flagged)
+		assert.Empty(t, activity.Reason)
+	})
+
+	t.Run("UnknownWallet", func(t *testing.T) {
+		activity := getActivity(t, "unknown-wallet")
+
+		assert.Equal(t, 0, activity.StationCount)
+		assert.Empty(t, activity.Stations)
+		assert.False(t, activity.Flagged)
+	})
+}
+
+func TestAdminHandler_GetWalletActivity_RapidStationSwitches(t *testing.T) {
+	router, storage := setupAdminTestRouter()
+
+	rapidWallet := "5DAAnrj7VHTznn2AWBemMuyBwZWs6FNFjdyVXUeYum3PTXFy"
+	patientWallet := "5HGjWAeFDfFCWPsjFQdVV2Msvz2XtMktvgocEZcCj68kUMaw"
+
+	// Both wallets submit to two stations; one switches after 2 seconds, the other after an hour
+	start := time.Now().Add(-2 * time.Hour)
+	submissions := []models.Submission{
+		{ID: "rapid-sub-1", WalletAddress: rapidWallet, PollingStationID: "RAP001", Timestamp: start},
+		{ID: "rapid-sub-2", WalletAddress: rapidWallet, PollingStationID: "RAP002", Timestamp: start.Add(2 * time.Second)},
+		{ID: "patient-sub-1", WalletAddress: patientWallet, PollingStationID: "RAP001", Timestamp: start},
+		{ID: "patient-sub-2", WalletAddress: patientWallet, PollingStationID: "RAP002", Timestamp: start.Add(time.Hour)},
+	}
+	for _, submission := range submissions {
+		submission.Results = map[string]int{"Alice": 10, "Bob": 5}
+		submission.SubmissionType = "image_ocr"
+		require.NoError(t, storage.StoreSubmission(submission))
+	}
+
+	getActivity := func(t *testing.T, address string) services.WalletActivity {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/admin/wallet/"+address+"/activity", nil)
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response struct {
+			Activity services.WalletActivity `json:"activity"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		return response.Activity
+	}
+
+	t.Run("TwoSecondsApartFlagged", func(t *testing.T) {
+		activity := getActivity(t, rapidWallet)
+
+		assert.Equal(t, 2, activity.StationCount)
+		assert.True(t, activity.Flagged)
+		assert.Contains(t, activity.Reason, "different stations")
+		assert.Equal(t, services.DefaultMinStationInterval.String(), activity.MinStationInterval)
+		require.Len(t, activity.RapidSwitches, 1)
+		assert.Equal(t, "RAP001", activity.RapidSwitches[0].FromPollingStationID)
+		assert.Equal(t, "RAP002", activity.RapidSwitches[0].ToPollingStationID)
+		assert.Equal(t, 2.0, activity.RapidSwitches[0].IntervalSeconds)
+	})
+
+	t.Run("HourApartNotFlagged", func(t *testing.T) {
+		activity := getActivity(t, patientWallet)
+
+		assert.Equal(t, 2, activity.StationCount)
+		assert.False(t, activity.Flagged)
+		assert.Empty(t, activity.Reason)
+		assert.Empty(t, activity.RapidSwitches)
+	})
+}
+
+func TestAdminHandler_WalletAnonymization(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	storage := services.NewStorageService()
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+
+	errorHandler := services.NewErrorHandler(logger)
+	adminHandler := NewAdminHandler(storage, services.NewRetentionService(storage, logger), services.NewWalletActivityService(storage, logger), errorHandler, logger)
+	pollingStationHandler := NewPollingStationHandler(storage, services.NewTallyService(storage, logger), errorHandler, logger)
+
+	anonymizer := services.NewWalletAnonymizer("test-salt")
+	anonymizer.SetEnabled(true)
+	pollingStationHandler.SetWalletAnonymizer(anonymizer)
+
+	router := gin.New()
+	api := router.Group("/api/v1")
+	{
+		api.GET("/polling-station/:id/witnesses", pollingStationHandler.GetStationWitnesses)
+		api.GET("/polling-station/:id/submissions", pollingStationHandler.GetStationSubmissions)
+		api.GET("/admin/wallet/:address/activity", adminHandler.GetWalletActivity)
+	}
+
+	wallet := "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY"
+	require.NoError(t, storage.StoreSubmission(models.Submission{
+		ID:               "anon-sub-1",
+		WalletAddress:    wallet,
+		PollingStationID: "ANON001",
+		Timestamp:        time.Now(),
+		Results:          map[string]int{"Alice": 10, "Bob": 5},
+		SubmissionType:   "image_ocr",
+	}))
+
+	t.Run("PublicWitnessListHashed", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/polling-station/ANON001/witnesses", nil)
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.NotContains(t, w.Body.String(), wallet)
+
+		var response struct {
+			Anonymized bool             `json:"anonymized"`
+			Witnesses  []models.Witness `json:"witnesses"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.True(t, response.Anonymized)
+		require.Len(t, response.Witnesses, 1)
+		assert.Equal(t, anonymizer.Anonymize(wallet), response.Witnesses[0].WalletAddress)
+		assert.Equal(t, "anon-sub-1", response.Witnesses[0].SubmissionID)
+	})
+
+	t.Run("PublicSubmissionsHashed", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/polling-station/ANON001/submissions", nil)
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.NotContains(t, w.Body.String(), wallet)
+		assert.Contains(t, w.Body.String(), anonymizer.Anonymize(wallet))
+
+		// Stored submissions keep the full address
+		assert.Equal(t, wallet, storage.GetSubmissionsByStation("ANON001")[0].WalletAddress)
+	})
+
+	t.Run("AdminActivityKeepsFullAddress", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/api/v1/admin/wallet/"+wallet+"/activity", nil)
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response struct {
+			Activity services.WalletActivity `json:"activity"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, wallet, response.Activity.WalletAddress)
+		assert.Equal(t, 1, response.Activity.StationCount)
+	})
+}
+
+func TestAdminHandler_AuditLog(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	storage := services.NewStorageService()
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+
+	auditService := services.NewAuditService(logger)
+	errorHandler := services.NewErrorHandler(logger)
+	adminHandler := NewAdminHandler(storage, services.NewRetentionService(storage, logger), services.NewWalletActivityService(storage, logger), errorHandler, logger)
+	adminHandler.SetAuditService(auditService)
+	votingProcessHandler := NewVotingProcessHandler(storage, logger)
+	votingProcessHandler.SetAuditService(auditService)
+
+	const adminToken = "audit-admin-token"
+
+	router := gin.New()
+	api := router.Group("/api/v1", middleware.RequireAdminToken(adminToken))
+	{
+		api.POST("/voting-process", votingProcessHandler.CreateVotingProcess)
+		api.PUT("/voting-process/:id/start", votingProcessHandler.StartVotingProcess)
+		api.POST("/admin/voting-process/:id/compact", adminHandler.CompactVotingProcess)
+		api.GET("/admin/audit", adminHandler.GetAuditLog)
+	}
+
+	perform := func(method, path, token string, body []byte) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(method, path, bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	body, err := json.Marshal(models.VotingProcessRequest{
+		Title:    "Audited Election",
+		Position: "Mayor",
+		Candidates: []models.Candidate{
+			{ID: "c1", Name: "Alice"},
+			{ID: "c2", Name: "Bob"},
+		},
+		PollingStations: []string{"AUD001"},
+	})
+	require.NoError(t, err)
+
+	// Unauthenticated callers are turned away before anything happens, so nothing is audited for them
+	require.Equal(t, http.StatusForbidden, perform("POST", "/api/v1/voting-process", "", body).Code)
+	require.Equal(t, http.StatusForbidden, perform("POST", "/api/v1/voting-process", "wrong-token", body).Code)
+
+	w := perform("POST", "/api/v1/voting-process", adminToken, body)
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	var created struct {
+		VotingProcess models.VotingProcess `json:"voting_process"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+	processID := created.VotingProcess.ID
+
+	require.Equal(t, http.StatusOK, perform("PUT", "/api/v1/voting-process/"+processID+"/start", adminToken, nil).Code)
+	require.NoError(t, storage.UpdateVotingProcessStatus(processID, "Complete"))
+	require.Equal(t, http.StatusOK, perform("POST", "/api/v1/admin/voting-process/"+processID+"/compact", adminToken, nil).Code)
+
+	// Failed actions are not audited
+	require.Equal(t, http.StatusNotFound, perform("PUT", "/api/v1/voting-process/missing/start", adminToken, nil).Code)
+
+	getAuditLog := func(query string) []models.AuditEntry {
+		w := perform("GET", "/api/v1/admin/audit"+query, adminToken, nil)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response struct {
+			Success bool                `json:"success"`
+			Entries []models.AuditEntry `json:"entries"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.True(t, response.Success)
+		return response.Entries
+	}
+
+	t.Run("RecordsEachAction", func(t *testing.T) {
+		entries := getAuditLog("")
+		require.Len(t, entries, 3)
+
+		// The actor is the one the admin middleware authenticated, never "anonymous"
+		for _, entry := range entries {
+			assert.Equal(t, middleware.AdminActor, entry.Actor)
+		}
+
+		assert.Equal(t, services.AuditActionCreateVotingProcess, entries[0].Action)
+		assert.Equal(t, "voting_process", entries[0].TargetType)
+		assert.Equal(t, processID, entries[0].TargetID)
+		assert.Equal(t, "Audited Election", entries[0].Parameters["title"])
+		assert.Equal(t, float64(2), entries[0].Parameters["candidates"])
+
+		assert.Equal(t, services.AuditActionStartVotingProcess, entries[1].Action)
+
+		assert.Equal(t, services.AuditActionCompactVotingProcess, entries[2].Action)
+		assert.Equal(t, float64(1), entries[2].Parameters["compacted_stations"])
+	})
+
+	t.Run("RequiresAdminToken", func(t *testing.T) {
+		assert.Equal(t, http.StatusForbidden, perform("GET", "/api/v1/admin/audit", "", nil).Code)
+	})
+
+	t.Run("FiltersByActorActionAndTarget", func(t *testing.T) {
+		assert.Len(t, getAuditLog("?actor="+middleware.AdminActor), 3)
+		assert.Empty(t, getAuditLog("?actor=anonymous"))
+		assert.Len(t, getAuditLog("?action="+services.AuditActionStartVotingProcess), 1)
+		assert.Len(t, getAuditLog("?target="+processID), 3)
+		assert.Empty(t, getAuditLog("?target=missing"))
+	})
+}
+
+func TestAdminHandler_GetConfig(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	t.Setenv("JWT_SECRET", "super-secret-signing-key")
+	t.Setenv("OYAH_TLS_KEY", "/etc/oyah/tls/server.key")
+	t.Setenv("WEBHOOK_SECRET", "")
+
+	storage := services.NewStorageService()
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+
+	consensusService := services.NewConsensusService(storage, logger)
+	adminHandler := NewAdminHandler(storage, services.NewRetentionService(storage, logger), services.NewWalletActivityService(storage, logger), services.NewErrorHandler(logger), logger)
+	adminHandler.SetEffectiveConfig(services.EffectiveConfig{
+		Server:    services.ServerConfig{Port: "8080", ReadTimeout: "15s"},
+		CORS:      services.CORSConfig{AllowedOrigins: []string{"https://dashboard.example.org"}},
+		Consensus: services.ConsensusConfig{Defaults: consensusService.VerificationCriteria(nil)},
+		Limits:    services.LimitsConfig{MinCandidates: 2},
+		Secrets:   services.RedactedSecrets(services.SecretSettings...),
+	})
+
+	router := gin.New()
+	router.GET("/api/v1/admin/config", adminHandler.GetConfig)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/api/v1/admin/config", nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	assert.NotContains(t, w.Body.String(), "super-secret-signing-key")
+	assert.NotContains(t, w.Body.String(), "server.key")
+
+	var response struct {
+		Success bool                     `json:"success"`
+		Config  services.EffectiveConfig `json:"config"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.True(t, response.Success)
+	assert.Equal(t, services.StorageBackendMemory, response.Config.StorageBackend)
+	assert.Equal(t, services.RedactedValue, response.Config.Secrets["JWT_SECRET"])
+	assert.Equal(t, services.RedactedValue, response.Config.Secrets["OYAH_TLS_KEY"])
+	assert.Empty(t, response.Config.Secrets["WEBHOOK_SECRET"], "unset secrets are reported as empty")
+	assert.Equal(t, 3, response.Config.Consensus.Defaults.ConsensusThreshold)
+	assert.Equal(t, []string{"https://dashboard.example.org"}, response.Config.CORS.AllowedOrigins)
+	assert.Equal(t, "15s", response.Config.Server.ReadTimeout)
+}
+
+func TestAdminHandler_UpdateMaintenanceMode(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	storage := services.NewStorageService()
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel) // Reduce log noise in tests
+
+	errorHandler := services.NewErrorHandler(logger)
+	consensusService := services.NewConsensusService(storage, logger)
+	maintenance := services.NewMaintenanceMode()
+
+	adminHandler := NewAdminHandler(storage, services.NewRetentionService(storage, logger), services.NewWalletActivityService(storage, logger), errorHandler, logger)
+	adminHandler.SetMaintenanceMode(maintenance)
+	submissionHandler := NewSubmissionHandler(storage, services.NewValidationService(storage), consensusService, services.NewConsensusRecoveryService(storage, consensusService, logger), errorHandler, logger)
+	submissionHandler.SetMaintenanceMode(maintenance)
+	tallyHandler := NewTallyHandler(services.NewTallyService(storage, logger), errorHandler, logger)
+
+	router := gin.New()
+	api := router.Group("/api/v1")
+	{
+		api.POST("/admin/maintenance", adminHandler.UpdateMaintenanceMode)
+		api.POST("/submitResult", submissionHandler.SubmitResult)
+		api.GET("/getTally/:votingProcessId", tallyHandler.GetTally)
+	}
+
+	require.NoError(t, storage.StoreVotingProcess(models.VotingProcess{
+		ID:              "maintenance-process",
+		Title:           "Maintenance Election",
+		Position:        "President",
+		Candidates:      []models.Candidate{{ID: "c1", Name: "Alice"}, {ID: "c2", Name: "Bob"}},
+		PollingStations: []string{"maintenance-station"},
+		Status:          "Setup",
+		CreatedAt:       time.Now(),
+	}))
+	require.NoError(t, storage.UpdateVotingProcessStatus("maintenance-process", "Active"))
+
+	post := func(path, body string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest("POST", path, bytes.NewBufferString(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+	submit := func(i int) *httptest.ResponseRecorder {
+		body, err := json.Marshal(models.SubmissionRequest{
+			WalletAddress:    fmt.Sprintf("5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQ%c", 'A'+i),
+			PollingStationID: "maintenance-station",
+			GPSCoordinates:   models.GPSCoordinates{Latitude: -1.292066, Longitude: 36.821946},
+			Timestamp:        time.Now(),
+			Results:          map[string]int{"Alice": 10, "Bob": 5},
+			SubmissionType:   "image_ocr",
+			Confidence:       0.9,
+		})
+		require.NoError(t, err)
+		return post("/api/v1/submitResult", string(body))
+	}
+	getTally := func() *httptest.ResponseRecorder {
+		req, err := http.NewRequest("GET", "/api/v1/getTally/maintenance-process", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	require.Equal(t, http.StatusOK, submit(0).Code)
+
+	t.Run("EnabledRejectsSubmissions", func(t *testing.T) {
+		w := post("/api/v1/admin/maintenance", `{"enabled": true}`)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		var response map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, true, response["maintenance_enabled"])
+		assert.True(t, maintenance.Enabled())
+
+		w = submit(1)
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+		var errorResponse models.ErrorResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &errorResponse))
+		assert.Equal(t, "MAINTENANCE_MODE", errorResponse.Code)
+		assert.Len(t, storage.GetSubmissionsByStation("maintenance-station"), 1, "rejected submission must not be stored")
+
+		// Reads keep working and existing data is untouched
+		assert.Equal(t, http.StatusOK, getTally().Code)
+	})
+
+	t.Run("DisabledAcceptsSubmissionsAgain", func(t *testing.T) {
+		w := post("/api/v1/admin/maintenance", `{"enabled": false}`)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		assert.False(t, maintenance.Enabled())
+
+		assert.Equal(t, http.StatusOK, submit(2).Code)
+		assert.Len(t, storage.GetSubmissionsByStation("maintenance-station"), 2)
+	})
+
+	t.Run("RequiresEnabledField", func(t *testing.T) {
+		w := post("/api/v1/admin/maintenance", `{}`)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.False(t, maintenance.Enabled())
+	})
+}
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+
+	"oyah-backend/internal/services"
+)
+
+// unknownFieldError reports a JSON payload field the target struct does not define
+type unknownFieldError struct {
+	Field string
+}
+
+func (e *unknownFieldError) Error() string {
+	return fmt.Sprintf("json: unknown field %q", e.Field)
+}
+
+// bindStrictJSON binds a JSON request body like ShouldBindJSON, but rejects fields the target struct does not
+// define so a misspelled field is reported as such instead of surfacing later as a missing value
+func bindStrictJSON(c *gin.Context, obj interface{}) error {
+	if c.Request == nil || c.Request.Body == nil {
+		return errors.New("invalid request")
+	}
+
+	decoder := json.NewDecoder(c.Request.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(obj); err != nil {
+		// encoding/json has no typed error for unknown fields, only this message
+		if field, found := strings.CutPrefix(err.Error(), "json: unknown field "); found {
+			return &unknownFieldError{Field: strings.Trim(field, `"`)}
+		}
+		return err
+	}
+
+	return binding.Validator.ValidateStruct(obj)
+}
+
+// unknownFieldAPIError converts a strict binding error for an unknown field into an UNKNOWN_FIELD API error
+func unknownFieldAPIError(err error) *services.APIError {
+	var fieldErr *unknownFieldError
+	if !errors.As(err, &fieldErr) {
+		return nil
+	}
+
+	return services.NewAPIError(
+		services.ErrorTypeUnknownField,
+		"Unknown field in request",
+		fmt.Sprintf("Field %q is not recognised; check its spelling", fieldErr.Field),
+		http.StatusBadRequest,
+	)
+}
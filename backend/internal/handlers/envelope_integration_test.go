@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oyah-backend/internal/middleware"
+	"oyah-backend/internal/models"
+	"oyah-backend/internal/services"
+)
+
+func setupEnvelopeTestRouter(envelope bool) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	storage := services.NewStorageService()
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel) // Reduce noise in tests
+
+	votingProcessHandler := NewVotingProcessHandler(storage, logger)
+	tallyHandler := NewTallyHandler(services.NewTallyService(storage, logger), services.NewErrorHandler(logger), logger)
+
+	router := gin.New()
+	v1 := router.Group("/api/v1")
+	if envelope {
+		v1.Use(middleware.ResponseEnvelopeMiddleware())
+	}
+	{
+		v1.POST("/voting-process", votingProcessHandler.CreateVotingProcess)
+		v1.GET("/getTally/:votingProcessId", tallyHandler.GetTally)
+	}
+
+	return router
+}
+
+// createEnvelopeTestProcess creates a voting process and returns the raw response
+func createEnvelopeTestProcess(t *testing.T, router *gin.Engine) *httptest.ResponseRecorder {
+	reqBody, err := json.Marshal(models.VotingProcessRequest{
+		Title:    "Envelope Test Election",
+		Position: "President",
+		Candidates: []models.Candidate{
+			{ID: "candidate-1", Name: "Alice Johnson"},
+			{ID: "candidate-2", Name: "Bob Smith"},
+		},
+		PollingStations: []string{"station-1"},
+	})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("POST", "/api/v1/voting-process", bytes.NewBuffer(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	return w
+}
+
+func TestResponseEnvelope(t *testing.T) {
+	t.Run("EnabledWrapsVotingProcessAndTally", func(t *testing.T) {
+		router := setupEnvelopeTestRouter(true)
+
+		w := createEnvelopeTestProcess(t, router)
+		var created struct {
+			Success   bool   `json:"success"`
+			Timestamp string `json:"timestamp"`
+			Data      struct {
+				Success       *bool                `json:"success"`
+				VotingProcess models.VotingProcess `json:"voting_process"`
+			} `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+		assert.True(t, created.Success)
+		assert.NotEmpty(t, created.Timestamp)
+		assert.Nil(t, created.Data.Success, "success flag should move to the envelope")
+		require.NotEmpty(t, created.Data.VotingProcess.ID)
+
+		req := httptest.NewRequest("GET", "/api/v1/getTally/"+created.Data.VotingProcess.ID, nil)
+		w = httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var tally struct {
+			Success   bool                   `json:"success"`
+			Timestamp string                 `json:"timestamp"`
+			Data      services.TallyResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &tally))
+		assert.True(t, tally.Success)
+		assert.NotEmpty(t, tally.Timestamp)
+		assert.Equal(t, created.Data.VotingProcess.ID, tally.Data.VotingProcess.ID)
+	})
+
+	t.Run("EnabledKeepsErrorResponseShape", func(t *testing.T) {
+		router := setupEnvelopeTestRouter(true)
+
+		req := httptest.NewRequest("GET", "/api/v1/getTally/missing-process", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		var body map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		assert.NotEmpty(t, body["code"])
+		assert.NotContains(t, body, "data")
+	})
+
+	t.Run("DisabledKeepsOldShapes", func(t *testing.T) {
+		router := setupEnvelopeTestRouter(false)
+
+		w := createEnvelopeTestProcess(t, router)
+		var created map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+		assert.Equal(t, true, created["success"])
+		assert.Contains(t, created, "voting_process")
+		assert.NotContains(t, created, "data")
+	})
+}
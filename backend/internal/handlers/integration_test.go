@@ -671,7 +671,7 @@ func TestPerformanceUnderLoad(t *testing.T) {
 	votingProcessReq := models.VotingProcessRequest{
 		Title:           "Performance Test Election",
 		Position:        "Representative",
-		Candidates:      []models.Candidate{{ID: "candidate-1", Name: "Test Candidate"}},
+		Candidates:      []models.Candidate{{ID: "candidate-1", Name: "Test Candidate"}, {ID: "candidate-2", Name: "Other Candidate"}},
 		PollingStations: pollingStations,
 	}
 
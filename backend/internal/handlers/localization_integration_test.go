@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oyah-backend/internal/middleware"
+	"oyah-backend/internal/models"
+	"oyah-backend/internal/services"
+)
+
+func setupLocalizationTestRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	storage := services.NewStorageService()
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel) // Reduce noise in tests
+
+	votingProcessHandler := NewVotingProcessHandler(storage, logger)
+	tallyHandler := NewTallyHandler(services.NewTallyService(storage, logger), services.NewErrorHandler(logger), logger)
+
+	router := gin.New()
+	v1 := router.Group("/api/v1")
+	v1.Use(middleware.ErrorLocalizationMiddleware(services.NewErrorTranslator([]string{"fr", "sw"})))
+	{
+		v1.POST("/voting-process", votingProcessHandler.CreateVotingProcess)
+		v1.GET("/getTally/:votingProcessId", tallyHandler.GetTally)
+	}
+
+	return router
+}
+
+// postInvalidVotingProcess sends a voting process whose only candidate fails validation
+func postInvalidVotingProcess(t *testing.T, router *gin.Engine, acceptLanguage string) *httptest.ResponseRecorder {
+	reqBody, err := json.Marshal(models.VotingProcessRequest{
+		Title:           "Localization Test Election",
+		Position:        "President",
+		Candidates:      []models.Candidate{{ID: "candidate-1", Name: "Alice Johnson"}},
+		PollingStations: []string{"station-1"},
+	})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("POST", "/api/v1/voting-process", bytes.NewBuffer(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+	if acceptLanguage != "" {
+		req.Header.Set("Accept-Language", acceptLanguage)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	return w
+}
+
+func TestErrorLocalization(t *testing.T) {
+	router := setupLocalizationTestRouter()
+
+	english := postInvalidVotingProcess(t, router, "")
+	var englishBody models.ErrorResponse
+	require.NoError(t, json.Unmarshal(english.Body.Bytes(), &englishBody))
+	require.NotEmpty(t, englishBody.Code)
+
+	t.Run("TranslatesMessageAndKeepsCode", func(t *testing.T) {
+		w := postInvalidVotingProcess(t, router, "fr-CA,fr;q=0.9,en;q=0.5")
+
+		var body models.ErrorResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		assert.Equal(t, englishBody.Code, body.Code)
+		assert.NotEqual(t, englishBody.Error, body.Error)
+		assert.Equal(t, "fr", w.Header().Get("Content-Language"))
+	})
+
+	t.Run("ValidationErrorInFrench", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/api/v1/voting-process", bytes.NewBufferString(`{"title": "Missing fields"}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Accept-Language", "fr")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+
+		var body models.ErrorResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		assert.Equal(t, "INVALID_JSON", body.Code)
+		assert.Equal(t, "Contenu JSON invalide", body.Error)
+		assert.NotEmpty(t, body.Details, "binding details should be kept")
+	})
+
+	t.Run("FallsBackToEnglish", func(t *testing.T) {
+		for _, acceptLanguage := range []string{"de", "en,fr;q=0.8", "fr;q=0"} {
+			w := postInvalidVotingProcess(t, router, acceptLanguage)
+
+			var body models.ErrorResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+			assert.Equal(t, englishBody, body, acceptLanguage)
+			assert.Empty(t, w.Header().Get("Content-Language"), acceptLanguage)
+		}
+	})
+
+	t.Run("LeavesSuccessfulResponsesAlone", func(t *testing.T) {
+		reqBody, err := json.Marshal(models.VotingProcessRequest{
+			Title:    "Localization Test Election",
+			Position: "President",
+			Candidates: []models.Candidate{
+				{ID: "candidate-1", Name: "Alice Johnson"},
+				{ID: "candidate-2", Name: "Bob Smith"},
+			},
+			PollingStations: []string{"station-1"},
+		})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest("POST", "/api/v1/voting-process", bytes.NewBuffer(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Accept-Language", "sw")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		var created map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+		assert.Equal(t, true, created["success"])
+		assert.Empty(t, w.Header().Get("Content-Language"))
+	})
+}
@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"oyah-backend/internal/services"
+)
+
+// MetricsHandler exposes service metrics for scraping
+type MetricsHandler struct {
+	registry *services.MetricsRegistry
+	logger   *logrus.Logger
+}
+
+// NewMetricsHandler creates a new metrics handler
+func NewMetricsHandler(registry *services.MetricsRegistry, logger *logrus.Logger) *MetricsHandler {
+	return &MetricsHandler{
+		registry: registry,
+		logger:   logger,
+	}
+}
+
+// GetMetrics handles GET /metrics requests in the Prometheus text exposition format
+func (h *MetricsHandler) GetMetrics(c *gin.Context) {
+	c.Header("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	c.Status(http.StatusOK)
+
+	if err := h.registry.WritePrometheus(c.Writer); err != nil {
+		h.logger.WithError(err).Error("Failed to write metrics")
+	}
+}
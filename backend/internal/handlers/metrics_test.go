@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"oyah-backend/internal/services"
+)
+
+func TestMetricsHandler_GetMetrics(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+
+	storage := services.NewStorageService()
+	consensusService := services.NewConsensusService(storage, logger)
+	registry := services.NewMetricsRegistry()
+	registry.Register(consensusService.Metrics()...)
+
+	handler := NewMetricsHandler(registry, logger)
+	router := gin.New()
+	router.GET("/metrics", handler.GetMetrics)
+
+	req, _ := http.NewRequest("GET", "/metrics", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Header().Get("Content-Type"), "text/plain")
+
+	body := w.Body.String()
+	assert.Contains(t, body, "# TYPE oyah_consensus_verified_total counter")
+	assert.Contains(t, body, "oyah_consensus_verified_total 0")
+	assert.Contains(t, body, "oyah_consensus_pending_total 0")
+	assert.Contains(t, body, "oyah_consensus_tie_total 0")
+}
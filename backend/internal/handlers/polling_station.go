@@ -0,0 +1,656 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"oyah-backend/internal/models"
+	"oyah-backend/internal/services"
+)
+
+// PollingStationHandler handles polling station related HTTP requests
+type PollingStationHandler struct {
+	storageService   *services.StorageService
+	tallyService     *services.TallyService
+	consensusService *services.ConsensusService // Optional; required for verify conditions and adjudication
+	anonymizer       *services.WalletAnonymizer
+	errorHandler     *services.ErrorHandler
+	logger           *logrus.Logger
+}
+
+// NewPollingStationHandler creates a new polling station handler
+func NewPollingStationHandler(storage *services.StorageService, tallyService *services.TallyService, errorHandler *services.ErrorHandler, logger *logrus.Logger) *PollingStationHandler {
+	return &PollingStationHandler{
+		storageService: storage,
+		tallyService:   tallyService,
+		anonymizer:     services.NewWalletAnonymizer(""),
+		errorHandler:   errorHandler,
+		logger:         logger,
+	}
+}
+
+// SetConsensusService sets the consensus service used to explain what would verify a pending station
+func (h *PollingStationHandler) SetConsensusService(consensusService *services.ConsensusService) {
+	h.consensusService = consensusService
+}
+
+// SetWalletAnonymizer sets the anonymizer applied to wallet addresses in these public responses
+func (h *PollingStationHandler) SetWalletAnonymizer(anonymizer *services.WalletAnonymizer) {
+	h.anonymizer = anonymizer
+}
+
+// GetStationSubmissions handles GET /api/v1/polling-station/{id}/submissions requests
+func (h *PollingStationHandler) GetStationSubmissions(c *gin.Context) {
+	// Generate request ID for tracing
+	requestID := uuid.New().String()
+	c.Set("request_id", requestID)
+
+	// Get polling station ID from URL parameter
+	stationID := c.Param("id")
+
+	// Create logger with request context
+	logger := h.logger.WithFields(logrus.Fields{
+		"request_id":         requestID,
+		"endpoint":           "getStationSubmissions",
+		"method":             c.Request.Method,
+		"client_ip":          c.ClientIP(),
+		"polling_station_id": stationID,
+	})
+
+	logger.Info("Processing get station submissions request")
+
+	station, err := h.storageService.GetPollingStation(stationID)
+	if err != nil {
+		h.errorHandler.HandleNotFoundError(c, "polling station", stationID)
+		return
+	}
+
+	// Raw submissions are no longer available once a station has been compacted
+	if station.Compacted {
+		logger.Info("Polling station submissions have been compacted")
+		c.JSON(http.StatusOK, gin.H{
+			"success":            true,
+			"polling_station_id": stationID,
+			"compacted":          true,
+			"summary":            station.SubmissionSummary,
+		})
+		return
+	}
+
+	// Each ?tag=key:value narrows the list to submissions carrying that tag
+	tagFilters := make(map[string]string)
+	for _, filter := range c.QueryArray("tag") {
+		key, value, found := strings.Cut(filter, ":")
+		if !found || key == "" {
+			h.errorHandler.HandleError(c, services.NewAPIError(
+				services.ErrorTypeValidation,
+				"Invalid tag filter",
+				fmt.Sprintf("tag filter %q must have the form key:value", filter),
+				http.StatusBadRequest,
+			), map[string]interface{}{"polling_station_id": stationID})
+			return
+		}
+		tagFilters[key] = value
+	}
+
+	// Vote counts of an embargoed process stay hidden until it is Complete, as in the tally
+	embargoed := h.storageService.IsPollingStationEmbargoed(stationID)
+
+	submissions := filterSubmissionsByTags(h.storageService.GetSubmissionsByStation(stationID), tagFilters)
+	for i := range submissions {
+		submissions[i].WalletAddress = h.anonymizer.Anonymize(submissions[i].WalletAddress)
+		if embargoed {
+			submissions[i].Results = nil
+		}
+	}
+
+	logger.WithFields(logrus.Fields{
+		"submission_count": len(submissions),
+		"embargoed":        embargoed,
+	}).Info("Station submissions retrieved successfully")
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":            true,
+		"polling_station_id": stationID,
+		"compacted":          false,
+		"embargoed":          embargoed,
+		"submissions":        submissions,
+	})
+}
+
+// filterSubmissionsByTags keeps the submissions carrying every one of the given tags
+func filterSubmissionsByTags(submissions []models.Submission, tags map[string]string) []models.Submission {
+	if len(tags) == 0 {
+		return submissions
+	}
+
+	filtered := make([]models.Submission, 0, len(submissions))
+	for _, submission := range submissions {
+		matches := true
+		for key, value := range tags {
+			if tagValue, ok := submission.Tags[key]; !ok || tagValue != value {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			filtered = append(filtered, submission)
+		}
+	}
+	return filtered
+}
+
+// GetStationWitnesses handles GET /api/v1/polling-station/{id}/witnesses requests
+func (h *PollingStationHandler) GetStationWitnesses(c *gin.Context) {
+	// Generate request ID for tracing
+	requestID := uuid.New().String()
+	c.Set("request_id", requestID)
+
+	// Get polling station ID from URL parameter
+	stationID := c.Param("id")
+
+	// Create logger with request context
+	logger := h.logger.WithFields(logrus.Fields{
+		"request_id":         requestID,
+		"endpoint":           "getStationWitnesses",
+		"method":             c.Request.Method,
+		"client_ip":          c.ClientIP(),
+		"polling_station_id": stationID,
+	})
+
+	logger.Info("Processing get station witnesses request")
+
+	if _, err := h.storageService.GetPollingStation(stationID); err != nil {
+		h.errorHandler.HandleNotFoundError(c, "polling station", stationID)
+		return
+	}
+
+	submissions := h.storageService.GetSubmissionsByStation(stationID)
+	witnesses := make([]models.Witness, 0, len(submissions))
+	for _, submission := range submissions {
+		witnesses = append(witnesses, models.Witness{
+			WalletAddress:  h.anonymizer.Anonymize(submission.WalletAddress),
+			SubmissionID:   submission.ID,
+			SubmissionType: submission.SubmissionType,
+			SubmittedAt:    submission.ProcessedAt,
+		})
+	}
+
+	logger.WithField("witness_count", len(witnesses)).Info("Station witnesses retrieved successfully")
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":            true,
+		"polling_station_id": stationID,
+		"anonymized":         h.anonymizer.IsEnabled(),
+		"witnesses":          witnesses,
+	})
+}
+
+// GetStationTypeBreakdown handles GET /api/v1/polling-station/{id}/type-breakdown requests
+func (h *PollingStationHandler) GetStationTypeBreakdown(c *gin.Context) {
+	// Generate request ID for tracing
+	requestID := uuid.New().String()
+	c.Set("request_id", requestID)
+
+	// Get polling station ID from URL parameter
+	stationID := c.Param("id")
+
+	// Create logger with request context
+	logger := h.logger.WithFields(logrus.Fields{
+		"request_id":         requestID,
+		"endpoint":           "getStationTypeBreakdown",
+		"method":             c.Request.Method,
+		"client_ip":          c.ClientIP(),
+		"polling_station_id": stationID,
+	})
+
+	logger.Info("Processing get station type breakdown request")
+
+	station, err := h.storageService.GetPollingStation(stationID)
+	if err != nil {
+		h.errorHandler.HandleNotFoundError(c, "polling station", stationID)
+		return
+	}
+
+	breakdown := services.BreakdownSubmissionTypes(station, h.storageService.GetSubmissionsByStation(stationID))
+
+	logger.WithFields(logrus.Fields{
+		"total_submissions": breakdown.TotalSubmissions,
+		"compacted":         breakdown.Compacted,
+	}).Info("Station type breakdown retrieved successfully")
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":   true,
+		"breakdown": breakdown,
+	})
+}
+
+// GetStationResult handles GET /api/v1/polling-station/{id}/result requests.
+// It returns only a verified station's result map, confidence and consensus time, without the status wrapper.
+func (h *PollingStationHandler) GetStationResult(c *gin.Context) {
+	// Generate request ID for tracing
+	requestID := uuid.New().String()
+	c.Set("request_id", requestID)
+
+	// Get polling station ID from URL parameter
+	stationID := c.Param("id")
+
+	// Create logger with request context
+	logger := h.logger.WithFields(logrus.Fields{
+		"request_id":         requestID,
+		"endpoint":           "getStationResult",
+		"method":             c.Request.Method,
+		"client_ip":          c.ClientIP(),
+		"polling_station_id": stationID,
+	})
+
+	logger.Info("Processing get station result request")
+
+	station, err := h.storageService.GetPollingStation(stationID)
+	if err != nil {
+		h.errorHandler.HandleNotFoundError(c, "polling station", stationID)
+		return
+	}
+
+	if station.Status != models.StationStatusVerified {
+		h.errorHandler.HandleError(c, services.NewAPIError(
+			services.ErrorTypeConflict,
+			"Polling station has no verified result yet",
+			fmt.Sprintf("polling station %s is %s; its result is available once consensus verifies it", stationID, station.Status),
+			http.StatusConflict,
+		), map[string]interface{}{"polling_station_id": stationID, "status": station.Status})
+		return
+	}
+
+	// Vote counts of an embargoed process stay hidden until it is Complete, as in the tally
+	if station.VotingProcessID != "" {
+		if process, err := h.storageService.GetVotingProcess(station.VotingProcessID); err == nil && process.ResultsEmbargoed() {
+			h.errorHandler.HandleError(c, services.NewAPIError(
+				services.ErrorTypeConflict,
+				"Polling station result is embargoed",
+				fmt.Sprintf("voting process %s publishes results only once it is Complete", process.ID),
+				http.StatusConflict,
+			), map[string]interface{}{"polling_station_id": stationID})
+			return
+		}
+	}
+
+	logger.WithField("confidence_level", station.ConfidenceLevel).Info("Station result retrieved successfully")
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":            true,
+		"polling_station_id": stationID,
+		"results":            station.VerifiedResults,
+		"confidence":         station.ConfidenceLevel,
+		"agreement_score":    station.AgreementScore,
+		"consensus_reached":  station.ConsensusReached,
+	})
+}
+
+// GetStationStatus handles GET /api/v1/polling-station/{id}/status requests.
+// Besides the station's status it reports when its first and most recent submissions arrived, so operators can
+// spot stations that stopped reporting.
+func (h *PollingStationHandler) GetStationStatus(c *gin.Context) {
+	// Generate request ID for tracing
+	requestID := uuid.New().String()
+	c.Set("request_id", requestID)
+
+	// Get polling station ID from URL parameter
+	stationID := c.Param("id")
+
+	// Create logger with request context
+	logger := h.logger.WithFields(logrus.Fields{
+		"request_id":         requestID,
+		"endpoint":           "getStationStatus",
+		"method":             c.Request.Method,
+		"client_ip":          c.ClientIP(),
+		"polling_station_id": stationID,
+	})
+
+	logger.Info("Processing get station status request")
+
+	station, err := h.storageService.GetPollingStation(stationID)
+	if err != nil {
+		h.errorHandler.HandleNotFoundError(c, "polling station", stationID)
+		return
+	}
+
+	logger.WithField("status", station.Status).Info("Station status retrieved successfully")
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":             true,
+		"polling_station_id":  stationID,
+		"voting_process_id":   station.VotingProcessID,
+		"status":              station.Status,
+		"submission_count":    len(h.storageService.GetSubmissionsByStation(stationID)),
+		"first_submission_at": station.FirstSubmissionAt,
+		"last_submission_at":  station.LastSubmissionAt,
+	})
+}
+
+// GetStationConsensus handles GET /api/v1/polling-station/{id}/consensus requests.
+// It reports the station's consensus status with the effective threshold, the agreeing wallets its result needs
+// once threshold scaling is applied to its submission volume, so observers see exactly what was required.
+func (h *PollingStationHandler) GetStationConsensus(c *gin.Context) {
+	// Generate request ID for tracing
+	requestID := uuid.New().String()
+	c.Set("request_id", requestID)
+
+	// Get polling station ID from URL parameter
+	stationID := c.Param("id")
+
+	// Create logger with request context
+	logger := h.logger.WithFields(logrus.Fields{
+		"request_id":         requestID,
+		"endpoint":           "getStationConsensus",
+		"method":             c.Request.Method,
+		"client_ip":          c.ClientIP(),
+		"polling_station_id": stationID,
+	})
+
+	logger.Info("Processing get station consensus request")
+
+	if h.consensusService == nil {
+		h.errorHandler.HandleError(c, services.NewAPIError(
+			services.ErrorTypeServiceError,
+			"Consensus service unavailable",
+			"Consensus status requires the consensus service",
+			http.StatusServiceUnavailable,
+		), map[string]interface{}{"polling_station_id": stationID})
+		return
+	}
+
+	station, err := h.storageService.GetPollingStation(stationID)
+	if err != nil {
+		h.errorHandler.HandleNotFoundError(c, "polling station", stationID)
+		return
+	}
+
+	consensus, err := h.consensusService.GetConsensusStatus(stationID)
+	if err != nil {
+		h.errorHandler.HandleServiceError(c, err, "consensus", "get_consensus_status")
+		return
+	}
+
+	// Vote counts of an embargoed process stay hidden until it is Complete, as in the tally
+	if station.VotingProcessID != "" {
+		if process, err := h.storageService.GetVotingProcess(station.VotingProcessID); err == nil && process.ResultsEmbargoed() {
+			consensus.VerifiedResults = nil
+		}
+	}
+
+	logger.WithFields(logrus.Fields{
+		"status":              consensus.Status,
+		"effective_threshold": consensus.EffectiveThreshold,
+	}).Info("Station consensus retrieved successfully")
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":            true,
+		"polling_station_id": stationID,
+		"consensus":          consensus,
+	})
+}
+
+// GetVerifyConditions handles GET /api/v1/polling-station/{id}/verify-conditions requests.
+// It reports the least strict threshold, agreeing-wallet minimum and majority under which a pending station would verify.
+func (h *PollingStationHandler) GetVerifyConditions(c *gin.Context) {
+	// Generate request ID for tracing
+	requestID := uuid.New().String()
+	c.Set("request_id", requestID)
+
+	// Get polling station ID from URL parameter
+	stationID := c.Param("id")
+
+	// Create logger with request context
+	logger := h.logger.WithFields(logrus.Fields{
+		"request_id":         requestID,
+		"endpoint":           "getVerifyConditions",
+		"method":             c.Request.Method,
+		"client_ip":          c.ClientIP(),
+		"polling_station_id": stationID,
+	})
+
+	logger.Info("Processing get verify conditions request")
+
+	if h.consensusService == nil {
+		h.errorHandler.HandleError(c, services.NewAPIError(
+			services.ErrorTypeServiceError,
+			"Consensus service unavailable",
+			"Verify conditions require the consensus service",
+			http.StatusServiceUnavailable,
+		), map[string]interface{}{"polling_station_id": stationID})
+		return
+	}
+
+	station, err := h.storageService.GetPollingStation(stationID)
+	if err != nil {
+		h.errorHandler.HandleNotFoundError(c, "polling station", stationID)
+		return
+	}
+
+	if station.Status != models.StationStatusPending {
+		h.errorHandler.HandleError(c, services.NewAPIError(
+			services.ErrorTypeConflict,
+			"Polling station is not pending",
+			fmt.Sprintf("polling station %s is %s; verify conditions are only reported for Pending stations", stationID, station.Status),
+			http.StatusConflict,
+		), map[string]interface{}{"polling_station_id": stationID, "status": station.Status})
+		return
+	}
+
+	conditions, err := h.consensusService.GetVerifyConditions(stationID)
+	if err != nil {
+		h.errorHandler.HandleServiceError(c, err, "consensus", "get_verify_conditions")
+		return
+	}
+
+	// Vote counts of an embargoed process stay hidden until it is Complete, as in the tally
+	if station.VotingProcessID != "" {
+		if process, err := h.storageService.GetVotingProcess(station.VotingProcessID); err == nil && process.ResultsEmbargoed() {
+			conditions.LargestGroupResults = nil
+		}
+	}
+
+	logger.WithFields(logrus.Fields{
+		"largest_group_wallets": conditions.LargestGroupWallets,
+		"total_submissions":     conditions.TotalSubmissions,
+		"blockers":              len(conditions.Blockers),
+	}).Info("Verify conditions retrieved successfully")
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":    true,
+		"conditions": conditions,
+	})
+}
+
+// GetAdjudication handles GET /api/v1/polling-station/{id}/adjudication requests.
+// It returns every submission to the station in full, grouped by result, for an official deciding a challenge.
+// The route must be registered behind admin authentication: wallet addresses are never anonymized here.
+func (h *PollingStationHandler) GetAdjudication(c *gin.Context) {
+	// Generate request ID for tracing
+	requestID := uuid.New().String()
+	c.Set("request_id", requestID)
+
+	// Get polling station ID from URL parameter
+	stationID := c.Param("id")
+
+	// Create logger with request context
+	logger := h.logger.WithFields(logrus.Fields{
+		"request_id":         requestID,
+		"endpoint":           "getAdjudication",
+		"method":             c.Request.Method,
+		"client_ip":          c.ClientIP(),
+		"polling_station_id": stationID,
+		"actor":              requestActor(c),
+	})
+
+	logger.Info("Processing get adjudication request")
+
+	if h.consensusService == nil {
+		h.errorHandler.HandleError(c, services.NewAPIError(
+			services.ErrorTypeServiceError,
+			"Consensus service unavailable",
+			"Adjudication requires the consensus service",
+			http.StatusServiceUnavailable,
+		), map[string]interface{}{"polling_station_id": stationID})
+		return
+	}
+
+	if _, err := h.storageService.GetPollingStation(stationID); err != nil {
+		h.errorHandler.HandleNotFoundError(c, "polling station", stationID)
+		return
+	}
+
+	adjudication, err := h.consensusService.GetAdjudication(stationID)
+	if err != nil {
+		h.errorHandler.HandleServiceError(c, err, "consensus", "get_adjudication")
+		return
+	}
+
+	logger.WithFields(logrus.Fields{
+		"groups":            len(adjudication.Groups),
+		"total_submissions": adjudication.TotalSubmissions,
+		"flagged":           len(adjudication.FlaggedSubmissions),
+	}).Info("Adjudication retrieved successfully")
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":      true,
+		"adjudication": adjudication,
+	})
+}
+
+// GetStationSheet handles GET /api/v1/polling-station/{id}/sheet requests
+func (h *PollingStationHandler) GetStationSheet(c *gin.Context) {
+	// Generate request ID for tracing
+	requestID := uuid.New().String()
+	c.Set("request_id", requestID)
+
+	// Get polling station ID from URL parameter
+	stationID := c.Param("id")
+
+	// Create logger with request context
+	logger := h.logger.WithFields(logrus.Fields{
+		"request_id":         requestID,
+		"endpoint":           "getStationSheet",
+		"method":             c.Request.Method,
+		"client_ip":          c.ClientIP(),
+		"polling_station_id": stationID,
+	})
+
+	logger.Info("Processing get station tally sheet request")
+
+	sheet, err := h.tallyService.GetStationTallySheet(stationID)
+	if err != nil {
+		h.errorHandler.HandleNotFoundError(c, "polling station", stationID)
+		return
+	}
+
+	logger.WithField("verified", sheet.Verified).Info("Station tally sheet generated successfully")
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"sheet":   sheet,
+	})
+}
+
+// ChallengeStation handles POST /api/v1/polling-station/{id}/challenge requests.
+// It records an observer's dispute of a verified result; the station keeps counting but is flagged as Challenged in the tally.
+func (h *PollingStationHandler) ChallengeStation(c *gin.Context) {
+	// Generate request ID for tracing
+	requestID := uuid.New().String()
+	c.Set("request_id", requestID)
+
+	// Get polling station ID from URL parameter
+	stationID := c.Param("id")
+
+	// Create logger with request context
+	logger := h.logger.WithFields(logrus.Fields{
+		"request_id":         requestID,
+		"endpoint":           "challengeStation",
+		"method":             c.Request.Method,
+		"client_ip":          c.ClientIP(),
+		"polling_station_id": stationID,
+	})
+
+	logger.Info("Processing challenge station request")
+
+	var req models.ChallengeStationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.errorHandler.HandleValidationError(c, err, "json_payload")
+		return
+	}
+	for candidate, votes := range req.DisputedResults {
+		if votes < 0 {
+			h.errorHandler.HandleError(c, services.NewAPIError(
+				services.ErrorTypeValidation,
+				"Invalid disputed results",
+				fmt.Sprintf("Vote count for %q cannot be negative", candidate),
+				http.StatusBadRequest,
+			), map[string]interface{}{"polling_station_id": stationID})
+			return
+		}
+	}
+
+	station, err := h.storageService.GetPollingStation(stationID)
+	if err != nil {
+		h.errorHandler.HandleNotFoundError(c, "polling station", stationID)
+		return
+	}
+
+	// Only a verified result can be disputed, and only one challenge is open at a time
+	if station.Status != models.StationStatusVerified {
+		h.errorHandler.HandleError(c, services.NewAPIError(
+			services.ErrorTypeConflict,
+			"Polling station is not verified",
+			"Only a verified polling station result can be challenged",
+			http.StatusConflict,
+		), map[string]interface{}{"polling_station_id": stationID})
+		return
+	}
+	if station.Challenge.Open() {
+		h.errorHandler.HandleError(c, services.NewAPIError(
+			services.ErrorTypeConflict,
+			"Polling station is already challenged",
+			fmt.Sprintf("Challenge %s must be resolved before filing another", station.Challenge.ID),
+			http.StatusConflict,
+		), map[string]interface{}{"polling_station_id": stationID})
+		return
+	}
+
+	challenge, err := h.storageService.FileStationChallenge(stationID, models.StationChallenge{
+		ID:              uuid.New().String(),
+		Challenger:      req.Challenger,
+		Reason:          req.Reason,
+		DisputedResults: req.DisputedResults,
+		FiledAt:         time.Now(),
+	})
+	if err != nil {
+		h.errorHandler.HandleServiceError(c, err, "storage", "file_station_challenge")
+		return
+	}
+
+	logger.WithFields(logrus.Fields{
+		"challenge_id": challenge.ID,
+		"challenger":   challenge.Challenger,
+	}).Info("Polling station challenged")
+
+	// The challenge records the verified result, which must not be read back while the process is embargoed
+	embargoed := h.storageService.IsPollingStationEmbargoed(stationID)
+	if embargoed {
+		challenge.VerifiedResults = nil
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success":            true,
+		"polling_station_id": stationID,
+		"status":             models.StationStatusChallenged,
+		"embargoed":          embargoed,
+		"challenge":          challenge,
+	})
+}
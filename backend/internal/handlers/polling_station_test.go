@@ -0,0 +1,752 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oyah-backend/internal/middleware"
+	"oyah-backend/internal/models"
+	"oyah-backend/internal/services"
+)
+
+func setupPollingStationTestRouter() (*gin.Engine, *services.StorageService) {
+	gin.SetMode(gin.TestMode)
+
+	storage := services.NewStorageService()
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel) // Reduce log noise in tests
+
+	errorHandler := services.NewErrorHandler(logger)
+	tallyService := services.NewTallyService(storage, logger)
+	handler := NewPollingStationHandler(storage, tallyService, errorHandler, logger)
+	handler.SetConsensusService(services.NewConsensusService(storage, logger))
+
+	router := gin.New()
+	api := router.Group("/api/v1")
+	{
+		api.GET("/polling-station/:id/submissions", handler.GetStationSubmissions)
+		api.GET("/polling-station/:id/sheet", handler.GetStationSheet)
+		api.GET("/polling-station/:id/type-breakdown", handler.GetStationTypeBreakdown)
+		api.GET("/polling-station/:id/result", handler.GetStationResult)
+		api.GET("/polling-station/:id/status", handler.GetStationStatus)
+		api.GET("/polling-station/:id/verify-conditions", handler.GetVerifyConditions)
+	}
+
+	votingProcess := models.VotingProcess{
+		ID:       "vp-sheet",
+		Title:    "County Election",
+		Position: "Governor",
+		Candidates: []models.Candidate{
+			{ID: "c1", Name: "Alice Johnson"},
+			{ID: "c2", Name: "Bob Smith"},
+		},
+		PollingStations: []string{"SHEET001", "SHEET002"},
+		Status:          "Active",
+		CreatedAt:       time.Now(),
+	}
+	storage.StoreVotingProcess(votingProcess)
+
+	return router, storage
+}
+
+func getStationSheet(t *testing.T, router *gin.Engine, stationID string) (int, services.TallySheet) {
+	req, err := http.NewRequest("GET", "/api/v1/polling-station/"+stationID+"/sheet", nil)
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	var response struct {
+		Success bool                `json:"success"`
+		Sheet   services.TallySheet `json:"sheet"`
+	}
+	if w.Code == http.StatusOK {
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.True(t, response.Success)
+	}
+	return w.Code, response.Sheet
+}
+
+func TestPollingStationHandler_GetStationSubmissions_TagFilter(t *testing.T) {
+	router, storage := setupPollingStationTestRouter()
+
+	tags := []map[string]string{
+		{"org": "redcross", "device": "Pixel 7"},
+		{"org": "redcross", "device": "iPhone 15"},
+		{"org": "carter-center"},
+		nil,
+	}
+	for i, submissionTags := range tags {
+		require.NoError(t, storage.StoreSubmission(models.Submission{
+			ID:               fmt.Sprintf("tagged-sub-%d", i),
+			WalletAddress:    fmt.Sprintf("tagged-wallet-%d", i),
+			PollingStationID: "SHEET001",
+			Timestamp:        time.Now(),
+			Results:          map[string]int{"Alice Johnson": 10, "Bob Smith": 5},
+			SubmissionType:   "image_ocr",
+			Confidence:       0.9,
+			Tags:             submissionTags,
+		}))
+	}
+
+	getSubmissions := func(t *testing.T, query string) (int, []models.Submission) {
+		req, err := http.NewRequest("GET", "/api/v1/polling-station/SHEET001/submissions"+query, nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		var response struct {
+			Submissions []models.Submission `json:"submissions"`
+		}
+		if w.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		}
+		return w.Code, response.Submissions
+	}
+
+	t.Run("Unfiltered", func(t *testing.T) {
+		code, submissions := getSubmissions(t, "")
+		assert.Equal(t, http.StatusOK, code)
+		require.Len(t, submissions, 4)
+
+		stored := make(map[string]map[string]string, len(submissions))
+		for _, submission := range submissions {
+			stored[submission.ID] = submission.Tags
+		}
+		assert.Equal(t, tags[0], stored["tagged-sub-0"])
+		assert.Nil(t, stored["tagged-sub-3"])
+	})
+
+	t.Run("SingleTag", func(t *testing.T) {
+		code, submissions := getSubmissions(t, "?tag=org:redcross")
+		assert.Equal(t, http.StatusOK, code)
+		require.Len(t, submissions, 2)
+		for _, submission := range submissions {
+			assert.Equal(t, "redcross", submission.Tags["org"])
+		}
+	})
+
+	t.Run("SeveralTags", func(t *testing.T) {
+		code, submissions := getSubmissions(t, "?tag=org:redcross&tag=device:Pixel%207")
+		assert.Equal(t, http.StatusOK, code)
+		require.Len(t, submissions, 1)
+		assert.Equal(t, "tagged-sub-0", submissions[0].ID)
+	})
+
+	t.Run("NoMatch", func(t *testing.T) {
+		code, submissions := getSubmissions(t, "?tag=org:unknown")
+		assert.Equal(t, http.StatusOK, code)
+		assert.Empty(t, submissions)
+	})
+
+	t.Run("MalformedFilter", func(t *testing.T) {
+		code, _ := getSubmissions(t, "?tag=redcross")
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+}
+
+func TestPollingStationHandler_GetStationSubmissions_Embargo(t *testing.T) {
+	router, storage := setupPollingStationTestRouter()
+
+	require.NoError(t, storage.StoreVotingProcess(models.VotingProcess{
+		ID:                   "vp-embargo",
+		Title:                "Embargoed Election",
+		Position:             "Governor",
+		Candidates:           []models.Candidate{{ID: "c1", Name: "Alice Johnson"}, {ID: "c2", Name: "Bob Smith"}},
+		PollingStations:      []string{"EMBARGO001"},
+		Status:               "Active",
+		EmbargoUntilComplete: true,
+		CreatedAt:            time.Now(),
+	}))
+	require.NoError(t, storage.StoreSubmission(models.Submission{
+		ID:               "embargo-sub-1",
+		WalletAddress:    "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY",
+		PollingStationID: "EMBARGO001",
+		Timestamp:        time.Now(),
+		Results:          map[string]int{"Alice Johnson": 210, "Bob Smith": 180},
+		SubmissionType:   "image_ocr",
+		Confidence:       0.9,
+	}))
+
+	getSubmissions := func() (bool, []models.Submission) {
+		req, err := http.NewRequest("GET", "/api/v1/polling-station/EMBARGO001/submissions", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response struct {
+			Embargoed   bool                `json:"embargoed"`
+			Submissions []models.Submission `json:"submissions"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		return response.Embargoed, response.Submissions
+	}
+
+	t.Run("CountsHiddenWhileActive", func(t *testing.T) {
+		embargoed, submissions := getSubmissions()
+		assert.True(t, embargoed)
+		require.Len(t, submissions, 1)
+		assert.Equal(t, "embargo-sub-1", submissions[0].ID)
+		assert.Nil(t, submissions[0].Results)
+	})
+
+	t.Run("CountsRevealedAfterCompletion", func(t *testing.T) {
+		require.NoError(t, storage.UpdateVotingProcessStatus("vp-embargo", "Complete"))
+
+		embargoed, submissions := getSubmissions()
+		assert.False(t, embargoed)
+		require.Len(t, submissions, 1)
+		assert.Equal(t, 210, submissions[0].Results["Alice Johnson"])
+	})
+}
+
+func TestPollingStationHandler_GetStationSheet(t *testing.T) {
+	router, storage := setupPollingStationTestRouter()
+
+	verifiedResults := map[string]int{
+		"Alice Johnson": 210,
+		"Bob Smith":     180,
+		"spoilt":        7,
+	}
+	require.NoError(t, storage.UpdatePollingStationStatus("SHEET001", "Verified", verifiedResults, 0.92))
+
+	t.Run("VerifiedStation", func(t *testing.T) {
+		code, sheet := getStationSheet(t, router, "SHEET001")
+		assert.Equal(t, http.StatusOK, code)
+
+		assert.Equal(t, "vp-sheet", sheet.VotingProcessID)
+		assert.Equal(t, "County Election", sheet.Title)
+		assert.Equal(t, "Governor", sheet.Position)
+		assert.Equal(t, "SHEET001", sheet.PollingStationID)
+		assert.True(t, sheet.Verified)
+
+		require.Len(t, sheet.Lines, 2)
+		assert.Equal(t, "Alice Johnson", sheet.Lines[0].CandidateName)
+		require.NotNil(t, sheet.Lines[0].Votes)
+		assert.Equal(t, 210, *sheet.Lines[0].Votes)
+		assert.Equal(t, "Bob Smith", sheet.Lines[1].CandidateName)
+		require.NotNil(t, sheet.Lines[1].Votes)
+		assert.Equal(t, 180, *sheet.Lines[1].Votes)
+
+		require.NotNil(t, sheet.Spoilt)
+		assert.Equal(t, 7, *sheet.Spoilt)
+		require.NotNil(t, sheet.TotalVotes)
+		assert.Equal(t, 397, *sheet.TotalVotes)
+		require.NotNil(t, sheet.Confidence)
+		assert.Equal(t, 0.92, *sheet.Confidence)
+		assert.NotNil(t, sheet.ConsensusReached)
+	})
+
+	t.Run("PendingStation", func(t *testing.T) {
+		code, sheet := getStationSheet(t, router, "SHEET002")
+		assert.Equal(t, http.StatusOK, code)
+
+		assert.Equal(t, "Pending", sheet.Status)
+		assert.False(t, sheet.Verified)
+		assert.Equal(t, "County Election", sheet.Title)
+
+		// Candidate rows are present but left blank
+		require.Len(t, sheet.Lines, 2)
+		assert.Nil(t, sheet.Lines[0].Votes)
+		assert.Nil(t, sheet.Lines[1].Votes)
+		assert.Nil(t, sheet.Spoilt)
+		assert.Nil(t, sheet.TotalVotes)
+		assert.Nil(t, sheet.Confidence)
+		assert.Nil(t, sheet.ConsensusReached)
+	})
+
+	t.Run("UnknownStation", func(t *testing.T) {
+		code, _ := getStationSheet(t, router, "UNKNOWN")
+		assert.Equal(t, http.StatusNotFound, code)
+	})
+}
+
+func TestPollingStationHandler_GetStationTypeBreakdown(t *testing.T) {
+	router, storage := setupPollingStationTestRouter()
+
+	submissions := []struct {
+		submissionType string
+		confidence     float64
+	}{
+		{"image_ocr", 0.9},
+		{"image_ocr", 0.8},
+		{"image_ocr", 0.7},
+		{"audio_stt", 0.6},
+	}
+	for i, s := range submissions {
+		require.NoError(t, storage.StoreSubmission(models.Submission{
+			ID:               fmt.Sprintf("breakdown-sub-%d", i),
+			WalletAddress:    fmt.Sprintf("breakdown-wallet-%d", i),
+			PollingStationID: "SHEET001",
+			Timestamp:        time.Now(),
+			Results:          map[string]int{"Alice Johnson": 10, "Bob Smith": 5},
+			SubmissionType:   s.submissionType,
+			Confidence:       s.confidence,
+		}))
+	}
+
+	getBreakdown := func(t *testing.T, stationID string) (int, services.SubmissionTypeBreakdown) {
+		req, err := http.NewRequest("GET", "/api/v1/polling-station/"+stationID+"/type-breakdown", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		var response struct {
+			Breakdown services.SubmissionTypeBreakdown `json:"breakdown"`
+		}
+		if w.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		}
+		return w.Code, response.Breakdown
+	}
+
+	t.Run("MixedTypes", func(t *testing.T) {
+		code, breakdown := getBreakdown(t, "SHEET001")
+		require.Equal(t, http.StatusOK, code)
+		assert.Equal(t, 4, breakdown.TotalSubmissions)
+		assert.False(t, breakdown.Compacted)
+
+		imageOCR := breakdown.Types["image_ocr"]
+		assert.Equal(t, 3, imageOCR.Count)
+		require.NotNil(t, imageOCR.AverageConfidence)
+		assert.InDelta(t, 0.8, *imageOCR.AverageConfidence, 0.0001)
+
+		audioSTT := breakdown.Types["audio_stt"]
+		assert.Equal(t, 1, audioSTT.Count)
+		require.NotNil(t, audioSTT.AverageConfidence)
+		assert.InDelta(t, 0.6, *audioSTT.AverageConfidence, 0.0001)
+	})
+
+	t.Run("NoSubmissions", func(t *testing.T) {
+		code, breakdown := getBreakdown(t, "SHEET002")
+		require.Equal(t, http.StatusOK, code)
+		assert.Equal(t, 0, breakdown.TotalSubmissions)
+		assert.Equal(t, 0, breakdown.Types["image_ocr"].Count)
+		assert.Nil(t, breakdown.Types["image_ocr"].AverageConfidence)
+	})
+
+	t.Run("UnknownStation", func(t *testing.T) {
+		code, _ := getBreakdown(t, "UNKNOWN")
+		assert.Equal(t, http.StatusNotFound, code)
+	})
+}
+
+func TestPollingStationHandler_GetStationResult(t *testing.T) {
+	router, storage := setupPollingStationTestRouter()
+
+	verifiedResults := map[string]int{"Alice Johnson": 210, "Bob Smith": 180, "spoilt": 7}
+	require.NoError(t, storage.UpdatePollingStationStatus("SHEET001", "Verified", verifiedResults, 0.92))
+
+	getResult := func(stationID string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest("GET", "/api/v1/polling-station/"+stationID+"/result", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("VerifiedStation", func(t *testing.T) {
+		w := getResult("SHEET001")
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response struct {
+			Success          bool           `json:"success"`
+			PollingStationID string         `json:"polling_station_id"`
+			Results          map[string]int `json:"results"`
+			Confidence       float64        `json:"confidence"`
+			ConsensusReached *time.Time     `json:"consensus_reached"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.True(t, response.Success)
+		assert.Equal(t, "SHEET001", response.PollingStationID)
+		assert.Equal(t, verifiedResults, response.Results)
+		assert.Equal(t, 0.92, response.Confidence)
+		assert.NotNil(t, response.ConsensusReached)
+	})
+
+	t.Run("PendingStation", func(t *testing.T) {
+		w := getResult("SHEET002")
+		require.Equal(t, http.StatusConflict, w.Code)
+
+		var response models.ErrorResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "Polling station has no verified result yet", response.Error)
+		assert.Contains(t, response.Details, "Pending")
+	})
+
+	t.Run("EmbargoedProcess", func(t *testing.T) {
+		require.NoError(t, storage.StoreVotingProcess(models.VotingProcess{
+			ID:                   "vp-embargo",
+			Title:                "Embargoed Election",
+			Position:             "Mayor",
+			Candidates:           []models.Candidate{{ID: "c1", Name: "Alice Johnson"}},
+			PollingStations:      []string{"EMBARGO001"},
+			Status:               "Active",
+			EmbargoUntilComplete: true,
+			CreatedAt:            time.Now(),
+		}))
+		require.NoError(t, storage.UpdatePollingStationStatus("EMBARGO001", "Verified", map[string]int{"Alice Johnson": 10}, 1.0))
+
+		w := getResult("EMBARGO001")
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.NotContains(t, w.Body.String(), "\"results\"")
+	})
+
+	t.Run("StationNotFound", func(t *testing.T) {
+		w := getResult("non-existent")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestPollingStationHandler_GetStationStatus(t *testing.T) {
+	router, storage := setupPollingStationTestRouter()
+
+	type statusResponse struct {
+		Success           bool       `json:"success"`
+		Status            string     `json:"status"`
+		SubmissionCount   int        `json:"submission_count"`
+		FirstSubmissionAt *time.Time `json:"first_submission_at"`
+		LastSubmissionAt  *time.Time `json:"last_submission_at"`
+	}
+	getStatus := func(stationID string) (int, statusResponse) {
+		req, err := http.NewRequest("GET", "/api/v1/polling-station/"+stationID+"/status", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		var response statusResponse
+		if w.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		}
+		return w.Code, response
+	}
+	submit := func(wallet string, alice int) models.Submission {
+		require.NoError(t, storage.StoreSubmission(models.Submission{
+			ID:               fmt.Sprintf("status-%s-%d", wallet, alice),
+			WalletAddress:    wallet,
+			PollingStationID: "SHEET001",
+			Timestamp:        time.Now(),
+			Results:          map[string]int{"Alice Johnson": alice, "Bob Smith": 100},
+			SubmissionType:   "image_ocr",
+			Confidence:       0.9,
+		}))
+		for _, stored := range storage.GetSubmissionsByStation("SHEET001") {
+			if stored.WalletAddress == wallet {
+				return stored
+			}
+		}
+		t.Fatalf("submission from %s not stored", wallet)
+		return models.Submission{}
+	}
+
+	t.Run("NoSubmissionsYet", func(t *testing.T) {
+		code, response := getStatus("SHEET001")
+		require.Equal(t, http.StatusOK, code)
+		assert.True(t, response.Success)
+		assert.Equal(t, "Pending", response.Status)
+		assert.Zero(t, response.SubmissionCount)
+		assert.Nil(t, response.FirstSubmissionAt)
+		assert.Nil(t, response.LastSubmissionAt)
+	})
+
+	first := submit("wallet-a", 200)
+	time.Sleep(10 * time.Millisecond)
+	second := submit("wallet-b", 200)
+
+	t.Run("FirstAndLastSubmission", func(t *testing.T) {
+		code, response := getStatus("SHEET001")
+		require.Equal(t, http.StatusOK, code)
+		assert.Equal(t, 2, response.SubmissionCount)
+		require.NotNil(t, response.FirstSubmissionAt)
+		require.NotNil(t, response.LastSubmissionAt)
+		assert.True(t, first.ProcessedAt.Equal(*response.FirstSubmissionAt))
+		assert.True(t, second.ProcessedAt.Equal(*response.LastSubmissionAt))
+		assert.True(t, response.LastSubmissionAt.After(*response.FirstSubmissionAt))
+	})
+
+	t.Run("ResubmissionUpdatesLastSubmission", func(t *testing.T) {
+		time.Sleep(10 * time.Millisecond)
+		resubmitted := submit("wallet-a", 205)
+
+		code, response := getStatus("SHEET001")
+		require.Equal(t, http.StatusOK, code)
+		assert.Equal(t, 2, response.SubmissionCount, "the resubmission replaces the wallet's earlier submission")
+		require.NotNil(t, response.FirstSubmissionAt)
+		require.NotNil(t, response.LastSubmissionAt)
+		assert.True(t, first.ProcessedAt.Equal(*response.FirstSubmissionAt), "the first arrival is kept after its submission is replaced")
+		assert.True(t, resubmitted.ProcessedAt.Equal(*response.LastSubmissionAt))
+	})
+
+	t.Run("StationNotFound", func(t *testing.T) {
+		code, _ := getStatus("non-existent")
+		assert.Equal(t, http.StatusNotFound, code)
+	})
+}
+
+func TestPollingStationHandler_GetVerifyConditions(t *testing.T) {
+	router, storage := setupPollingStationTestRouter()
+
+	// Seven witnesses, of whom the largest group of three agree: short of a majority
+	groups := []struct {
+		wallets int
+		results map[string]int
+	}{
+		{3, map[string]int{"Alice Johnson": 210, "Bob Smith": 180}},
+		{2, map[string]int{"Alice Johnson": 200, "Bob Smith": 190}},
+		{2, map[string]int{"Alice Johnson": 150, "Bob Smith": 250}},
+	}
+	wallet := 0
+	for _, group := range groups {
+		for i := 0; i < group.wallets; i++ {
+			require.NoError(t, storage.StoreSubmission(models.Submission{
+				ID:               fmt.Sprintf("conditions-sub-%d", wallet),
+				WalletAddress:    fmt.Sprintf("conditions-wallet-%d", wallet),
+				PollingStationID: "SHEET002",
+				Timestamp:        time.Now(),
+				Results:          group.results,
+				SubmissionType:   "image_ocr",
+				Confidence:       0.9,
+			}))
+			wallet++
+		}
+	}
+
+	getConditions := func(stationID string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest("GET", "/api/v1/polling-station/"+stationID+"/verify-conditions", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("PendingStation", func(t *testing.T) {
+		w := getConditions("SHEET002")
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		var response struct {
+			Success    bool                      `json:"success"`
+			Conditions services.VerifyConditions `json:"conditions"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.True(t, response.Success)
+
+		conditions := response.Conditions
+		assert.Equal(t, 7, conditions.TotalSubmissions)
+		assert.Equal(t, 3, conditions.LargestGroupWallets)
+		assert.Equal(t, groups[0].results, conditions.LargestGroupResults)
+		assert.Equal(t, 1, conditions.TiedGroups)
+		assert.Equal(t, 7, conditions.MaxConsensusThreshold)
+		assert.Equal(t, 3, conditions.MaxMinAgreeingWallets)
+		// A majority requirement below 3/7 of submissions would let the group verify
+		assert.InDelta(t, 300.0/7, conditions.MajorityPercentageBelow, 0.0001)
+		assert.Equal(t, 50.0, conditions.CurrentCriteria.MajorityPercentage)
+		require.Len(t, conditions.Blockers, 1)
+		assert.Contains(t, conditions.Blockers[0], "majority")
+	})
+
+	t.Run("VerifiedStation", func(t *testing.T) {
+		require.NoError(t, storage.UpdatePollingStationStatus("SHEET001", "Verified", map[string]int{"Alice Johnson": 210, "Bob Smith": 180}, 0.92))
+
+		w := getConditions("SHEET001")
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("StationNotFound", func(t *testing.T) {
+		w := getConditions("non-existent")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestPollingStationHandler_GetStationConsensus(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	storage := services.NewStorageService()
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+
+	consensusService := services.NewConsensusService(storage, logger)
+	consensusService.SetThresholdScaling(0.25)
+	handler := NewPollingStationHandler(storage, services.NewTallyService(storage, logger), services.NewErrorHandler(logger), logger)
+	handler.SetConsensusService(consensusService)
+
+	router := gin.New()
+	router.GET("/api/v1/polling-station/:id/consensus", handler.GetStationConsensus)
+
+	require.NoError(t, storage.StoreVotingProcess(models.VotingProcess{
+		ID:              "vp-consensus",
+		PollingStations: []string{"LOW001", "HIGH001"},
+		Status:          "Active",
+		CreatedAt:       time.Now(),
+	}))
+	for stationID, count := range map[string]int{"LOW001": 3, "HIGH001": 20} {
+		for i := 0; i < count; i++ {
+			require.NoError(t, storage.StoreSubmission(models.Submission{
+				ID:               fmt.Sprintf("%s-sub-%d", stationID, i),
+				WalletAddress:    fmt.Sprintf("%s-wallet-%d", stationID, i),
+				PollingStationID: stationID,
+				Timestamp:        time.Now(),
+				Results:          map[string]int{"Alice": 210, "Bob": 180},
+				SubmissionType:   "image_ocr",
+				Confidence:       0.9,
+			}))
+		}
+		_, err := consensusService.ProcessConsensus(stationID)
+		require.NoError(t, err)
+	}
+
+	getConsensus := func(stationID string) (int, services.ConsensusResult) {
+		req, err := http.NewRequest("GET", "/api/v1/polling-station/"+stationID+"/consensus", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		var response struct {
+			Success   bool                     `json:"success"`
+			Consensus services.ConsensusResult `json:"consensus"`
+		}
+		if w.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.True(t, response.Success)
+		}
+		return w.Code, response.Consensus
+	}
+
+	t.Run("HighVolumeStationNeedsMoreAgreement", func(t *testing.T) {
+		code, low := getConsensus("LOW001")
+		require.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "Verified", low.Status)
+		assert.Equal(t, 3, low.EffectiveThreshold)
+
+		code, high := getConsensus("HIGH001")
+		require.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "Verified", high.Status)
+		assert.Equal(t, 5, high.EffectiveThreshold, "a quarter of 20 submissions must agree")
+		assert.Greater(t, high.EffectiveThreshold, low.EffectiveThreshold)
+	})
+
+	t.Run("StationNotFound", func(t *testing.T) {
+		code, _ := getConsensus("non-existent")
+		assert.Equal(t, http.StatusNotFound, code)
+	})
+}
+
+func TestPollingStationHandler_GetAdjudication(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	storage := services.NewStorageService()
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+
+	consensusService := services.NewConsensusService(storage, logger)
+	handler := NewPollingStationHandler(storage, services.NewTallyService(storage, logger), services.NewErrorHandler(logger), logger)
+	handler.SetConsensusService(consensusService)
+
+	router := gin.New()
+	router.GET("/api/v1/polling-station/:id/adjudication", middleware.RequireAdminToken("adjudication-token"), handler.GetAdjudication)
+
+	// Three witnesses agree, one reports a different result, and one submission was flagged as fraudulent
+	verified := map[string]int{"Alice": 210, "Bob": 180}
+	disputed := map[string]int{"Alice": 150, "Bob": 250}
+	for i, results := range []map[string]int{verified, verified, verified, disputed, disputed} {
+		require.NoError(t, storage.StoreSubmission(models.Submission{
+			ID:               fmt.Sprintf("adjudication-sub-%d", i),
+			WalletAddress:    fmt.Sprintf("adjudication-wallet-%d", i),
+			PollingStationID: "ADJ001",
+			Timestamp:        time.Now(),
+			Results:          results,
+			SubmissionType:   "image_ocr",
+			Confidence:       0.9,
+		}))
+	}
+	_, err := storage.FlagSubmission("adjudication-sub-4", "fabricated image")
+	require.NoError(t, err)
+	result, err := consensusService.ProcessConsensus("ADJ001")
+	require.NoError(t, err)
+	require.Equal(t, "Verified", result.Status)
+
+	getAdjudication := func(stationID, token string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest("GET", "/api/v1/polling-station/"+stationID+"/adjudication", nil)
+		require.NoError(t, err)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("GroupsContestedStation", func(t *testing.T) {
+		w := getAdjudication("ADJ001", "adjudication-token")
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		var response struct {
+			Success      bool                  `json:"success"`
+			Adjudication services.Adjudication `json:"adjudication"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.True(t, response.Success)
+
+		adjudication := response.Adjudication
+		assert.Equal(t, "Verified", adjudication.Status)
+		assert.Equal(t, 4, adjudication.TotalSubmissions)
+		require.Len(t, adjudication.Groups, 2)
+
+		assert.Equal(t, verified, adjudication.Groups[0].Results)
+		assert.Equal(t, 3, adjudication.Groups[0].WalletCount)
+		assert.True(t, adjudication.Groups[0].Verified)
+		require.Len(t, adjudication.Groups[0].Submissions, 3)
+		// Full detail, including the witness's wallet address
+		assert.Equal(t, "adjudication-wallet-0", adjudication.Groups[0].Submissions[0].WalletAddress)
+
+		assert.Equal(t, disputed, adjudication.Groups[1].Results)
+		assert.Equal(t, 1, adjudication.Groups[1].WalletCount)
+		assert.False(t, adjudication.Groups[1].Verified)
+
+		require.Len(t, adjudication.FlaggedSubmissions, 1)
+		assert.Equal(t, "adjudication-sub-4", adjudication.FlaggedSubmissions[0].ID)
+	})
+
+	t.Run("NonAdminDenied", func(t *testing.T) {
+		for name, token := range map[string]string{"NoToken": "", "WrongToken": "not-the-token"} {
+			w := getAdjudication("ADJ001", token)
+			assert.Equal(t, http.StatusForbidden, w.Code, name)
+			assert.Contains(t, w.Body.String(), "FORBIDDEN", name)
+			assert.NotContains(t, w.Body.String(), "adjudication-wallet", name)
+		}
+	})
+
+	t.Run("NoTokenConfiguredDeniesEveryone", func(t *testing.T) {
+		unconfigured := gin.New()
+		unconfigured.GET("/api/v1/polling-station/:id/adjudication", middleware.RequireAdminToken(""), handler.GetAdjudication)
+
+		req, err := http.NewRequest("GET", "/api/v1/polling-station/ADJ001/adjudication", nil)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer ")
+		w := httptest.NewRecorder()
+		unconfigured.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("UnknownStation", func(t *testing.T) {
+		w := getAdjudication("NOPE404", "adjudication-token")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
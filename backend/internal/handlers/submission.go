@@ -1,7 +1,14 @@
 package handlers
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -17,6 +24,13 @@ type SubmissionHandler struct {
 	validationService      *services.ValidationService
 	consensusService       *services.ConsensusService
 	consensusRecovery      *services.ConsensusRecoveryService
+	idGenerator            *services.SubmissionIDGenerator
+	consensusPool          *services.ConsensusWorkerPool
+	intake                 *services.SubmissionIntake
+	geoChecker             *services.IPGeolocationChecker
+	evidenceVerifier       *services.EvidenceVerifier
+	maintenance            *services.MaintenanceMode
+	consensusRetryAfter    time.Duration // Delay before consensus is retried in the background after it and recovery failed
 	errorHandler          *services.ErrorHandler
 	logger                *logrus.Logger
 }
@@ -28,11 +42,73 @@ func NewSubmissionHandler(storage *services.StorageService, validation *services
 		validationService: validation,
 		consensusService:  consensus,
 		consensusRecovery: consensusRecovery,
+		idGenerator:       services.NewSubmissionIDGenerator(),
+		consensusRetryAfter: services.DefaultConsensusRetryAfter,
 		errorHandler:     errorHandler,
 		logger:           logger,
 	}
 }
 
+// SetConsensusPool sets the worker pool used to process consensus in the background
+func (h *SubmissionHandler) SetConsensusPool(pool *services.ConsensusWorkerPool) {
+	h.consensusPool = pool
+}
+
+// SetIntake sets the bounded intake that turns submissions away with 503 when the server is overloaded
+func (h *SubmissionHandler) SetIntake(intake *services.SubmissionIntake) {
+	h.intake = intake
+}
+
+// SetGeolocationChecker enables the soft cross-check of submission GPS coordinates against the client IP's location
+func (h *SubmissionHandler) SetGeolocationChecker(checker *services.IPGeolocationChecker) {
+	h.geoChecker = checker
+}
+
+// SetEvidenceVerifier enables checking, at ingest, that a submission's evidence hash matches its evidence URI
+func (h *SubmissionHandler) SetEvidenceVerifier(verifier *services.EvidenceVerifier) {
+	h.evidenceVerifier = verifier
+}
+
+// SetMaintenanceMode sets the switch that closes submissions while the server is in maintenance
+func (h *SubmissionHandler) SetMaintenanceMode(maintenance *services.MaintenanceMode) {
+	h.maintenance = maintenance
+}
+
+// SetConsensusRetryAfter sets how long after a failed consensus run, recovery included, it is retried in the background.
+// The delay is reported to the client as a retry hint.
+func (h *SubmissionHandler) SetConsensusRetryAfter(delay time.Duration) {
+	if delay > 0 {
+		h.consensusRetryAfter = delay
+	}
+}
+
+// SetIDGenerator sets the generator used to assign submission IDs
+func (h *SubmissionHandler) SetIDGenerator(generator *services.SubmissionIDGenerator) {
+	h.idGenerator = generator
+}
+
+// invalidResultFormatError translates a JSON type mismatch in the results field into an error naming the
+// offending candidate, e.g. a vote count sent as 150.5 or "150". It returns nil for any other error.
+func invalidResultFormatError(err error) *services.APIError {
+	var typeErr *json.UnmarshalTypeError
+	if !errors.As(err, &typeErr) {
+		return nil
+	}
+
+	var details string
+	switch {
+	case typeErr.Field == "results":
+		details = fmt.Sprintf("results must be an object mapping candidate names to vote counts, got %s", typeErr.Value)
+	case strings.HasPrefix(typeErr.Field, "results."):
+		candidate := strings.TrimPrefix(typeErr.Field, "results.")
+		details = fmt.Sprintf("results[%q] must be a whole number of votes, got %s", candidate, typeErr.Value)
+	default:
+		return nil
+	}
+
+	return services.NewAPIError(services.ErrorTypeInvalidResultFormat, "Invalid result format", details, http.StatusBadRequest)
+}
+
 // SubmitResult handles POST /api/v1/submitResult requests
 func (h *SubmissionHandler) SubmitResult(c *gin.Context) {
 	// Generate request ID for tracing
@@ -53,12 +129,131 @@ func (h *SubmissionHandler) SubmitResult(c *gin.Context) {
 	// Store request ID in context for error handler
 	c.Set("request_id", requestID)
 
-	// Bind JSON payload
-	if err := c.ShouldBindJSON(&req); err != nil {
+	// Submissions are closed outright while an administrator has the server in maintenance
+	if h.rejectDuringMaintenance(c, logger) {
+		return
+	}
+
+	// Shed load before doing any work so clients get a clear signal to back off
+	if h.intake != nil {
+		if !h.acquireIntake(c, logger) {
+			return
+		}
+		defer h.intake.Release()
+	}
+
+	// Bind JSON payload, rejecting misspelled fields
+	if err := bindStrictJSON(c, &req); err != nil {
+		if apiErr := unknownFieldAPIError(err); apiErr != nil {
+			h.errorHandler.HandleError(c, apiErr, map[string]interface{}{"validation_field": "json_payload"})
+			return
+		}
+		if apiErr := invalidResultFormatError(err); apiErr != nil {
+			h.errorHandler.HandleError(c, apiErr, map[string]interface{}{"validation_field": "results"})
+			return
+		}
+		h.errorHandler.HandleValidationError(c, err, "json_payload")
+		return
+	}
+
+	h.ingestSubmission(c, req, requestID, logger)
+}
+
+// SubmitCompactResult handles POST /api/v1/submitResult/compact requests carrying a signed QR code submission
+func (h *SubmissionHandler) SubmitCompactResult(c *gin.Context) {
+	// Generate request ID for tracing
+	requestID := uuid.New().String()
+	c.Set("request_id", requestID)
+
+	// Create logger with request context
+	logger := h.logger.WithFields(logrus.Fields{
+		"request_id": requestID,
+		"endpoint":   "submitCompactResult",
+		"method":     c.Request.Method,
+		"client_ip":  c.ClientIP(),
+	})
+
+	logger.Info("Processing compact submission request")
+
+	// Submissions are closed outright while an administrator has the server in maintenance
+	if h.rejectDuringMaintenance(c, logger) {
+		return
+	}
+
+	// Shed load before doing any work so clients get a clear signal to back off
+	if h.intake != nil {
+		if !h.acquireIntake(c, logger) {
+			return
+		}
+		defer h.intake.Release()
+	}
+
+	var compactReq models.CompactSubmissionRequest
+	if err := c.ShouldBindJSON(&compactReq); err != nil {
 		h.errorHandler.HandleValidationError(c, err, "json_payload")
 		return
 	}
 
+	req, err := services.DecodeCompactSubmission(compactReq.Data)
+	if err != nil {
+		if errors.Is(err, services.ErrCompactSignature) {
+			h.errorHandler.HandleError(c, services.NewAPIError(
+				services.ErrorTypeInvalidSignature,
+				"Invalid submission signature",
+				err.Error(),
+				http.StatusUnauthorized,
+			), nil)
+			return
+		}
+		h.errorHandler.HandleError(c, services.NewAPIError(
+			services.ErrorTypeInvalidCompactEncoding,
+			"Invalid compact submission",
+			err.Error(),
+			http.StatusBadRequest,
+		), nil)
+		return
+	}
+
+	h.ingestSubmission(c, req, requestID, logger)
+}
+
+// rejectDuringMaintenance answers 503 when maintenance mode is on and reports whether it did
+func (h *SubmissionHandler) rejectDuringMaintenance(c *gin.Context, logger *logrus.Entry) bool {
+	if h.maintenance == nil || !h.maintenance.Enabled() {
+		return false
+	}
+
+	logger.Warn("Maintenance mode enabled - rejecting submission")
+	h.errorHandler.HandleError(c, services.NewAPIError(
+		services.ErrorTypeMaintenanceMode,
+		"Submissions are closed for maintenance",
+		"The server is in maintenance mode and is not accepting submissions; results can still be read",
+		http.StatusServiceUnavailable,
+	), nil)
+	return true
+}
+
+// acquireIntake reserves a submission intake slot, answering 503 with Retry-After when the intake is full.
+// Callers must release the slot once the submission has been handled.
+func (h *SubmissionHandler) acquireIntake(c *gin.Context, logger *logrus.Entry) bool {
+	if h.intake.TryAcquire() {
+		return true
+	}
+
+	retryAfter := int(math.Ceil(h.intake.RetryAfter().Seconds()))
+	logger.WithField("in_flight", h.intake.InFlight()).Warn("Submission intake full - rejecting submission")
+	c.Header("Retry-After", strconv.Itoa(retryAfter))
+	h.errorHandler.HandleError(c, services.NewAPIError(
+		services.ErrorTypeIntakeFull,
+		"Server is busy",
+		fmt.Sprintf("Too many submissions are being processed; retry after %d seconds", retryAfter),
+		http.StatusServiceUnavailable,
+	), nil)
+	return false
+}
+
+// ingestSubmission validates, stores and runs consensus for a decoded submission request, then writes the response
+func (h *SubmissionHandler) ingestSubmission(c *gin.Context, req models.SubmissionRequest, requestID string, logger *logrus.Entry) {
 	// Add request details to logger
 	logger = logger.WithFields(logrus.Fields{
 		"wallet_address":     req.WalletAddress,
@@ -77,9 +272,32 @@ func (h *SubmissionHandler) SubmitResult(c *gin.Context) {
 		return
 	}
 
+	// Reject results whose evidence does not match the hash the witness submitted
+	if h.evidenceVerifier != nil {
+		if err := h.evidenceVerifier.Verify(req.EvidenceURI, req.EvidenceHash); err != nil {
+			context := map[string]interface{}{"evidence_uri": req.EvidenceURI}
+			if errors.Is(err, services.ErrEvidenceMismatch) {
+				h.errorHandler.HandleError(c, services.NewAPIError(
+					services.ErrorTypeEvidenceMismatch,
+					"Evidence does not match its hash",
+					"The content at evidenceUri does not hash to evidenceHash",
+					http.StatusUnprocessableEntity,
+				), context)
+				return
+			}
+			h.errorHandler.HandleError(c, services.NewAPIError(
+				services.ErrorTypeEvidenceUnavailable,
+				"Evidence could not be verified",
+				err.Error(),
+				http.StatusBadGateway,
+			), context)
+			return
+		}
+	}
+
 	// Create submission model
 	submission := models.Submission{
-		ID:               uuid.New().String(),
+		ID:               h.idGenerator.GenerateID(req),
 		WalletAddress:    req.WalletAddress,
 		PollingStationID: req.PollingStationID,
 		GPSCoordinates:   req.GPSCoordinates,
@@ -87,23 +305,139 @@ func (h *SubmissionHandler) SubmitResult(c *gin.Context) {
 		Results:          req.Results,
 		SubmissionType:   req.SubmissionType,
 		Confidence:       req.Confidence,
+		EvidenceURI:      req.EvidenceURI,
+		EvidenceHash:     req.EvidenceHash,
+		Tags:             req.Tags,
 	}
 
 	// Store submission
 	if err := h.storageService.StoreSubmission(submission); err != nil {
+		if errors.Is(err, services.ErrSubmissionCoalesced) {
+			h.respondCoalesced(c, submission, logger)
+			return
+		}
 		h.errorHandler.HandleServiceError(c, err, "storage", "store_submission")
 		return
 	}
 
 	logger.WithField("submission_id", submission.ID).Info("Submission stored successfully")
 
-	// Trigger consensus processing with recovery
-	consensusResult, err := h.consensusService.ProcessConsensus(submission.PollingStationID)
+	// Acknowledge storage so the client knows when its local copy can be discarded
+	ack, err := h.storageService.GetSubmissionAck(submission.ID)
+	if err != nil {
+		h.errorHandler.HandleServiceError(c, err, "storage", "acknowledge_submission")
+		return
+	}
+
+	// Flag, but never block, submissions whose client IP resolves far from their GPS coordinates
+	var geoWarning *services.GeolocationWarning
+	if h.geoChecker != nil {
+		warning, err := h.geoChecker.Check(c.ClientIP(), submission.GPSCoordinates)
+		if err != nil {
+			logger.WithError(err).Debug("IP geolocation cross-check skipped")
+		} else if warning != nil {
+			geoWarning = warning
+			logger.WithFields(logrus.Fields{
+				"submission_id": submission.ID,
+				"ip_country":    warning.IPCountry,
+				"ip_region":     warning.IPRegion,
+				"distance_km":   warning.DistanceKm,
+			}).Warn("Submission GPS coordinates are far from the client IP's location")
+		}
+	}
+
+	// Queue consensus processing when a worker pool is configured, otherwise process it on the request
+	var consensusResult *services.ConsensusResult
+	var consensusErr error
+	if h.consensusPool != nil {
+		if err := h.consensusPool.Enqueue(submission.PollingStationID, requestID); err == nil {
+			consensusResult = &services.ConsensusResult{
+				Status:  models.StationStatusPending,
+				Message: "Consensus processing queued - the result will be broadcast when ready",
+			}
+			logger.Info("Consensus processing queued")
+		} else {
+			logger.WithError(err).Warning("Consensus queue unavailable, processing synchronously")
+			consensusResult, consensusErr = h.processConsensus(submission.PollingStationID, requestID, logger)
+		}
+	} else {
+		consensusResult, consensusErr = h.processConsensus(submission.PollingStationID, requestID, logger)
+	}
+	if consensusErr != nil {
+		h.scheduleConsensusRetry(submission.PollingStationID, requestID, logger)
+	}
+
+	// Prepare response
+	response := gin.H{
+		"success":       true,
+		"submission_id": submission.ID,
+		"ack":           ack,
+		"message":       "Submission received and stored successfully",
+	}
+
+	if geoWarning != nil {
+		response["geolocation_warning"] = geoWarning
+	}
+
+	// Include consensus information if available
+	if consensusResult != nil {
+		response["consensus"] = gin.H{
+			"status":          consensusResult.Status,
+			"confidence_level": consensusResult.ConfidenceLevel,
+			"message":         consensusResult.Message,
+		}
+	}
+
+	// The submission is stored either way; tell the client consensus is still outstanding rather than staying silent
+	if consensusErr != nil {
+		response["consensus_error"] = gin.H{
+			"code":                "CONSENSUS_UNAVAILABLE",
+			"message":             "Consensus could not be computed yet - it will be retried automatically",
+			"retryable":           true,
+			"retry_after_seconds": int(h.consensusRetryAfter.Seconds()),
+		}
+	}
+
+	// Return success response
+	c.JSON(http.StatusOK, response)
+}
+
+// respondCoalesced acknowledges an accidental double submit with the submission it repeats, without running consensus again
+func (h *SubmissionHandler) respondCoalesced(c *gin.Context, submission models.Submission, logger *logrus.Entry) {
+	var existingID string
+	for _, existing := range h.storageService.GetSubmissionsByWallet(submission.WalletAddress) {
+		if existing.PollingStationID == submission.PollingStationID {
+			existingID = existing.ID
+			break
+		}
+	}
+
+	ack, err := h.storageService.GetSubmissionAck(existingID)
+	if err != nil {
+		h.errorHandler.HandleServiceError(c, err, "storage", "acknowledge_submission")
+		return
+	}
+
+	logger.WithField("submission_id", existingID).Info("Identical resubmission coalesced with a recent submission")
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":       true,
+		"submission_id": existingID,
+		"ack":           ack,
+		"coalesced":     true,
+		"message":       "Identical submission received moments ago - not processed again",
+	})
+}
+
+// processConsensus runs consensus processing for a polling station, attempting recovery on failure.
+// It returns an error only when both consensus and recovery failed.
+func (h *SubmissionHandler) processConsensus(pollingStationID string, requestID string, logger *logrus.Entry) (*services.ConsensusResult, error) {
+	consensusResult, err := h.consensusService.ProcessConsensus(pollingStationID)
 	if err != nil {
 		// Attempt consensus recovery
 		logger.WithError(err).Warning("Consensus processing failed, attempting recovery")
 		
-		recoveryResult := h.consensusRecovery.RecoverConsensusProcessing(submission.PollingStationID, err)
+		recoveryResult := h.consensusRecovery.RecoverConsensusProcessing(pollingStationID, requestID, err)
 		
 		if recoveryResult.Success {
 			consensusResult = recoveryResult.FinalResult
@@ -122,6 +456,7 @@ func (h *SubmissionHandler) SubmitResult(c *gin.Context) {
 			
 			// Continue without consensus result
 			logger.Warning("Continuing despite consensus processing and recovery failure")
+			return nil, fmt.Errorf("consensus recovery failed: %s", recoveryResult.Error)
 		}
 	} else {
 		logger.WithFields(logrus.Fields{
@@ -130,22 +465,116 @@ func (h *SubmissionHandler) SubmitResult(c *gin.Context) {
 		}).Info("Consensus processing completed successfully")
 	}
 
-	// Prepare response
-	response := gin.H{
-		"success":       true,
-		"submission_id": submission.ID,
-		"message":       "Submission received and stored successfully",
+	return consensusResult, nil
+}
+
+// scheduleConsensusRetry retries consensus for a station once, in the background, after the configured delay.
+// Further attempts happen with the station's next submission.
+func (h *SubmissionHandler) scheduleConsensusRetry(pollingStationID string, requestID string, logger *logrus.Entry) {
+	logger.WithField("retry_after", h.consensusRetryAfter.String()).Info("Scheduling background consensus retry")
+
+	time.AfterFunc(h.consensusRetryAfter, func() {
+		if h.consensusPool != nil {
+			if err := h.consensusPool.Enqueue(pollingStationID, requestID); err == nil {
+				return
+			}
+		}
+		if _, err := h.processConsensus(pollingStationID, requestID, logger); err != nil {
+			logger.WithError(err).Error("Background consensus retry failed")
+		}
+	})
+}
+
+// GetSubmissionAck handles GET /api/v1/submission/{id}/ack requests
+func (h *SubmissionHandler) GetSubmissionAck(c *gin.Context) {
+	// Generate request ID for tracing
+	requestID := uuid.New().String()
+	c.Set("request_id", requestID)
+
+	// Get submission ID from URL parameter
+	submissionID := c.Param("id")
+
+	// Create logger with request context
+	logger := h.logger.WithFields(logrus.Fields{
+		"request_id":    requestID,
+		"endpoint":      "getSubmissionAck",
+		"method":        c.Request.Method,
+		"client_ip":     c.ClientIP(),
+		"submission_id": submissionID,
+	})
+
+	logger.Info("Processing get submission ack request")
+
+	ack, err := h.storageService.GetSubmissionAck(submissionID)
+	if err != nil {
+		h.errorHandler.HandleNotFoundError(c, "submission", submissionID)
+		return
 	}
 
-	// Include consensus information if available
-	if consensusResult != nil {
-		response["consensus"] = gin.H{
-			"status":          consensusResult.Status,
-			"confidence_level": consensusResult.ConfidenceLevel,
-			"message":         consensusResult.Message,
+	logger.WithField("durable", ack.Durable).Info("Submission ack retrieved successfully")
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"ack":     ack,
+	})
+}
+
+// DiffSubmissions handles GET /api/v1/submissions/diff?a={id}&b={id} requests
+func (h *SubmissionHandler) DiffSubmissions(c *gin.Context) {
+	// Generate request ID for tracing
+	requestID := uuid.New().String()
+	c.Set("request_id", requestID)
+
+	submissionA := c.Query("a")
+	submissionB := c.Query("b")
+
+	// Create logger with request context
+	logger := h.logger.WithFields(logrus.Fields{
+		"request_id":   requestID,
+		"endpoint":     "diffSubmissions",
+		"method":       c.Request.Method,
+		"client_ip":    c.ClientIP(),
+		"submission_a": submissionA,
+		"submission_b": submissionB,
+	})
+
+	logger.Info("Processing submission diff request")
+
+	if submissionA == "" || submissionB == "" {
+		h.errorHandler.HandleValidationError(c, errors.New("both 'a' and 'b' submission IDs are required"), "a,b")
+		return
+	}
+
+	a, err := h.storageService.GetSubmissionByID(submissionA)
+	if err != nil {
+		h.errorHandler.HandleNotFoundError(c, "submission", submissionA)
+		return
+	}
+	b, err := h.storageService.GetSubmissionByID(submissionB)
+	if err != nil {
+		h.errorHandler.HandleNotFoundError(c, "submission", submissionB)
+		return
+	}
+
+	// Submissions' vote counts stay hidden while their voting process is embargoed, as in the tally
+	for _, submission := range []*models.Submission{a, b} {
+		if h.storageService.IsPollingStationEmbargoed(submission.PollingStationID) {
+			h.errorHandler.HandleError(c, services.NewAPIError(
+				services.ErrorTypeConflict,
+				"Submission results are embargoed",
+				fmt.Sprintf("submission %s belongs to polling station %s, whose voting process publishes results only once it is Complete", submission.ID, submission.PollingStationID),
+				http.StatusConflict,
+			), map[string]interface{}{"submission_id": submission.ID})
+			return
 		}
 	}
 
-	// Return success response
-	c.JSON(http.StatusOK, response)
-}
\ No newline at end of file
+	diff := services.DiffSubmissions(*a, *b)
+
+	logger.WithField("mismatch_count", diff.MismatchCount).Info("Submission diff generated successfully")
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"diff":    diff,
+	})
+}
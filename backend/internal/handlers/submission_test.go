@@ -2,9 +2,14 @@ package handlers
 
 import (
 	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -55,6 +60,7 @@ func setupTestHandler() (*SubmissionHandler, *gin.Engine) {
 	gin.SetMode(gin.TestMode)
 	router := gin.New()
 	router.POST("/api/v1/submitResult", handler.SubmitResult)
+	router.POST("/api/v1/submitResult/compact", handler.SubmitCompactResult)
 
 	return handler, router
 }
@@ -132,6 +138,84 @@ func TestSubmissionHandler_SubmitResult_Success(t *testing.T) {
 	}
 }
 
+func TestSubmissionHandler_SubmitResult_ConsensusFailure(t *testing.T) {
+	handler, router := setupTestHandler()
+
+	// Point consensus and recovery at a store that never sees the submission, so both fail as in a downstream outage
+	logger := logrus.New()
+	logger.SetLevel(logrus.PanicLevel)
+	unreachable := services.NewStorageService()
+	handler.consensusService = services.NewConsensusService(unreachable, logger)
+	handler.consensusRecovery = services.NewConsensusRecoveryService(unreachable, handler.consensusService, logger)
+	handler.SetConsensusRetryAfter(time.Hour) // Keep the background retry out of the test
+
+	submission := models.SubmissionRequest{
+		WalletAddress:    "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY",
+		PollingStationID: "STATION_001",
+		GPSCoordinates: models.GPSCoordinates{
+			Latitude:  40.7128,
+			Longitude: -74.0060,
+		},
+		Timestamp: time.Now().Add(-1 * time.Hour),
+		Results: map[string]int{
+			"Candidate A": 100,
+			"Candidate B": 150,
+			"spoilt":      5,
+		},
+		SubmissionType: "image_ocr",
+		Confidence:     0.85,
+	}
+
+	jsonData, err := json.Marshal(submission)
+	if err != nil {
+		t.Fatalf("Failed to marshal JSON: %v", err)
+	}
+
+	req, err := http.NewRequest("POST", "/api/v1/submitResult", bytes.NewBuffer(jsonData))
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	// The submission is still stored and acknowledged
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+
+	var response map[string]interface{}
+	if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+
+	if success, ok := response["success"].(bool); !ok || !success {
+		t.Error("Expected success to be true")
+	}
+	submissionID, _ := response["submission_id"].(string)
+	if _, err := handler.storageService.GetSubmissionByID(submissionID); err != nil {
+		t.Errorf("Expected submission %q to be stored: %v", submissionID, err)
+	}
+	if _, ok := response["consensus"]; ok {
+		t.Error("Expected no consensus information when consensus failed")
+	}
+
+	consensusError, ok := response["consensus_error"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("Expected consensus_error in response, got %s", w.Body.String())
+	}
+	if code := consensusError["code"]; code != "CONSENSUS_UNAVAILABLE" {
+		t.Errorf("Expected code CONSENSUS_UNAVAILABLE, got %v", code)
+	}
+	if retryable, _ := consensusError["retryable"].(bool); !retryable {
+		t.Error("Expected consensus_error to be retryable")
+	}
+	if retryAfter := consensusError["retry_after_seconds"]; retryAfter != float64(3600) {
+		t.Errorf("Expected retry_after_seconds 3600, got %v", retryAfter)
+	}
+}
+
 func TestSubmissionHandler_SubmitResult_InvalidJSON(t *testing.T) {
 	_, router := setupTestHandler()
 
@@ -169,6 +253,428 @@ func TestSubmissionHandler_SubmitResult_InvalidJSON(t *testing.T) {
 	}
 }
 
+func TestSubmissionHandler_SubmitResult_InvalidResultFormat(t *testing.T) {
+	_, router := setupTestHandler()
+
+	tests := []struct {
+		name            string
+		results         string
+		expectedDetails string
+	}{
+		{"FloatVoteCount", `{"Candidate A": 150, "Candidate B": 120.5}`, `"Candidate B"`},
+		{"StringVoteCount", `{"Candidate A": "150", "Candidate B": 120}`, `"Candidate A"`},
+		{"ResultsNotAnObject", `[150, 120]`, "results must be an object"},
+	}
+
+	for _, test := range tests {
+		body := `{
+			"walletAddress": "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY",
+			"pollingStationId": "STATION_001",
+			"gpsCoordinates": {"latitude": -1.2921, "longitude": 36.8219},
+			"timestamp": "` + time.Now().Format(time.RFC3339) + `",
+			"results": ` + test.results + `,
+			"submissionType": "image_ocr",
+			"confidence": 0.95
+		}`
+
+		req, err := http.NewRequest("POST", "/api/v1/submitResult", bytes.NewBufferString(body))
+		if err != nil {
+			t.Fatalf("%s: failed to create request: %v", test.name, err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected status code %d, got %d", test.name, http.StatusBadRequest, w.Code)
+		}
+
+		var response models.ErrorResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+			t.Fatalf("%s: failed to unmarshal response: %v", test.name, err)
+		}
+		if response.Code != "INVALID_RESULT_FORMAT" {
+			t.Errorf("%s: expected error code 'INVALID_RESULT_FORMAT', got %s", test.name, response.Code)
+		}
+		if !strings.Contains(response.Details, test.expectedDetails) {
+			t.Errorf("%s: expected details to contain %s, got %q", test.name, test.expectedDetails, response.Details)
+		}
+	}
+}
+
+func TestSubmissionHandler_SubmitResult_UnknownField(t *testing.T) {
+	_, router := setupTestHandler()
+
+	tests := []struct {
+		name          string
+		walletKey     string
+		expectedCode  int
+		expectedField string
+	}{
+		{"MisspelledWalletAddress", "walletAddres", http.StatusBadRequest, "walletAddres"},
+		{"CleanPayload", "walletAddress", http.StatusOK, ""},
+	}
+
+	for _, test := range tests {
+		body := `{
+			"` + test.walletKey + `": "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY",
+			"pollingStationId": "STATION_001",
+			"gpsCoordinates": {"latitude": -1.2921, "longitude": 36.8219},
+			"timestamp": "` + time.Now().Add(-time.Hour).Format(time.RFC3339) + `",
+			"results": {"Candidate A": 150, "Candidate B": 120},
+			"submissionType": "image_ocr",
+			"confidence": 0.95
+		}`
+
+		req, err := http.NewRequest("POST", "/api/v1/submitResult", bytes.NewBufferString(body))
+		if err != nil {
+			t.Fatalf("%s: failed to create request: %v", test.name, err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		if w.Code != test.expectedCode {
+			t.Errorf("%s: expected status code %d, got %d: %s", test.name, test.expectedCode, w.Code, w.Body.String())
+			continue
+		}
+		if test.expectedField == "" {
+			continue
+		}
+
+		var response models.ErrorResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+			t.Fatalf("%s: failed to unmarshal response: %v", test.name, err)
+		}
+		if response.Code != "UNKNOWN_FIELD" {
+			t.Errorf("%s: expected error code 'UNKNOWN_FIELD', got %s", test.name, response.Code)
+		}
+		if !strings.Contains(response.Details, `"`+test.expectedField+`"`) {
+			t.Errorf("%s: expected details to name field %s, got %q", test.name, test.expectedField, response.Details)
+		}
+	}
+}
+
+func TestSubmissionHandler_SubmitResult_IntakeBackpressure(t *testing.T) {
+	handler, router := setupTestHandler()
+	intake := services.NewSubmissionIntake(10, 3*time.Second)
+	handler.SetIntake(intake)
+
+	submit := func(walletAddress string) *httptest.ResponseRecorder {
+		jsonData, err := json.Marshal(models.SubmissionRequest{
+			WalletAddress:    walletAddress,
+			PollingStationID: "STATION_001",
+			GPSCoordinates:   models.GPSCoordinates{Latitude: 40.7128, Longitude: -74.0060},
+			Timestamp:        time.Now().Add(-1 * time.Hour),
+			Results:          map[string]int{"Candidate A": 100, "Candidate B": 150},
+			SubmissionType:   "image_ocr",
+			Confidence:       0.85,
+		})
+		if err != nil {
+			t.Fatalf("Failed to marshal JSON: %v", err)
+		}
+
+		req, err := http.NewRequest("POST", "/api/v1/submitResult", bytes.NewBuffer(jsonData))
+		if err != nil {
+			t.Fatalf("Failed to create request: %v", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// Under capacity: the submission succeeds and releases its slot
+	if w := submit("5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY"); w.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d under capacity, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	if intake.InFlight() != 0 {
+		t.Errorf("Expected the slot to be released, %d still in flight", intake.InFlight())
+	}
+
+	// Saturate the intake up to its high-water mark, as concurrent in-flight submissions would
+	for intake.TryAcquire() {
+	}
+
+	w := submit("5FHneW46xGXgs5mUiveU4sbTyGBzmstUspZC92UhjJM694ty")
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("Expected status code %d when saturated, got %d", http.StatusServiceUnavailable, w.Code)
+	}
+	if retryAfter := w.Header().Get("Retry-After"); retryAfter != "3" {
+		t.Errorf("Expected Retry-After 3, got %q", retryAfter)
+	}
+
+	var response models.ErrorResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	if response.Code != "INTAKE_FULL" {
+		t.Errorf("Expected error code 'INTAKE_FULL', got %s", response.Code)
+	}
+
+	// Once a slot frees up, submissions are accepted again
+	intake.Release()
+	if w := submit("5FHneW46xGXgs5mUiveU4sbTyGBzmstUspZC92UhjJM694ty"); w.Code != http.StatusOK {
+		t.Errorf("Expected status code %d after a slot freed up, got %d", http.StatusOK, w.Code)
+	}
+}
+
+// stubGeolocationProvider resolves every IP to a fixed location
+type stubGeolocationProvider struct {
+	location services.IPLocation
+}
+
+func (p stubGeolocationProvider) Locate(ip string) (*services.IPLocation, error) {
+	return &p.location, nil
+}
+
+func TestSubmissionHandler_SubmitResult_GeolocationWarning(t *testing.T) {
+	tests := []struct {
+		name        string
+		location    services.IPLocation
+		wantWarning bool
+	}{
+		{
+			name: "IP in another region",
+			location: services.IPLocation{
+				Country:     "United Kingdom",
+				Region:      "England",
+				Coordinates: models.GPSCoordinates{Latitude: 51.5074, Longitude: -0.1278},
+			},
+			wantWarning: true,
+		},
+		{
+			name: "IP in the same region",
+			location: services.IPLocation{
+				Country:     "United States",
+				Region:      "New York",
+				Coordinates: models.GPSCoordinates{Latitude: 40.7306, Longitude: -73.9352},
+			},
+			wantWarning: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, router := setupTestHandler()
+			handler.SetGeolocationChecker(services.NewIPGeolocationChecker(stubGeolocationProvider{location: tt.location}, 500))
+
+			jsonData, err := json.Marshal(models.SubmissionRequest{
+				WalletAddress:    "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY",
+				PollingStationID: "STATION_001",
+				GPSCoordinates:   models.GPSCoordinates{Latitude: 40.7128, Longitude: -74.0060},
+				Timestamp:        time.Now().Add(-1 * time.Hour),
+				Results:          map[string]int{"Candidate A": 100, "Candidate B": 150},
+				SubmissionType:   "image_ocr",
+				Confidence:       0.85,
+			})
+			if err != nil {
+				t.Fatalf("Failed to marshal JSON: %v", err)
+			}
+
+			req, err := http.NewRequest("POST", "/api/v1/submitResult", bytes.NewBuffer(jsonData))
+			if err != nil {
+				t.Fatalf("Failed to create request: %v", err)
+			}
+			req.Header.Set("Content-Type", "application/json")
+			req.RemoteAddr = "203.0.113.7:40000"
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// The cross-check is a soft signal, so the submission is accepted either way
+			if w.Code != http.StatusOK {
+				t.Fatalf("Expected status code %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+			}
+
+			var response struct {
+				GeolocationWarning *services.GeolocationWarning `json:"geolocation_warning"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+				t.Fatalf("Failed to unmarshal response: %v", err)
+			}
+
+			if !tt.wantWarning {
+				if response.GeolocationWarning != nil {
+					t.Errorf("Expected no geolocation warning, got %+v", response.GeolocationWarning)
+				}
+				return
+			}
+			if response.GeolocationWarning == nil {
+				t.Fatal("Expected a geolocation warning")
+			}
+			if response.GeolocationWarning.IPCountry != tt.location.Country || response.GeolocationWarning.ClientIP != "203.0.113.7" {
+				t.Errorf("Unexpected geolocation warning: %+v", response.GeolocationWarning)
+			}
+			if response.GeolocationWarning.DistanceKm < 500 {
+				t.Errorf("Expected a distance beyond 500 km, got %.1f", response.GeolocationWarning.DistanceKm)
+			}
+		})
+	}
+}
+
+func TestSubmissionHandler_SubmitResult_DoubleSubmitCoalesced(t *testing.T) {
+	jsonData, err := json.Marshal(models.SubmissionRequest{
+		WalletAddress:    "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY",
+		PollingStationID: "STATION_001",
+		GPSCoordinates:   models.GPSCoordinates{Latitude: 40.7128, Longitude: -74.0060},
+		Timestamp:        time.Now().Add(-1 * time.Hour),
+		Results:          map[string]int{"Candidate A": 100, "Candidate B": 150},
+		SubmissionType:   "image_ocr",
+		Confidence:       0.85,
+	})
+	if err != nil {
+		t.Fatalf("Failed to marshal JSON: %v", err)
+	}
+
+	submit := func(router *gin.Engine) map[string]interface{} {
+		req, err := http.NewRequest("POST", "/api/v1/submitResult", bytes.NewBuffer(jsonData))
+		if err != nil {
+			t.Fatalf("Failed to create request: %v", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		if w.Code != http.StatusOK {
+			t.Fatalf("Expected status code %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+
+		var response map[string]interface{}
+		if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+			t.Fatalf("Failed to unmarshal response: %v", err)
+		}
+		return response
+	}
+
+	t.Run("within window", func(t *testing.T) {
+		_, router := setupTestHandler()
+
+		first := submit(router)
+		second := submit(router)
+
+		if second["coalesced"] != true {
+			t.Errorf("Expected the second submission to be coalesced, got %v", second)
+		}
+		if second["submission_id"] != first["submission_id"] {
+			t.Errorf("Expected the coalesced submission to reference %v, got %v", first["submission_id"], second["submission_id"])
+		}
+		if _, ok := second["consensus"]; ok {
+			t.Error("Expected consensus not to run again for a coalesced submission")
+		}
+	})
+
+	t.Run("outside window", func(t *testing.T) {
+		handler, router := setupTestHandler()
+		handler.storageService.SetDuplicateWindow(20 * time.Millisecond)
+
+		first := submit(router)
+		time.Sleep(30 * time.Millisecond)
+		second := submit(router)
+
+		if _, ok := second["coalesced"]; ok {
+			t.Errorf("Expected the second submission to be processed, got %v", second)
+		}
+		if second["submission_id"] == first["submission_id"] {
+			t.Errorf("Expected a new submission ID, got %v for both", first["submission_id"])
+		}
+		if _, ok := second["consensus"]; !ok {
+			t.Error("Expected consensus to run for the second submission")
+		}
+	})
+}
+
+// stubEvidenceFetcher serves fixed evidence content and counts fetches
+type stubEvidenceFetcher struct {
+	content []byte
+	fetches int
+}
+
+func (f *stubEvidenceFetcher) Fetch(uri string) ([]byte, error) {
+	f.fetches++
+	return f.content, nil
+}
+
+func TestSubmissionHandler_SubmitResult_EvidenceVerification(t *testing.T) {
+	evidence := []byte("tally sheet photo")
+	sum := sha256.Sum256(evidence)
+	matchingHash := hex.EncodeToString(sum[:])
+
+	tests := []struct {
+		name           string
+		evidenceURI    string
+		evidenceHash   string
+		expectedStatus int
+		expectedCode   string
+		expectedFetch  int
+	}{
+		{"matching hash is accepted", "https://evidence.example/sheet.jpg", matchingHash, http.StatusOK, "", 1},
+		{"prefixed hash is accepted", "https://evidence.example/sheet.jpg", "sha256:" + strings.ToUpper(matchingHash), http.StatusOK, "", 1},
+		{"mismatched hash is rejected", "https://evidence.example/sheet.jpg", strings.Repeat("0", 64), http.StatusUnprocessableEntity, "EVIDENCE_MISMATCH", 1},
+		{"submission without evidence is unaffected", "", "", http.StatusOK, "", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, router := setupTestHandler()
+			fetcher := &stubEvidenceFetcher{content: evidence}
+			handler.SetEvidenceVerifier(services.NewEvidenceVerifier(fetcher))
+
+			jsonData, err := json.Marshal(models.SubmissionRequest{
+				WalletAddress:    "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY",
+				PollingStationID: "STATION_001",
+				GPSCoordinates:   models.GPSCoordinates{Latitude: 40.7128, Longitude: -74.0060},
+				Timestamp:        time.Now().Add(-1 * time.Hour),
+				Results:          map[string]int{"Candidate A": 100, "Candidate B": 150},
+				SubmissionType:   "image_ocr",
+				Confidence:       0.85,
+				EvidenceURI:      tt.evidenceURI,
+				EvidenceHash:     tt.evidenceHash,
+			})
+			if err != nil {
+				t.Fatalf("Failed to marshal JSON: %v", err)
+			}
+
+			req, err := http.NewRequest("POST", "/api/v1/submitResult", bytes.NewBuffer(jsonData))
+			if err != nil {
+				t.Fatalf("Failed to create request: %v", err)
+			}
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("Expected status code %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+			if fetcher.fetches != tt.expectedFetch {
+				t.Errorf("Expected %d evidence fetches, got %d", tt.expectedFetch, fetcher.fetches)
+			}
+
+			stored := handler.storageService.GetSubmissionsByStation("STATION_001")
+			if tt.expectedCode != "" {
+				var response models.ErrorResponse
+				if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+					t.Fatalf("Failed to unmarshal response: %v", err)
+				}
+				if response.Code != tt.expectedCode {
+					t.Errorf("Expected error code %s, got %s", tt.expectedCode, response.Code)
+				}
+				if len(stored) != 0 {
+					t.Errorf("Expected the rejected submission not to be stored, got %d submissions", len(stored))
+				}
+				return
+			}
+			if len(stored) != 1 || stored[0].EvidenceHash != tt.evidenceHash {
+				t.Errorf("Expected the submission to be stored with its evidence hash, got %v", stored)
+			}
+		})
+	}
+}
+
 func TestSubmissionHandler_SubmitResult_ValidationError(t *testing.T) {
 	_, router := setupTestHandler()
 
@@ -330,4 +836,446 @@ func TestSubmissionHandler_SubmitResult_DuplicateSubmission(t *testing.T) {
 	if submissions[0].Results["Candidate A"] != 120 {
 		t.Errorf("Expected latest submission results, got %d", submissions[0].Results["Candidate A"])
 	}
-}
\ No newline at end of file
+}
+
+func TestSubmissionHandler_SubmitResult_QueuedConsensus(t *testing.T) {
+	handler, router := setupTestHandler()
+
+	logger := logrus.New()
+	logger.SetLevel(logrus.PanicLevel)
+	pool := services.NewConsensusWorkerPool(handler.consensusService, handler.consensusRecovery, logger, 2, 100)
+	pool.Start()
+	handler.SetConsensusPool(pool)
+
+	wallets := []string{
+		"5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY",
+		"5FHneW46xGXgs5mUiveU4sbTyGBzmstUspZC92UhjJM694ty",
+		"5FLSigC9HGRKVhB9FiEo4Y3koPsNmBmLJbpXg2mp1hXcS59Y",
+	}
+
+	for _, wallet := range wallets {
+		reqBody := models.SubmissionRequest{
+			WalletAddress:    wallet,
+			PollingStationID: "STATION_001",
+			GPSCoordinates:   models.GPSCoordinates{Latitude: -1.2921, Longitude: 36.8219},
+			Timestamp:        time.Now(),
+			Results:          map[string]int{"Candidate A": 100, "Candidate B": 150},
+			SubmissionType:   "image_ocr",
+			Confidence:       0.9,
+		}
+		jsonBody, _ := json.Marshal(reqBody)
+
+		start := time.Now()
+		req, _ := http.NewRequest("POST", "/api/v1/submitResult", bytes.NewBuffer(jsonBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("Expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Errorf("Expected queued submission to return quickly, took %v", elapsed)
+		}
+
+		var response map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		consensus, ok := response["consensus"].(map[string]interface{})
+		if !ok {
+			t.Fatal("Expected consensus information in response")
+		}
+		if consensus["status"] != "Pending" {
+			t.Errorf("Expected queued consensus status 'Pending', got %v", consensus["status"])
+		}
+	}
+
+	// Consensus converges once the queue drains
+	pool.Wait()
+
+	station, err := handler.storageService.GetPollingStation("STATION_001")
+	if err != nil {
+		t.Fatalf("GetPollingStation() error = %v", err)
+	}
+	if station.Status != "Verified" {
+		t.Errorf("Expected station to be verified after queued consensus, got %s", station.Status)
+	}
+}
+
+func TestSubmissionHandler_DiffSubmissions(t *testing.T) {
+	handler, router := setupTestHandler()
+	router.GET("/api/v1/submissions/diff", handler.DiffSubmissions)
+
+	handler.storageService.StoreSubmission(models.Submission{
+		ID:               "diff-a",
+		WalletAddress:    "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY",
+		PollingStationID: "STATION_001",
+		Results:          map[string]int{"Candidate A": 100, "Candidate B": 150},
+		SubmissionType:   "image_ocr",
+	})
+	handler.storageService.StoreSubmission(models.Submission{
+		ID:               "diff-b",
+		WalletAddress:    "5FHneW46xGXgs5mUiveU4sbTyGBzmstUspZC92UhjJM694ty",
+		PollingStationID: "STATION_001",
+		Results:          map[string]int{"Candidate A": 100, "Candidate B": 140, "spoilt": 3},
+		SubmissionType:   "audio_stt",
+	})
+
+	tests := []struct {
+		name           string
+		query          string
+		expectedStatus int
+	}{
+		{"BothSubmissionsFound", "?a=diff-a&b=diff-b", http.StatusOK},
+		{"MissingParameter", "?a=diff-a", http.StatusBadRequest},
+		{"UnknownSubmission", "?a=diff-a&b=unknown", http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest("GET", "/api/v1/submissions/diff"+tt.query, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("Expected status %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var response struct {
+				Diff services.SubmissionDiff `json:"diff"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+				t.Fatalf("Failed to parse response: %v", err)
+			}
+			if response.Diff.MismatchCount != 2 {
+				t.Errorf("Expected 2 mismatches, got %d", response.Diff.MismatchCount)
+			}
+			if len(response.Diff.Lines) != 3 {
+				t.Errorf("Expected 3 diff lines, got %d", len(response.Diff.Lines))
+			}
+		})
+	}
+}
+
+
+func TestSubmissionHandler_DiffSubmissions_Embargo(t *testing.T) {
+	handler, router := setupTestHandler()
+	router.GET("/api/v1/submissions/diff", handler.DiffSubmissions)
+
+	if err := handler.storageService.StoreVotingProcess(models.VotingProcess{
+		ID:                   "diff-embargo",
+		Title:                "Embargoed Election",
+		Position:             "President",
+		Candidates:           []models.Candidate{{ID: "c1", Name: "Candidate A"}, {ID: "c2", Name: "Candidate B"}},
+		PollingStations:      []string{"DIFF_EMBARGO"},
+		Status:               "Active",
+		EmbargoUntilComplete: true,
+		CreatedAt:            time.Now(),
+	}); err != nil {
+		t.Fatalf("Failed to store voting process: %v", err)
+	}
+	for id, results := range map[string]map[string]int{
+		"diff-embargo-a": {"Candidate A": 100, "Candidate B": 150},
+		"diff-embargo-b": {"Candidate A": 100, "Candidate B": 140},
+	} {
+		if err := handler.storageService.StoreSubmission(models.Submission{
+			ID:               id,
+			WalletAddress:    "wallet-" + id,
+			PollingStationID: "DIFF_EMBARGO",
+			Results:          results,
+			SubmissionType:   "image_ocr",
+		}); err != nil {
+			t.Fatalf("Failed to store submission %s: %v", id, err)
+		}
+	}
+
+	diff := func() int {
+		req, _ := http.NewRequest("GET", "/api/v1/submissions/diff?a=diff-embargo-a&b=diff-embargo-b", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	if code := diff(); code != http.StatusConflict {
+		t.Errorf("Expected status %d while embargoed, got %d", http.StatusConflict, code)
+	}
+
+	if err := handler.storageService.UpdateVotingProcessStatus("diff-embargo", "Complete"); err != nil {
+		t.Fatalf("Failed to complete voting process: %v", err)
+	}
+	if code := diff(); code != http.StatusOK {
+		t.Errorf("Expected status %d once complete, got %d", http.StatusOK, code)
+	}
+}
+
+func TestSubmissionHandler_GetSubmissionAck(t *testing.T) {
+	handler, router := setupTestHandler()
+	router.GET("/api/v1/submission/:id/ack", handler.GetSubmissionAck)
+
+	reqBody := models.SubmissionRequest{
+		WalletAddress:    "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY",
+		PollingStationID: "STATION_001",
+		GPSCoordinates:   models.GPSCoordinates{Latitude: 40.7128, Longitude: -74.0060},
+		Timestamp:        time.Now().Add(-1 * time.Hour),
+		Results:          map[string]int{"Candidate A": 100, "Candidate B": 150},
+		SubmissionType:   "image_ocr",
+		Confidence:       0.85,
+	}
+	jsonBody, _ := json.Marshal(reqBody)
+
+	req, _ := http.NewRequest("POST", "/api/v1/submitResult", bytes.NewBuffer(jsonBody))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+
+	var submitResponse struct {
+		SubmissionID string               `json:"submission_id"`
+		Ack          models.SubmissionAck `json:"ack"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &submitResponse); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if !submitResponse.Ack.Stored || submitResponse.Ack.SubmissionID != submitResponse.SubmissionID {
+		t.Errorf("Expected stored ack for %s, got %+v", submitResponse.SubmissionID, submitResponse.Ack)
+	}
+
+	t.Run("StoredSubmission", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/api/v1/submission/"+submitResponse.SubmissionID+"/ack", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("Expected status %d, got %d", http.StatusOK, w.Code)
+		}
+
+		var response struct {
+			Ack models.SubmissionAck `json:"ack"`
+		}
+		if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+			t.Fatalf("Failed to parse response: %v", err)
+		}
+		if !response.Ack.Stored {
+			t.Error("Expected ack to report the submission as stored")
+		}
+		if response.Ack.Durable {
+			t.Error("Expected in-memory storage not to report durability")
+		}
+		if response.Ack.PollingStationID != "STATION_001" {
+			t.Errorf("Expected polling station STATION_001, got %s", response.Ack.PollingStationID)
+		}
+	})
+
+	t.Run("UnknownSubmission", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/api/v1/submission/unknown/ack", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		if w.Code != http.StatusNotFound {
+			t.Errorf("Expected status %d, got %d", http.StatusNotFound, w.Code)
+		}
+	})
+}
+
+func TestSubmissionHandler_SubmitResult_LogRedaction(t *testing.T) {
+	wallet := "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY"
+
+	submitWithLogs := func(t *testing.T, redact bool) string {
+		handler, router := setupTestHandler()
+
+		var logs bytes.Buffer
+		logger := logrus.New()
+		logger.SetFormatter(&logrus.JSONFormatter{})
+		logger.SetOutput(&logs)
+		if redact {
+			logger.AddHook(services.NewPIIRedactionHook())
+		}
+		handler.logger = logger
+
+		jsonBody, _ := json.Marshal(models.SubmissionRequest{
+			WalletAddress:    wallet,
+			PollingStationID: "STATION_001",
+			GPSCoordinates:   models.GPSCoordinates{Latitude: 40.7128, Longitude: -74.0060},
+			Timestamp:        time.Now().Add(-1 * time.Hour),
+			Results:          map[string]int{"Candidate A": 100, "Candidate B": 150},
+			SubmissionType:   "image_ocr",
+		})
+
+		req, _ := http.NewRequest("POST", "/api/v1/submitResult", bytes.NewBuffer(jsonBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("Expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+		return logs.String()
+	}
+
+	t.Run("RedactionOn", func(t *testing.T) {
+		logs := submitWithLogs(t, true)
+		if strings.Contains(logs, wallet) {
+			t.Errorf("Expected wallet address to be redacted from logs:\n%s", logs)
+		}
+		if !strings.Contains(logs, services.RedactWalletAddress(wallet)) {
+			t.Errorf("Expected truncated wallet address %s in logs:\n%s", services.RedactWalletAddress(wallet), logs)
+		}
+	})
+
+	t.Run("RedactionOff", func(t *testing.T) {
+		logs := submitWithLogs(t, false)
+		if !strings.Contains(logs, wallet) {
+			t.Errorf("Expected full wallet address in logs:\n%s", logs)
+		}
+	})
+}
+
+func TestSubmissionHandler_SubmitResult_ProcessStageErrors(t *testing.T) {
+	handler, router := setupTestHandler()
+
+	processes := []models.VotingProcess{
+		{ID: "vp-setup", Title: "Future Election", Position: "Mayor", PollingStations: []string{"STATION_SETUP"}, Status: "Setup"},
+		{ID: "vp-complete", Title: "Past Election", Position: "Mayor", PollingStations: []string{"STATION_COMPLETE"}, Status: "Setup"},
+	}
+	for _, process := range processes {
+		if err := handler.storageService.StoreVotingProcess(process); err != nil {
+			t.Fatalf("Failed to store voting process: %v", err)
+		}
+	}
+	handler.storageService.UpdateVotingProcessStatus("vp-complete", "Active")
+	handler.storageService.UpdateVotingProcessStatus("vp-complete", "Complete")
+
+	tests := []struct {
+		stationID      string
+		expectedStatus int
+		expectedCode   string
+	}{
+		{"STATION_SETUP", http.StatusConflict, "PROCESS_NOT_STARTED"},
+		{"STATION_COMPLETE", http.StatusConflict, "PROCESS_COMPLETED"},
+		{"STATION_UNKNOWN", http.StatusNotFound, "UNKNOWN_STATION"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expectedCode, func(t *testing.T) {
+			jsonBody, _ := json.Marshal(models.SubmissionRequest{
+				WalletAddress:    "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY",
+				PollingStationID: tt.stationID,
+				GPSCoordinates:   models.GPSCoordinates{Latitude: 40.7128, Longitude: -74.0060},
+				Timestamp:        time.Now().Add(-1 * time.Hour),
+				Results:          map[string]int{"Candidate A": 100, "Candidate B": 150},
+				SubmissionType:   "image_ocr",
+			})
+
+			req, _ := http.NewRequest("POST", "/api/v1/submitResult", bytes.NewBuffer(jsonBody))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("Expected status %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+
+			var response models.ErrorResponse
+			if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+				t.Fatalf("Failed to parse response: %v", err)
+			}
+			if response.Code != tt.expectedCode {
+				t.Errorf("Expected error code %s, got %s", tt.expectedCode, response.Code)
+			}
+		})
+	}
+}
+
+func TestSubmissionHandler_SubmitCompactResult(t *testing.T) {
+	handler, router := setupTestHandler()
+
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	submission := models.SubmissionRequest{
+		WalletAddress:    services.SS58Address(publicKey, 42),
+		PollingStationID: "STATION_001",
+		GPSCoordinates:   models.GPSCoordinates{Latitude: -1.2921, Longitude: 36.8219},
+		Timestamp:        time.Now().Add(-1 * time.Hour),
+		Results:          map[string]int{"Candidate A": 100, "Candidate B": 150, "spoilt": 5},
+		SubmissionType:   "image_ocr",
+		Confidence:       0.85,
+	}
+	encoded, err := services.EncodeCompactSubmission(submission, privateKey)
+	if err != nil {
+		t.Fatalf("EncodeCompactSubmission() error = %v", err)
+	}
+
+	postCompact := func(data string) *httptest.ResponseRecorder {
+		body, _ := json.Marshal(models.CompactSubmissionRequest{Data: data})
+		req, _ := http.NewRequest("POST", "/api/v1/submitResult/compact", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("ValidEncodingIsStored", func(t *testing.T) {
+		w := postCompact(encoded)
+		if w.Code != http.StatusOK {
+			t.Fatalf("Expected status code %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+
+		var response map[string]interface{}
+		if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+			t.Fatalf("Failed to unmarshal response: %v", err)
+		}
+		submissionID, _ := response["submission_id"].(string)
+
+		stored := handler.storageService.GetSubmissionsByStation("STATION_001")
+		if len(stored) != 1 {
+			t.Fatalf("Expected 1 stored submission, got %d", len(stored))
+		}
+		if stored[0].ID != submissionID || stored[0].WalletAddress != submission.WalletAddress {
+			t.Errorf("Stored submission %+v does not match response ID %s and wallet %s", stored[0], submissionID, submission.WalletAddress)
+		}
+		if stored[0].Results["Candidate B"] != 150 || stored[0].SubmissionType != "image_ocr" {
+			t.Errorf("Stored submission lost its decoded fields: %+v", stored[0])
+		}
+	})
+
+	t.Run("MalformedEncodingRejected", func(t *testing.T) {
+		notJSON := base64.RawURLEncoding.EncodeToString([]byte("not json"))
+		for _, data := range []string{"not-a-compact-submission", "!!!.???", notJSON + ".AAAA"} {
+			w := postCompact(data)
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("%q: expected status code %d, got %d", data, http.StatusBadRequest, w.Code)
+				continue
+			}
+			var response models.ErrorResponse
+			if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+				t.Fatalf("Failed to unmarshal response: %v", err)
+			}
+			if response.Code != string(services.ErrorTypeInvalidCompactEncoding) {
+				t.Errorf("%q: expected code %s, got %s", data, services.ErrorTypeInvalidCompactEncoding, response.Code)
+			}
+		}
+	})
+
+	t.Run("ForeignSignatureRejected", func(t *testing.T) {
+		_, otherKey, _ := ed25519.GenerateKey(nil)
+		forged, err := services.EncodeCompactSubmission(submission, otherKey)
+		if err != nil {
+			t.Fatalf("EncodeCompactSubmission() error = %v", err)
+		}
+
+		w := postCompact(forged)
+		if w.Code != http.StatusUnauthorized {
+			t.Fatalf("Expected status code %d, got %d", http.StatusUnauthorized, w.Code)
+		}
+		if !strings.Contains(w.Body.String(), string(services.ErrorTypeInvalidSignature)) {
+			t.Errorf("Expected %s in response, got %s", services.ErrorTypeInvalidSignature, w.Body.String())
+		}
+	})
+}
@@ -1,22 +1,32 @@
 package handlers
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 
+	"oyah-backend/internal/models"
 	"oyah-backend/internal/services"
 )
 
+// maxTallyBatchSize limits how many voting processes a single batch tally request may fetch
+const maxTallyBatchSize = 50
+
 // TallyHandler handles tally-related HTTP requests
 type TallyHandler struct {
-	tallyService *services.TallyService
-	errorHandler *services.ErrorHandler
-	logger       *logrus.Logger
+	tallyService   *services.TallyService
+	packageService *services.ElectionPackageService // Optional; required for election packages
+	errorHandler   *services.ErrorHandler
+	logger         *logrus.Logger
 }
 
 // NewTallyHandler creates a new tally handler
@@ -28,6 +38,11 @@ func NewTallyHandler(tallyService *services.TallyService, errorHandler *services
 	}
 }
 
+// SetElectionPackageService sets the service assembling election packages for archival
+func (h *TallyHandler) SetElectionPackageService(packageService *services.ElectionPackageService) {
+	h.packageService = packageService
+}
+
 // GetTally handles GET /api/v1/getTally/{votingProcessId} requests
 func (h *TallyHandler) GetTally(c *gin.Context) {
 	// Generate request ID for tracing
@@ -69,8 +84,47 @@ func (h *TallyHandler) GetTally(c *gin.Context) {
 		return
 	}
 
-	// Handle zero result scenarios gracefully
-	h.tallyService.HandleZeroResultScenarios(tallyData)
+	// Optionally leave contested stations out of the aggregate
+	if exclude := c.Query("exclude"); exclude != "" {
+		var stationIDs []string
+		for _, stationID := range strings.Split(exclude, ",") {
+			if stationID = strings.TrimSpace(stationID); stationID != "" {
+				stationIDs = append(stationIDs, stationID)
+			}
+		}
+		h.tallyService.ExcludeStations(tallyData, stationIDs)
+	}
+
+	// Optionally include the provisional view of pending and provisionally verified stations
+	if h.includes(c, "provisional") {
+		if err := h.tallyService.IncludeProvisionalTally(tallyData); err != nil {
+			h.errorHandler.HandleServiceError(c, err, "tally", "include_provisional_tally")
+			return
+		}
+	}
+
+	// Let polling clients revalidate cheaply with If-None-Match / If-Modified-Since
+	etag, err := tallyETag(tallyData)
+	if err != nil {
+		h.errorHandler.HandleServiceError(c, err, "tally", "compute_etag")
+		return
+	}
+	c.Header("ETag", etag)
+	c.Header("Cache-Control", "no-cache")
+
+	// Pending submissions in the provisional view have no modification time, so only ETags apply there
+	var lastModified time.Time
+	if !h.includes(c, "provisional") {
+		if lastModified, err = h.tallyService.GetTallyLastModified(votingProcessID); err == nil {
+			c.Header("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+		}
+	}
+
+	if notModified(c, etag, lastModified) {
+		logger.Info("Tally unchanged since last request")
+		c.Status(http.StatusNotModified)
+		return
+	}
 
 	logger.WithFields(logrus.Fields{
 		"verified_stations": h.countVerifiedStations(tallyData.PollingStations),
@@ -82,12 +136,352 @@ func (h *TallyHandler) GetTally(c *gin.Context) {
 	c.JSON(http.StatusOK, tallyData)
 }
 
+// GetTallyBatch handles POST /api/v1/getTally/batch requests.
+// Each voting process succeeds or fails independently, so unknown IDs do not fail the whole request.
+func (h *TallyHandler) GetTallyBatch(c *gin.Context) {
+	// Generate request ID for tracing
+	requestID := uuid.New().String()
+	c.Set("request_id", requestID)
+
+	// Create logger with request context
+	logger := h.logger.WithFields(logrus.Fields{
+		"request_id": requestID,
+		"endpoint":   "getTallyBatch",
+		"method":     c.Request.Method,
+		"client_ip":  c.ClientIP(),
+	})
+
+	logger.Info("Processing batch tally request")
+
+	var req models.TallyBatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.errorHandler.HandleValidationError(c, err, "json_payload")
+		return
+	}
+
+	if len(req.VotingProcessIDs) > maxTallyBatchSize {
+		h.errorHandler.HandleValidationError(c,
+			fmt.Errorf("at most %d voting process IDs may be requested at once", maxTallyBatchSize),
+			"votingProcessIds")
+		return
+	}
+
+	results := make(map[string]services.TallyBatchResult, len(req.VotingProcessIDs))
+	failed := 0
+	for _, votingProcessID := range req.VotingProcessIDs {
+		if _, done := results[votingProcessID]; done {
+			continue
+		}
+
+		tallyData, err := h.tallyService.GetTallyData(votingProcessID)
+		if err != nil {
+			failed++
+			if contains(err.Error(), "voting process not found") {
+				results[votingProcessID] = services.TallyBatchResult{Error: services.NewAPIError(
+					services.ErrorTypeNotFound,
+					"voting process not found",
+					fmt.Sprintf("The requested voting process with identifier '%s' does not exist", votingProcessID),
+					http.StatusNotFound,
+				)}
+				continue
+			}
+
+			logger.WithError(err).WithField("voting_process_id", votingProcessID).Error("Failed to get tally data")
+			results[votingProcessID] = services.TallyBatchResult{Error: services.NewAPIError(
+				services.ErrorTypeServiceError,
+				"Failed to get tally data",
+				err.Error(),
+				http.StatusInternalServerError,
+			)}
+			continue
+		}
+
+		results[votingProcessID] = services.TallyBatchResult{Tally: tallyData}
+	}
+
+	logger.WithFields(logrus.Fields{
+		"requested": len(results),
+		"failed":    failed,
+	}).Info("Batch tally retrieved successfully")
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"results": results,
+	})
+}
+
+// tallyETag derives a strong ETag from the tally content, ignoring the per-request LastUpdated timestamp
+func tallyETag(tallyData *services.TallyResponse) (string, error) {
+	content := *tallyData
+	content.LastUpdated = time.Time{}
+
+	body, err := json.Marshal(content)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode tally for ETag: %w", err)
+	}
+
+	hash := sha256.Sum256(body)
+	return `"` + hex.EncodeToString(hash[:16]) + `"`, nil
+}
+
+// notModified evaluates the request's conditional headers; If-None-Match takes precedence over If-Modified-Since
+func notModified(c *gin.Context, etag string, lastModified time.Time) bool {
+	if ifNoneMatch := c.GetHeader("If-None-Match"); ifNoneMatch != "" {
+		for _, candidate := range strings.Split(ifNoneMatch, ",") {
+			candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+			if candidate == "*" || candidate == etag {
+				return true
+			}
+		}
+		return false
+	}
+
+	if ifModifiedSince := c.GetHeader("If-Modified-Since"); ifModifiedSince != "" && !lastModified.IsZero() {
+		since, err := http.ParseTime(ifModifiedSince)
+		if err == nil && !lastModified.Truncate(time.Second).After(since) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetProgress handles GET /api/v1/voting-process/{id}/progress requests
+func (h *TallyHandler) GetProgress(c *gin.Context) {
+	// Generate request ID for tracing
+	requestID := uuid.New().String()
+	c.Set("request_id", requestID)
+
+	// Get voting process ID from URL parameter
+	votingProcessID := c.Param("id")
+
+	// Create logger with request context
+	logger := h.logger.WithFields(logrus.Fields{
+		"request_id":        requestID,
+		"endpoint":          "getProgress",
+		"method":            c.Request.Method,
+		"client_ip":         c.ClientIP(),
+		"voting_process_id": votingProcessID,
+	})
+
+	logger.Info("Processing get progress request")
+
+	progress, err := h.tallyService.GetVotingProcessProgress(votingProcessID)
+	if err != nil {
+		if contains(err.Error(), "voting process not found") {
+			h.errorHandler.HandleNotFoundError(c, "voting process", votingProcessID)
+			return
+		}
+
+		h.errorHandler.HandleServiceError(c, err, "tally", "get_progress")
+		return
+	}
+
+	logger.WithFields(logrus.Fields{
+		"verified_stations":  progress.VerifiedStations,
+		"reporting_stations": progress.ReportingStations,
+		"total_stations":     progress.TotalStations,
+	}).Info("Progress retrieved successfully")
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":  true,
+		"progress": progress,
+	})
+}
+
+// GetProjection handles GET /api/v1/voting-process/{id}/projection requests
+func (h *TallyHandler) GetProjection(c *gin.Context) {
+	// Generate request ID for tracing
+	requestID := uuid.New().String()
+	c.Set("request_id", requestID)
+
+	// Get voting process ID from URL parameter
+	votingProcessID := c.Param("id")
+
+	// Create logger with request context
+	logger := h.logger.WithFields(logrus.Fields{
+		"request_id":        requestID,
+		"endpoint":          "getProjection",
+		"method":            c.Request.Method,
+		"client_ip":         c.ClientIP(),
+		"voting_process_id": votingProcessID,
+	})
+
+	logger.Info("Processing get projection request")
+
+	projection, err := h.tallyService.GetTallyProjection(votingProcessID)
+	if err != nil {
+		if contains(err.Error(), "voting process not found") {
+			h.errorHandler.HandleNotFoundError(c, "voting process", votingProcessID)
+			return
+		}
+
+		h.errorHandler.HandleServiceError(c, err, "tally", "get_projection")
+		return
+	}
+
+	logger.WithFields(logrus.Fields{
+		"verified_stations": projection.VerifiedStations,
+		"total_stations":    projection.TotalStations,
+		"confidence":        projection.Confidence,
+	}).Info("Projection generated successfully")
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":    true,
+		"projection": projection,
+	})
+}
+
+// GetConfidenceDistribution handles GET /api/v1/voting-process/{id}/confidence-distribution requests
+func (h *TallyHandler) GetConfidenceDistribution(c *gin.Context) {
+	// Generate request ID for tracing
+	requestID := uuid.New().String()
+	c.Set("request_id", requestID)
+
+	// Get voting process ID from URL parameter
+	votingProcessID := c.Param("id")
+
+	// Create logger with request context
+	logger := h.logger.WithFields(logrus.Fields{
+		"request_id":        requestID,
+		"endpoint":          "getConfidenceDistribution",
+		"method":            c.Request.Method,
+		"client_ip":         c.ClientIP(),
+		"voting_process_id": votingProcessID,
+	})
+
+	logger.Info("Processing get confidence distribution request")
+
+	distribution, err := h.tallyService.GetConfidenceDistribution(votingProcessID)
+	if err != nil {
+		if contains(err.Error(), "voting process not found") {
+			h.errorHandler.HandleNotFoundError(c, "voting process", votingProcessID)
+			return
+		}
+
+		h.errorHandler.HandleServiceError(c, err, "tally", "get_confidence_distribution")
+		return
+	}
+
+	logger.WithField("verified_stations", distribution.VerifiedStations).Info("Confidence distribution retrieved successfully")
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":      true,
+		"distribution": distribution,
+	})
+}
+
+// GetElectionPackage handles GET /api/v1/voting-process/{id}/package requests.
+// It serves a completed process's self-verifying election package as a downloadable JSON file.
+func (h *TallyHandler) GetElectionPackage(c *gin.Context) {
+	// Generate request ID for tracing
+	requestID := uuid.New().String()
+	c.Set("request_id", requestID)
+
+	// Get voting process ID from URL parameter
+	votingProcessID := c.Param("id")
+
+	// Create logger with request context
+	logger := h.logger.WithFields(logrus.Fields{
+		"request_id":        requestID,
+		"endpoint":          "getElectionPackage",
+		"method":            c.Request.Method,
+		"client_ip":         c.ClientIP(),
+		"voting_process_id": votingProcessID,
+	})
+
+	logger.Info("Processing get election package request")
+
+	if h.packageService == nil {
+		h.errorHandler.HandleError(c, services.NewAPIError(
+			services.ErrorTypeServiceError,
+			"Election packages unavailable",
+			"Election packages require the election package service",
+			http.StatusServiceUnavailable,
+		), map[string]interface{}{"voting_process_id": votingProcessID})
+		return
+	}
+
+	pkg, err := h.packageService.BuildPackage(votingProcessID)
+	if err != nil {
+		if errors.Is(err, services.ErrProcessNotComplete) {
+			h.errorHandler.HandleError(c, services.NewAPIError(
+				services.ErrorTypeConflict,
+				"Voting process is not complete",
+				"An election package is only available once the voting process is Complete",
+				http.StatusConflict,
+			), map[string]interface{}{"voting_process_id": votingProcessID})
+			return
+		}
+		if contains(err.Error(), "voting process not found") {
+			h.errorHandler.HandleNotFoundError(c, "voting process", votingProcessID)
+			return
+		}
+
+		h.errorHandler.HandleServiceError(c, err, "election_package", "build_package")
+		return
+	}
+
+	logger.WithFields(logrus.Fields{
+		"polling_stations": len(pkg.PollingStations),
+		"hash":             pkg.Hash,
+	}).Info("Election package generated successfully")
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="election-package-%s.json"`, votingProcessID))
+	c.JSON(http.StatusOK, pkg)
+}
+
+// GetTrend handles GET /api/v1/voting-process/{id}/trend requests
+func (h *TallyHandler) GetTrend(c *gin.Context) {
+	// Generate request ID for tracing
+	requestID := uuid.New().String()
+	c.Set("request_id", requestID)
+
+	// Get voting process ID from URL parameter
+	votingProcessID := c.Param("id")
+
+	// Create logger with request context
+	logger := h.logger.WithFields(logrus.Fields{
+		"request_id":        requestID,
+		"endpoint":          "getTrend",
+		"method":            c.Request.Method,
+		"client_ip":         c.ClientIP(),
+		"voting_process_id": votingProcessID,
+	})
+
+	logger.Info("Processing get trend request")
+
+	trend, err := h.tallyService.GetTallyTrend(votingProcessID)
+	if err != nil {
+		h.errorHandler.HandleNotFoundError(c, "voting process", votingProcessID)
+		return
+	}
+
+	logger.WithField("snapshot_count", len(trend)).Info("Tally trend retrieved successfully")
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":           true,
+		"voting_process_id": votingProcessID,
+		"trend":             trend,
+	})
+}
+
+// includes reports whether the comma-separated "include" query parameter contains the given option
+func (h *TallyHandler) includes(c *gin.Context, option string) bool {
+	for _, value := range strings.Split(c.Query("include"), ",") {
+		if strings.TrimSpace(value) == option {
+			return true
+		}
+	}
+	return false
+}
+
 // Helper methods for logging
 
 func (h *TallyHandler) countVerifiedStations(stations []services.StationStatus) int {
 	count := 0
 	for _, station := range stations {
-		if station.Status == "Verified" {
+		if station.Status == models.StationStatusVerified {
 			count++
 		}
 	}
@@ -97,7 +491,7 @@ func (h *TallyHandler) countVerifiedStations(stations []services.StationStatus)
 func (h *TallyHandler) countPendingStations(stations []services.StationStatus) int {
 	count := 0
 	for _, station := range stations {
-		if station.Status == "Pending" {
+		if station.Status == models.StationStatusPending {
 			count++
 		}
 	}
@@ -1,9 +1,13 @@
 package handlers
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -269,4 +273,698 @@ func TestTallyHandler_HelperMethods(t *testing.T) {
 
 	total := handler.sumTotalVotes(tally)
 	assert.Equal(t, 360, total)
-}
\ No newline at end of file
+}
+
+func TestTallyHandler_GetTally_ProvisionalTally(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	// Initialize services
+	storage := services.NewStorageService()
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+	consensusService := services.NewConsensusService(storage, logger)
+	tallyService := services.NewTallyService(storage, logger)
+	tallyService.SetConsensusService(consensusService)
+	errorHandler := services.NewErrorHandler(logger)
+	tallyHandler := NewTallyHandler(tallyService, errorHandler, logger)
+
+	votingProcess := models.VotingProcess{
+		ID:       "test-process-provisional",
+		Title:    "Provisional Election",
+		Position: "Mayor",
+		Candidates: []models.Candidate{
+			{ID: "candidate-1", Name: "Alice Johnson"},
+			{ID: "candidate-2", Name: "Bob Smith"},
+		},
+		PollingStations: []string{"station-1", "station-2", "station-3", "station-4"},
+		Status:          "Active",
+		CreatedAt:       time.Now(),
+	}
+	require.NoError(t, storage.StoreVotingProcess(votingProcess))
+
+	// station-1 is verified
+	err := storage.UpdatePollingStationStatus("station-1", "Verified", map[string]int{"Alice Johnson": 100, "Bob Smith": 50}, 0.9)
+	require.NoError(t, err)
+
+	// station-2 is pending, with two witnesses agreeing and one dissenting
+	pendingSubmissions := []struct {
+		wallet  string
+		results map[string]int
+	}{
+		{"5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY", map[string]int{"Alice Johnson": 30, "Bob Smith": 40}},
+		{"5FHneW46xGXgs5mUiveU4sbTyGBzmstUspZC92UhjJM694ty", map[string]int{"Alice Johnson": 30, "Bob Smith": 40}},
+		{"5FLSigC9HGRKVhB9FiEo4Y3koPsNmBmLJbpXg2mp1hXcS59Y", map[string]int{"Alice Johnson": 35, "Bob Smith": 40}},
+	}
+	for i, submission := range pendingSubmissions {
+		require.NoError(t, storage.StoreSubmission(models.Submission{
+			ID:               fmt.Sprintf("pending-%d", i),
+			WalletAddress:    submission.wallet,
+			PollingStationID: "station-2",
+			Timestamp:        time.Now(),
+			Results:          submission.results,
+			SubmissionType:   "image_ocr",
+		}))
+	}
+
+	// station-3 is pending without submissions
+
+	// station-4 qualified for verification and is waiting out the stability window
+	provisionalResults := map[string]int{"Alice Johnson": 20, "Bob Smith": 10}
+	for i, wallet := range []string{"5DAAnrj7VHTznn2AWBemMuyBwZWs6FNFjdyVXUeYum3PTXFy", "5HGjWAeFDfFCWPsjFQdVV2Msvz2XtMktvgocEZcCj68kUMaw"} {
+		require.NoError(t, storage.StoreSubmission(models.Submission{
+			ID:               fmt.Sprintf("provisional-%d", i),
+			WalletAddress:    wallet,
+			PollingStationID: "station-4",
+			Timestamp:        time.Now(),
+			Results:          provisionalResults,
+			SubmissionType:   "image_ocr",
+		}))
+	}
+	require.NoError(t, storage.UpdatePollingStationStatus("station-4", "VerifiedProvisional", provisionalResults, 0.8))
+
+	router := gin.New()
+	router.GET("/api/v1/getTally/:votingProcessId", tallyHandler.GetTally)
+
+	t.Run("WithProvisional", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/api/v1/getTally/test-process-provisional?include=provisional", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response services.TallyResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+
+		// Verified tally excludes pending stations
+		assert.Equal(t, 100, response.AggregatedTally["Alice Johnson"])
+		assert.Equal(t, 50, response.AggregatedTally["Bob Smith"])
+
+		// Provisional tally adds the leading results of the pending and provisionally verified stations
+		assert.Equal(t, 150, response.ProvisionalTally["Alice Johnson"])
+		assert.Equal(t, 100, response.ProvisionalTally["Bob Smith"])
+		assert.ElementsMatch(t, []string{"station-2", "station-4"}, response.ProvisionalStations)
+	})
+
+	t.Run("WithoutProvisional", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/api/v1/getTally/test-process-provisional", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response services.TallyResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+
+		assert.Equal(t, 100, response.AggregatedTally["Alice Johnson"])
+		assert.Nil(t, response.ProvisionalTally)
+		assert.Empty(t, response.ProvisionalStations)
+	})
+}
+
+func TestTallyHandler_GetProgress(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	storage := services.NewStorageService()
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+	tallyHandler := NewTallyHandler(services.NewTallyService(storage, logger), services.NewErrorHandler(logger), logger)
+
+	router := gin.New()
+	router.GET("/api/v1/voting-process/:id/progress", tallyHandler.GetProgress)
+
+	for _, id := range []string{"vp-progress", "vp-fresh"} {
+		require.NoError(t, storage.StoreVotingProcess(models.VotingProcess{
+			ID:              id,
+			Title:           "Progress Election",
+			Position:        "Mayor",
+			Candidates:      []models.Candidate{{ID: "c1", Name: "Alice"}, {ID: "c2", Name: "Bob"}},
+			PollingStations: []string{id + "-1", id + "-2", id + "-3", id + "-4"},
+			Status:          "Setup",
+			CreatedAt:       time.Now(),
+		}))
+		require.NoError(t, storage.UpdateVotingProcessStatus(id, "Active"))
+	}
+
+	// One station verified, one more with a submission but still pending
+	require.NoError(t, storage.UpdatePollingStationStatus("vp-progress-1", "Verified", map[string]int{"Alice": 10, "Bob": 5}, 0.9))
+	require.NoError(t, storage.StoreSubmission(models.Submission{
+		ID:               "progress-sub",
+		WalletAddress:    "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY",
+		PollingStationID: "vp-progress-2",
+		Timestamp:        time.Now(),
+		Results:          map[string]int{"Alice": 10, "Bob": 5},
+		SubmissionType:   "image_ocr",
+	}))
+
+	getProgress := func(t *testing.T, processID string) (int, services.ProgressReport) {
+		req, err := http.NewRequest("GET", "/api/v1/voting-process/"+processID+"/progress", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		var response struct {
+			Progress services.ProgressReport `json:"progress"`
+		}
+		if w.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		}
+		return w.Code, response.Progress
+	}
+
+	t.Run("PartialVerification", func(t *testing.T) {
+		code, progress := getProgress(t, "vp-progress")
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, 4, progress.TotalStations)
+		assert.Equal(t, 1, progress.VerifiedStations)
+		assert.Equal(t, 2, progress.ReportingStations)
+		assert.InDelta(t, 0.25, progress.ProgressFraction, 0.0001)
+		assert.InDelta(t, 25.0, progress.ProgressPercentage, 0.0001)
+		assert.NotNil(t, progress.EstimatedCompletion)
+	})
+
+	t.Run("FreshProcess", func(t *testing.T) {
+		code, progress := getProgress(t, "vp-fresh")
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, 0, progress.VerifiedStations)
+		assert.Equal(t, 0, progress.ReportingStations)
+		assert.Equal(t, 0.0, progress.ProgressPercentage)
+		assert.Nil(t, progress.EstimatedCompletion)
+	})
+
+	t.Run("ProcessNotFound", func(t *testing.T) {
+		code, _ := getProgress(t, "missing")
+		assert.Equal(t, http.StatusNotFound, code)
+	})
+}
+
+func TestTallyHandler_GetTally_ConditionalRequests(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	storage := services.NewStorageService()
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+	tallyHandler := NewTallyHandler(services.NewTallyService(storage, logger), services.NewErrorHandler(logger), logger)
+
+	require.NoError(t, storage.StoreVotingProcess(models.VotingProcess{
+		ID:       "test-process-etag",
+		Title:    "Conditional Election",
+		Position: "Mayor",
+		Candidates: []models.Candidate{
+			{ID: "candidate-1", Name: "Alice Johnson"},
+			{ID: "candidate-2", Name: "Bob Smith"},
+		},
+		PollingStations: []string{"station-1", "station-2"},
+		Status:          "Active",
+		CreatedAt:       time.Now().Add(-time.Hour),
+	}))
+	require.NoError(t, storage.UpdatePollingStationStatus("station-1", "Verified", map[string]int{"Alice Johnson": 100, "Bob Smith": 50}, 0.9))
+
+	router := gin.New()
+	router.GET("/api/v1/getTally/:votingProcessId", tallyHandler.GetTally)
+
+	getTally := func(t *testing.T, headers map[string]string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest("GET", "/api/v1/getTally/test-process-etag", nil)
+		require.NoError(t, err)
+		for key, value := range headers {
+			req.Header.Set(key, value)
+		}
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	first := getTally(t, nil)
+	require.Equal(t, http.StatusOK, first.Code)
+	etag := first.Header().Get("ETag")
+	lastModified := first.Header().Get("Last-Modified")
+	require.NotEmpty(t, etag)
+	require.NotEmpty(t, lastModified)
+
+	t.Run("UnchangedETag", func(t *testing.T) {
+		w := getTally(t, map[string]string{"If-None-Match": etag})
+		assert.Equal(t, http.StatusNotModified, w.Code)
+		assert.Empty(t, w.Body.String())
+		assert.Equal(t, etag, w.Header().Get("ETag"))
+	})
+
+	t.Run("UnchangedLastModified", func(t *testing.T) {
+		w := getTally(t, map[string]string{"If-Modified-Since": lastModified})
+		assert.Equal(t, http.StatusNotModified, w.Code)
+	})
+
+	t.Run("ChangedAfterStationVerifies", func(t *testing.T) {
+		require.NoError(t, storage.UpdatePollingStationStatus("station-2", "Verified", map[string]int{"Alice Johnson": 20, "Bob Smith": 70}, 0.8))
+
+		w := getTally(t, map[string]string{"If-None-Match": etag})
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NotEqual(t, etag, w.Header().Get("ETag"))
+
+		var response services.TallyResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, 120, response.AggregatedTally["Alice Johnson"])
+		assert.Equal(t, 120, response.AggregatedTally["Bob Smith"])
+	})
+}
+
+func TestTallyHandler_GetTallyBatch(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	storage := services.NewStorageService()
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+	tallyHandler := NewTallyHandler(services.NewTallyService(storage, logger), services.NewErrorHandler(logger), logger)
+
+	for _, id := range []string{"batch-1", "batch-2"} {
+		require.NoError(t, storage.StoreVotingProcess(models.VotingProcess{
+			ID:       id,
+			Title:    "Batch Election " + id,
+			Position: "Mayor",
+			Candidates: []models.Candidate{
+				{ID: "candidate-1", Name: "Alice Johnson"},
+				{ID: "candidate-2", Name: "Bob Smith"},
+			},
+			PollingStations: []string{id + "-station"},
+			Status:          "Active",
+			CreatedAt:       time.Now(),
+		}))
+	}
+	require.NoError(t, storage.UpdatePollingStationStatus("batch-1-station", "Verified", map[string]int{"Alice Johnson": 100, "Bob Smith": 50}, 0.9))
+
+	router := gin.New()
+	router.GET("/api/v1/getTally/:votingProcessId", tallyHandler.GetTally)
+	router.POST("/api/v1/getTally/batch", tallyHandler.GetTallyBatch)
+
+	postBatch := func(t *testing.T, body string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest("POST", "/api/v1/getTally/batch", strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("MixedValidAndUnknownIDs", func(t *testing.T) {
+		w := postBatch(t, `{"votingProcessIds": ["batch-1", "missing", "batch-2"]}`)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response struct {
+			Success bool                                 `json:"success"`
+			Results map[string]services.TallyBatchResult `json:"results"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.True(t, response.Success)
+		require.Len(t, response.Results, 3)
+
+		first := response.Results["batch-1"]
+		require.NotNil(t, first.Tally)
+		assert.Nil(t, first.Error)
+		assert.Equal(t, 100, first.Tally.AggregatedTally["Alice Johnson"])
+
+		second := response.Results["batch-2"]
+		require.NotNil(t, second.Tally)
+		assert.Nil(t, second.Error)
+		assert.Equal(t, 0, second.Tally.AggregatedTally["Alice Johnson"])
+
+		missing := response.Results["missing"]
+		assert.Nil(t, missing.Tally)
+		require.NotNil(t, missing.Error)
+		assert.Equal(t, services.ErrorTypeNotFound, missing.Error.Type)
+	})
+
+	t.Run("EmptyList", func(t *testing.T) {
+		w := postBatch(t, `{"votingProcessIds": []}`)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("TooManyIDs", func(t *testing.T) {
+		ids := make([]string, maxTallyBatchSize+1)
+		for i := range ids {
+			ids[i] = fmt.Sprintf("batch-%d", i)
+		}
+		body, err := json.Marshal(models.TallyBatchRequest{VotingProcessIDs: ids})
+		require.NoError(t, err)
+
+		w := postBatch(t, string(body))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("SingleTallyRouteUnaffected", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/api/v1/getTally/batch-1", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
+
+func TestTallyHandler_GetProjection(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	storage := services.NewStorageService()
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+	tallyHandler := NewTallyHandler(services.NewTallyService(storage, logger), services.NewErrorHandler(logger), logger)
+
+	router := gin.New()
+	router.GET("/api/v1/voting-process/:id/projection", tallyHandler.GetProjection)
+
+	for _, id := range []string{"vp-projection", "vp-unverified"} {
+		require.NoError(t, storage.StoreVotingProcess(models.VotingProcess{
+			ID:              id,
+			Title:           "Projection Election",
+			Position:        "Mayor",
+			Candidates:      []models.Candidate{{ID: "c1", Name: "Alice"}, {ID: "c2", Name: "Bob"}},
+			PollingStations: []string{id + "-1", id + "-2", id + "-3", id + "-4"},
+			Status:          "Active",
+			CreatedAt:       time.Now(),
+		}))
+	}
+
+	// Half the stations verified
+	require.NoError(t, storage.UpdatePollingStationStatus("vp-projection-1", "Verified", map[string]int{"Alice": 120, "Bob": 80, "spoilt": 3}, 0.9))
+	require.NoError(t, storage.UpdatePollingStationStatus("vp-projection-2", "Verified", map[string]int{"Alice": 60, "Bob": 90, "spoilt": 2}, 0.9))
+
+	getProjection := func(t *testing.T, processID string) (int, services.TallyProjection) {
+		req, err := http.NewRequest("GET", "/api/v1/voting-process/"+processID+"/projection", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		var response struct {
+			Projection services.TallyProjection `json:"projection"`
+		}
+		if w.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		}
+		return w.Code, response.Projection
+	}
+
+	t.Run("HalfVerifiedScalesProportionally", func(t *testing.T) {
+		code, projection := getProjection(t, "vp-projection")
+		require.Equal(t, http.StatusOK, code)
+
+		assert.False(t, projection.Official)
+		assert.Equal(t, 4, projection.TotalStations)
+		assert.Equal(t, 2, projection.VerifiedStations)
+		assert.InDelta(t, 0.5, projection.ReportingFraction, 0.0001)
+		assert.Equal(t, map[string]int{"Alice": 180, "Bob": 170, "spoilt": 5}, projection.VerifiedTally)
+		assert.Equal(t, map[string]int{"Alice": 360, "Bob": 340, "spoilt": 10}, projection.ProjectedTally)
+		assert.Equal(t, "moderate", projection.Confidence)
+		assert.Contains(t, projection.Caveat, "not an official result")
+	})
+
+	t.Run("NothingVerified", func(t *testing.T) {
+		code, projection := getProjection(t, "vp-unverified")
+		require.Equal(t, http.StatusOK, code)
+
+		assert.Equal(t, "none", projection.Confidence)
+		assert.Equal(t, 0, projection.ProjectedTally["Alice"])
+	})
+
+	t.Run("ProcessNotFound", func(t *testing.T) {
+		code, _ := getProjection(t, "missing")
+		assert.Equal(t, http.StatusNotFound, code)
+	})
+}
+
+func TestTallyHandler_GetTally_Embargo(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	storage := services.NewStorageService()
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+	tallyService := services.NewTallyService(storage, logger)
+	tallyService.SetConsensusService(services.NewConsensusService(storage, logger))
+	tallyHandler := NewTallyHandler(tallyService, services.NewErrorHandler(logger), logger)
+
+	require.NoError(t, storage.StoreVotingProcess(models.VotingProcess{
+		ID:       "embargo-process",
+		Title:    "Test Election",
+		Position: "President",
+		Candidates: []models.Candidate{
+			{ID: "candidate-1", Name: "Alice Johnson"},
+			{ID: "candidate-2", Name: "Bob Smith"},
+		},
+		PollingStations:      []string{"station-1", "station-2"},
+		Status:               "Active",
+		EmbargoUntilComplete: true,
+		CreatedAt:            time.Now(),
+	}))
+	require.NoError(t, storage.UpdatePollingStationStatus("station-1", "Verified", map[string]int{
+		"Alice Johnson": 150,
+		"Bob Smith":     120,
+		"spoilt":        5,
+	}, 0.85))
+
+	router := gin.New()
+	router.GET("/api/v1/getTally/:votingProcessId", tallyHandler.GetTally)
+
+	getTally := func(path string) (services.TallyResponse, map[string]interface{}) {
+		req, err := http.NewRequest("GET", path, nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response services.TallyResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		var raw map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &raw))
+		return response, raw
+	}
+
+	t.Run("CountsHiddenWhileActive", func(t *testing.T) {
+		for _, path := range []string{"/api/v1/getTally/embargo-process", "/api/v1/getTally/embargo-process?include=provisional"} {
+			response, raw := getTally(path)
+
+			assert.True(t, response.Embargoed)
+			assert.Nil(t, raw["aggregatedTally"])
+			assert.Empty(t, response.Winners)
+			assert.Empty(t, response.ProvisionalTally)
+
+			require.Len(t, response.PollingStations, 2)
+			statuses := make(map[string]string)
+			for _, station := range response.PollingStations {
+				statuses[station.ID] = station.Status
+				assert.Nil(t, station.Results)
+				assert.Zero(t, station.Confidence)
+			}
+			assert.Equal(t, map[string]string{"station-1": "Verified", "station-2": "Pending"}, statuses)
+		}
+	})
+
+	t.Run("CountsRevealedAfterCompletion", func(t *testing.T) {
+		require.NoError(t, storage.UpdateVotingProcessStatus("embargo-process", "Complete"))
+
+		response, _ := getTally("/api/v1/getTally/embargo-process")
+
+		assert.False(t, response.Embargoed)
+		assert.Equal(t, 150, response.AggregatedTally["Alice Johnson"])
+		assert.Equal(t, 120, response.AggregatedTally["Bob Smith"])
+		assert.Equal(t, []string{"Alice Johnson"}, response.Winners)
+		for _, station := range response.PollingStations {
+			if station.ID == "station-1" {
+				assert.Equal(t, 150, station.Results["Alice Johnson"])
+			}
+		}
+	})
+}
+
+func TestTallyHandler_GetTally_ExcludeStations(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	storage := services.NewStorageService()
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+	tallyService := services.NewTallyService(storage, logger)
+	tallyHandler := NewTallyHandler(tallyService, services.NewErrorHandler(logger), logger)
+
+	require.NoError(t, storage.StoreVotingProcess(models.VotingProcess{
+		ID:       "exclude-process",
+		Title:    "Test Election",
+		Position: "President",
+		Candidates: []models.Candidate{
+			{ID: "candidate-1", Name: "Alice Johnson"},
+			{ID: "candidate-2", Name: "Bob Smith"},
+		},
+		PollingStations: []string{"station-1", "station-2", "station-3"},
+		Status:          "Active",
+		CreatedAt:       time.Now(),
+	}))
+	contested := map[string]int{"Alice Johnson": 300, "Bob Smith": 20, "spoilt": 4}
+	require.NoError(t, storage.UpdatePollingStationStatus("station-1", "Verified", contested, 0.9))
+	require.NoError(t, storage.UpdatePollingStationStatus("station-2", "Verified", map[string]int{"Alice Johnson": 100, "Bob Smith": 150, "spoilt": 5}, 0.85))
+
+	router := gin.New()
+	router.GET("/api/v1/getTally/:votingProcessId", tallyHandler.GetTally)
+
+	getTally := func(path string) services.TallyResponse {
+		req, err := http.NewRequest("GET", path, nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response services.TallyResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		return response
+	}
+
+	full := getTally("/api/v1/getTally/exclude-process")
+	assert.Empty(t, full.ExcludedStations)
+	assert.Equal(t, []string{"Alice Johnson"}, full.Winners)
+
+	// Pending and unknown stations contribute nothing and are not reported as excluded
+	excluded := getTally("/api/v1/getTally/exclude-process?exclude=station-1,%20station-3,unknown")
+	assert.Equal(t, []string{"station-1"}, excluded.ExcludedStations)
+	for candidate, votes := range full.AggregatedTally {
+		assert.Equal(t, contested[candidate], votes-excluded.AggregatedTally[candidate], "difference for %s", candidate)
+	}
+	assert.Equal(t, []string{"Bob Smith"}, excluded.Winners)
+	assert.Len(t, excluded.PollingStations, 3)
+}
+
+func TestTallyHandler_GetElectionPackage(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	storage := services.NewStorageService()
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+	tallyService := services.NewTallyService(storage, logger)
+	tallyHandler := NewTallyHandler(tallyService, services.NewErrorHandler(logger), logger)
+	tallyHandler.SetElectionPackageService(services.NewElectionPackageService(storage, tallyService, logger))
+
+	require.NoError(t, storage.StoreVotingProcess(models.VotingProcess{
+		ID:       "package-process",
+		Title:    "Archived Election",
+		Position: "Mayor",
+		Candidates: []models.Candidate{
+			{ID: "candidate-1", Name: "Alice Johnson"},
+			{ID: "candidate-2", Name: "Bob Smith"},
+		},
+		PollingStations: []string{"station-1"},
+		Status:          "Active",
+		CreatedAt:       time.Now().Add(-time.Hour),
+	}))
+	require.NoError(t, storage.UpdatePollingStationStatus("station-1", "Verified", map[string]int{"Alice Johnson": 100, "Bob Smith": 50}, 0.9))
+
+	router := gin.New()
+	router.GET("/api/v1/voting-process/:id/package", tallyHandler.GetElectionPackage)
+
+	getPackage := func(t *testing.T, processID string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest("GET", "/api/v1/voting-process/"+processID+"/package", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("ActiveProcess", func(t *testing.T) {
+		w := getPackage(t, "package-process")
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("CompletedProcess", func(t *testing.T) {
+		require.NoError(t, storage.UpdateVotingProcessStatus("package-process", "Complete"))
+
+		w := getPackage(t, "package-process")
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Header().Get("Content-Disposition"), `filename="election-package-package-process.json"`)
+
+		var pkg services.ElectionPackage
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &pkg))
+		assert.Equal(t, 100, pkg.AggregatedTally["Alice Johnson"])
+		require.NotEmpty(t, pkg.Hash)
+
+		// The downloaded file hashes to its embedded hash once the hash itself is blanked
+		blanked := strings.Replace(w.Body.String(), `"hash":"`+pkg.Hash+`"`, `"hash":""`, 1)
+		sum := sha256.Sum256([]byte(blanked))
+		assert.Equal(t, pkg.Hash, hex.EncodeToString(sum[:]))
+	})
+
+	t.Run("UnknownProcess", func(t *testing.T) {
+		w := getPackage(t, "missing")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestTallyHandler_GetConfidenceDistribution(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	storage := services.NewStorageService()
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+	tallyHandler := NewTallyHandler(services.NewTallyService(storage, logger), services.NewErrorHandler(logger), logger)
+
+	router := gin.New()
+	router.GET("/api/v1/voting-process/:id/confidence-distribution", tallyHandler.GetConfidenceDistribution)
+
+	stationIDs := []string{"conf-1", "conf-2", "conf-3", "conf-4", "conf-5", "conf-6", "conf-7"}
+	require.NoError(t, storage.StoreVotingProcess(models.VotingProcess{
+		ID:              "vp-confidence",
+		Title:           "Confidence Election",
+		Position:        "Mayor",
+		Candidates:      []models.Candidate{{ID: "c1", Name: "Alice"}, {ID: "c2", Name: "Bob"}},
+		PollingStations: stationIDs,
+		Status:          "Active",
+		CreatedAt:       time.Now(),
+	}))
+
+	// conf-7 stays pending and is not counted
+	confidences := map[string]float64{"conf-1": 0.55, "conf-2": 0.6, "conf-3": 0.65, "conf-4": 0.7, "conf-5": 0.93, "conf-6": 1.0}
+	for stationID, confidence := range confidences {
+		require.NoError(t, storage.UpdatePollingStationStatus(stationID, "Verified", map[string]int{"Alice": 10, "Bob": 5}, confidence))
+	}
+
+	getDistribution := func(t *testing.T, processID string) (int, services.ConfidenceDistribution) {
+		req, err := http.NewRequest("GET", "/api/v1/voting-process/"+processID+"/confidence-distribution", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		var response struct {
+			Success      bool                            `json:"success"`
+			Distribution services.ConfidenceDistribution `json:"distribution"`
+		}
+		if w.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.True(t, response.Success)
+		}
+		return w.Code, response.Distribution
+	}
+
+	t.Run("CountsVerifiedStationsPerBucket", func(t *testing.T) {
+		code, distribution := getDistribution(t, "vp-confidence")
+		require.Equal(t, http.StatusOK, code)
+
+		assert.Equal(t, 6, distribution.VerifiedStations)
+		require.Len(t, distribution.Buckets, 10)
+		assert.Equal(t, 0.0, distribution.Buckets[0].Min)
+		assert.Equal(t, 1.0, distribution.Buckets[9].Max)
+
+		counts := make([]int, len(distribution.Buckets))
+		for i, bucket := range distribution.Buckets {
+			counts[i] = bucket.Count
+		}
+		// 0.55 in 0.5-0.6; 0.6 and 0.65 in 0.6-0.7; 0.7 in 0.7-0.8; 0.93 and 1.0 in 0.9-1.0
+		assert.Equal(t, []int{0, 0, 0, 0, 0, 1, 2, 1, 0, 2}, counts)
+	})
+
+	t.Run("ProcessNotFound", func(t *testing.T) {
+		code, _ := getDistribution(t, "missing")
+		assert.Equal(t, http.StatusNotFound, code)
+	})
+}
@@ -1,9 +1,16 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -13,18 +20,77 @@ import (
 	"oyah-backend/internal/services"
 )
 
+var (
+	// errInvalidCharacters is returned when a text field contains control characters or invalid UTF-8
+	errInvalidCharacters = errors.New("contains invalid characters")
+
+	// errInsufficientCandidates is returned when a voting process has fewer candidates than the configured minimum
+	errInsufficientCandidates = errors.New("insufficient candidates")
+
+	// errInvalidCandidateID is returned when a candidate ID does not match the configured pattern
+	errInvalidCandidateID = errors.New("invalid candidate ID format")
+)
+
+// candidateColorPattern matches #RGB and #RRGGBB hex color codes
+var candidateColorPattern = regexp.MustCompile(`^#([0-9A-Fa-f]{3}|[0-9A-Fa-f]{6})$`)
+
+// defaultCandidateIDPattern allows letters, digits and hyphens, e.g. "c1" or "candidate-1"
+const defaultCandidateIDPattern = `^[A-Za-z0-9-]+$`
+
 // VotingProcessHandler handles voting process management HTTP requests
 type VotingProcessHandler struct {
-	storageService *services.StorageService
-	logger         *logrus.Logger
+	storageService     *services.StorageService
+	logger             *logrus.Logger
+	minCandidates      int            // Minimum number of candidates a voting process must have
+	candidateIDPattern *regexp.Regexp // Pattern every candidate ID must match; nil disables the check
+	auditService       *services.AuditService
+	consensusService   *services.ConsensusService // Optional; supplies the verification criteria shown to observers
 }
 
 // NewVotingProcessHandler creates a new voting process handler
 func NewVotingProcessHandler(storage *services.StorageService, logger *logrus.Logger) *VotingProcessHandler {
 	return &VotingProcessHandler{
-		storageService: storage,
-		logger:         logger,
+		storageService:     storage,
+		logger:             logger,
+		minCandidates:      2, // A meaningful election needs at least two choices
+		candidateIDPattern: regexp.MustCompile(defaultCandidateIDPattern),
+	}
+}
+
+// SetMinimumCandidates sets the minimum number of candidates, e.g. 1 for single-question referendums
+func (h *VotingProcessHandler) SetMinimumCandidates(minCandidates int) {
+	if minCandidates > 0 {
+		h.minCandidates = minCandidates
+		h.logger.WithField("min_candidates", minCandidates).Info("Minimum candidate count updated")
+	}
+}
+
+// SetAuditService sets the audit log that records voting process administration
+func (h *VotingProcessHandler) SetAuditService(auditService *services.AuditService) {
+	h.auditService = auditService
+}
+
+// SetConsensusService sets the consensus service whose verification criteria are published with each voting process
+func (h *VotingProcessHandler) SetConsensusService(consensusService *services.ConsensusService) {
+	h.consensusService = consensusService
+}
+
+// SetCandidateIDPattern sets the regular expression every candidate ID must match; an empty pattern disables the check
+func (h *VotingProcessHandler) SetCandidateIDPattern(pattern string) error {
+	if pattern == "" {
+		h.candidateIDPattern = nil
+		h.logger.Info("Candidate ID format validation disabled")
+		return nil
 	}
+
+	compiled, err := regexp.Compile(pattern)
+	if err != nil {
+		return fmt.Errorf("invalid candidate ID pattern: %w", err)
+	}
+
+	h.candidateIDPattern = compiled
+	h.logger.WithField("candidate_id_pattern", pattern).Info("Candidate ID pattern updated")
+	return nil
 }
 
 // CreateVotingProcess handles POST /api/v1/voting-process requests
@@ -44,9 +110,17 @@ func (h *VotingProcessHandler) CreateVotingProcess(c *gin.Context) {
 
 	var req models.VotingProcessRequest
 	
-	// Bind JSON payload
-	if err := c.ShouldBindJSON(&req); err != nil {
+	// Bind JSON payload, rejecting misspelled fields
+	if err := bindStrictJSON(c, &req); err != nil {
 		logger.WithError(err).Error("Failed to bind JSON payload")
+		if apiErr := unknownFieldAPIError(err); apiErr != nil {
+			c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   apiErr.Message,
+				Code:    string(apiErr.Type),
+				Details: apiErr.Details,
+			})
+			return
+		}
 		c.JSON(http.StatusBadRequest, models.ErrorResponse{
 			Error:   "Invalid JSON payload",
 			Code:    "INVALID_JSON",
@@ -66,23 +140,48 @@ func (h *VotingProcessHandler) CreateVotingProcess(c *gin.Context) {
 	// Validate request
 	if err := h.validateVotingProcessRequest(req); err != nil {
 		logger.WithError(err).Error("Voting process validation failed")
+		code := "VALIDATION_ERROR"
+		switch {
+		case errors.Is(err, errInvalidCharacters):
+			code = "INVALID_CHARACTERS"
+		case errors.Is(err, errInsufficientCandidates):
+			code = "INSUFFICIENT_CANDIDATES"
+		case errors.Is(err, errInvalidCandidateID):
+			code = "INVALID_CANDIDATE_ID"
+		}
 		c.JSON(http.StatusBadRequest, models.ErrorResponse{
 			Error:   "Validation failed",
-			Code:    "VALIDATION_ERROR",
+			Code:    code,
 			Details: err.Error(),
 		})
 		return
 	}
 
+	// Single-winner positions need not specify seats
+	seatsAvailable := req.SeatsAvailable
+	if seatsAvailable == 0 {
+		seatsAvailable = 1
+	}
+
 	// Create voting process model
 	votingProcess := models.VotingProcess{
-		ID:              uuid.New().String(),
-		Title:           req.Title,
-		Position:        req.Position,
-		Candidates:      req.Candidates,
-		PollingStations: req.PollingStations,
-		Status:          "Setup",
-		CreatedAt:       time.Now(),
+		ID:                   uuid.New().String(),
+		Title:                req.Title,
+		Position:             req.Position,
+		Candidates:           req.Candidates,
+		PollingStations:      req.PollingStations,
+		Status:               models.ProcessStatusSetup,
+		CreatedAt:            time.Now(),
+		ScheduledStart:       req.ScheduledStart,
+		WebhookURL:           req.WebhookURL,
+		SeatsAvailable:       seatsAvailable,
+		MinLocationClusters:  req.MinLocationClusters,
+		ExpectedBallots:      req.ExpectedBallots,
+		BallotTolerance:      req.BallotTolerance,
+		AutoCompleteFraction: req.AutoCompleteFraction,
+		EmbargoUntilComplete: req.EmbargoUntilComplete,
+		Referendum:           req.Referendum,
+		ValidationRules:      req.ValidationRules,
 	}
 
 	// Store voting process
@@ -98,6 +197,15 @@ func (h *VotingProcessHandler) CreateVotingProcess(c *gin.Context) {
 
 	logger.WithField("voting_process_id", votingProcess.ID).Info("Voting process created successfully")
 
+	if h.auditService != nil {
+		h.auditService.Record(requestActor(c), services.AuditActionCreateVotingProcess, "voting_process", votingProcess.ID, map[string]interface{}{
+			"title":            votingProcess.Title,
+			"position":         votingProcess.Position,
+			"candidates":       len(votingProcess.Candidates),
+			"polling_stations": len(votingProcess.PollingStations),
+		})
+	}
+
 	// Return success response
 	c.JSON(http.StatusCreated, gin.H{
 		"success": true,
@@ -149,7 +257,7 @@ func (h *VotingProcessHandler) StartVotingProcess(c *gin.Context) {
 	}
 
 	// Check if voting process is in Setup status
-	if votingProcess.Status != "Setup" {
+	if votingProcess.Status != models.ProcessStatusSetup {
 		logger.WithField("current_status", votingProcess.Status).Error("Invalid status for starting voting process")
 		c.JSON(http.StatusBadRequest, models.ErrorResponse{
 			Error:   "Cannot start voting process",
@@ -160,7 +268,7 @@ func (h *VotingProcessHandler) StartVotingProcess(c *gin.Context) {
 	}
 
 	// Update voting process status to Active
-	if err := h.storageService.UpdateVotingProcessStatus(processID, "Active"); err != nil {
+	if err := h.storageService.UpdateVotingProcessStatus(processID, models.ProcessStatusActive); err != nil {
 		logger.WithError(err).Error("Failed to update voting process status")
 		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error:   "Failed to start voting process",
@@ -172,6 +280,10 @@ func (h *VotingProcessHandler) StartVotingProcess(c *gin.Context) {
 
 	logger.Info("Voting process started successfully")
 
+	if h.auditService != nil {
+		h.auditService.Record(requestActor(c), services.AuditActionStartVotingProcess, "voting_process", processID, nil)
+	}
+
 	// Get updated voting process
 	updatedProcess, err := h.storageService.GetVotingProcess(processID)
 	if err != nil {
@@ -192,6 +304,136 @@ func (h *VotingProcessHandler) StartVotingProcess(c *gin.Context) {
 	})
 }
 
+// AddPollingStations handles POST /api/v1/voting-process/{id}/stations requests.
+// Stations can only be added while the voting process is in Setup.
+func (h *VotingProcessHandler) AddPollingStations(c *gin.Context) {
+	// Generate request ID for tracing
+	requestID := uuid.New().String()
+
+	// Get voting process ID from URL parameter
+	processID := c.Param("id")
+
+	// Create logger with request context
+	logger := h.logger.WithFields(logrus.Fields{
+		"request_id":        requestID,
+		"endpoint":          "addPollingStations",
+		"method":            c.Request.Method,
+		"client_ip":         c.ClientIP(),
+		"voting_process_id": processID,
+	})
+
+	logger.Info("Processing add polling stations request")
+
+	var req models.AddPollingStationsRequest
+
+	// Bind JSON payload, rejecting misspelled fields
+	if err := bindStrictJSON(c, &req); err != nil {
+		logger.WithError(err).Error("Failed to bind JSON payload")
+		if apiErr := unknownFieldAPIError(err); apiErr != nil {
+			c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   apiErr.Message,
+				Code:    string(apiErr.Type),
+				Details: apiErr.Details,
+			})
+			return
+		}
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "Invalid JSON payload",
+			Code:    "INVALID_JSON",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	logger = logger.WithField("polling_stations_count", len(req.PollingStations))
+
+	// Check if voting process exists
+	votingProcess, err := h.storageService.GetVotingProcess(processID)
+	if err != nil {
+		logger.WithError(err).Error("Voting process not found")
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "Voting process not found",
+			Code:    "PROCESS_NOT_FOUND",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	// Stations are fixed once witnesses may start submitting
+	if votingProcess.Status != models.ProcessStatusSetup {
+		logger.WithField("current_status", votingProcess.Status).Error("Invalid status for adding polling stations")
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "Cannot add polling stations",
+			Code:    "INVALID_STATUS",
+			Details: "Voting process must be in 'Setup' status to add polling stations",
+		})
+		return
+	}
+
+	validationErr := validatePollingStationIDs(req.PollingStations)
+	if validationErr == nil && len(votingProcess.PollingStations)+len(req.PollingStations) > 1000 {
+		validationErr = fmt.Errorf("maximum 1000 polling stations allowed")
+	}
+	if validationErr != nil {
+		logger.WithError(validationErr).Error("Polling station validation failed")
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "Validation failed",
+			Code:    "VALIDATION_ERROR",
+			Details: validationErr.Error(),
+		})
+		return
+	}
+
+	// Reject stations already registered to this or another voting process
+	for _, stationID := range req.PollingStations {
+		if station, err := h.storageService.GetPollingStation(stationID); err == nil && station.VotingProcessID != "" {
+			logger.WithField("polling_station_id", stationID).Error("Polling station already registered")
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "Duplicate polling station",
+				Code:    "DUPLICATE_STATION",
+				Details: fmt.Sprintf("polling station %s is already registered to voting process %s", stationID, station.VotingProcessID),
+			})
+			return
+		}
+	}
+
+	if err := h.storageService.AddPollingStationsToVotingProcess(processID, req.PollingStations); err != nil {
+		logger.WithError(err).Error("Failed to add polling stations")
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "Failed to add polling stations",
+			Code:    "UPDATE_ERROR",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	logger.Info("Polling stations added successfully")
+
+	if h.auditService != nil {
+		h.auditService.Record(requestActor(c), services.AuditActionAddPollingStations, "voting_process", processID, map[string]interface{}{
+			"polling_stations": req.PollingStations,
+		})
+	}
+
+	updatedProcess, err := h.storageService.GetVotingProcess(processID)
+	if err != nil {
+		logger.WithError(err).Error("Failed to retrieve updated voting process")
+		// Still return success since the update was successful
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"message": "Polling stations added successfully",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":        true,
+		"voting_process": updatedProcess,
+		"added_stations": req.PollingStations,
+		"message":        "Polling stations added successfully",
+	})
+}
+
 // GetVotingProcess handles GET /api/v1/voting-process/{id} requests
 func (h *VotingProcessHandler) GetVotingProcess(c *gin.Context) {
 	// Generate request ID for tracing
@@ -236,13 +478,337 @@ func (h *VotingProcessHandler) GetVotingProcess(c *gin.Context) {
 
 	logger.Info("Voting process retrieved successfully")
 
-	// Return voting process
-	c.JSON(http.StatusOK, gin.H{
+	response := gin.H{
 		"success": true,
 		"voting_process": votingProcess,
+	}
+	if h.consensusService != nil {
+		response["verification_criteria"] = h.consensusService.VerificationCriteria(votingProcess)
+	}
+
+	// Return voting process
+	c.JSON(http.StatusOK, response)
+}
+
+// GetCandidates handles GET /api/v1/voting-process/{id}/candidates requests.
+// It returns just what a client needs to build a ballot, without the full process payload.
+func (h *VotingProcessHandler) GetCandidates(c *gin.Context) {
+	// Generate request ID for tracing
+	requestID := uuid.New().String()
+
+	// Get voting process ID from URL parameter
+	processID := c.Param("id")
+
+	// Create logger with request context
+	logger := h.logger.WithFields(logrus.Fields{
+		"request_id":        requestID,
+		"endpoint":          "getCandidates",
+		"method":            c.Request.Method,
+		"client_ip":         c.ClientIP(),
+		"voting_process_id": processID,
+	})
+
+	logger.Info("Processing get candidates request")
+
+	votingProcess, err := h.storageService.GetVotingProcess(processID)
+	if err != nil {
+		logger.WithError(err).Error("Voting process not found")
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "Voting process not found",
+			Code:    "PROCESS_NOT_FOUND",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	logger.WithField("candidates_count", len(votingProcess.Candidates)).Info("Candidates retrieved successfully")
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":           true,
+		"voting_process_id": votingProcess.ID,
+		"candidates":        votingProcess.Candidates,
+		"spoilt_key":        models.SpoiltVotesKey,
 	})
 }
 
+// GetSubmissionCounts handles GET /api/v1/voting-process/{id}/submission-counts requests
+func (h *VotingProcessHandler) GetSubmissionCounts(c *gin.Context) {
+	// Generate request ID for tracing
+	requestID := uuid.New().String()
+
+	// Get voting process ID from URL parameter
+	processID := c.Param("id")
+
+	// Create logger with request context
+	logger := h.logger.WithFields(logrus.Fields{
+		"request_id":        requestID,
+		"endpoint":          "getSubmissionCounts",
+		"method":            c.Request.Method,
+		"client_ip":         c.ClientIP(),
+		"voting_process_id": processID,
+	})
+
+	logger.Info("Processing get submission counts request")
+
+	counts, err := h.storageService.GetSubmissionCountsByVotingProcess(processID)
+	if err != nil {
+		logger.WithError(err).Error("Voting process not found")
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "Voting process not found",
+			Code:    "PROCESS_NOT_FOUND",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	// Wallets witnessing several stations count once towards the process's witness turnout
+	distinctWallets, err := h.storageService.GetDistinctWalletCountByVotingProcess(processID)
+	if err != nil {
+		logger.WithError(err).Error("Voting process not found")
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "Voting process not found",
+			Code:    "PROCESS_NOT_FOUND",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	logger.WithFields(logrus.Fields{
+		"polling_stations_count": len(counts),
+		"distinct_wallets":       distinctWallets,
+	}).Info("Submission counts retrieved successfully")
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":           true,
+		"voting_process_id": processID,
+		"distinct_wallets":  distinctWallets,
+		"stations":          counts,
+	})
+}
+
+// GetStationsByConfidence handles GET /api/v1/voting-process/{id}/stations requests.
+// It lists the verified stations whose confidence lies within [minConfidence, maxConfidence], weakest first.
+func (h *VotingProcessHandler) GetStationsByConfidence(c *gin.Context) {
+	// Generate request ID for tracing
+	requestID := uuid.New().String()
+
+	// Get voting process ID from URL parameter
+	processID := c.Param("id")
+
+	// Create logger with request context
+	logger := h.logger.WithFields(logrus.Fields{
+		"request_id":        requestID,
+		"endpoint":          "getStationsByConfidence",
+		"method":            c.Request.Method,
+		"client_ip":         c.ClientIP(),
+		"voting_process_id": processID,
+	})
+
+	logger.Info("Processing get stations by confidence request")
+
+	minConfidence, minErr := parseConfidenceBound(c.Query("minConfidence"), 0)
+	maxConfidence, maxErr := parseConfidenceBound(c.Query("maxConfidence"), 1)
+	if minErr != nil || maxErr != nil || minConfidence > maxConfidence {
+		logger.WithFields(logrus.Fields{
+			"min_confidence": c.Query("minConfidence"),
+			"max_confidence": c.Query("maxConfidence"),
+		}).Warn("Invalid confidence range")
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "Invalid confidence range",
+			Code:    "INVALID_CONFIDENCE_RANGE",
+			Details: "minConfidence and maxConfidence must be numbers between 0 and 1, with minConfidence not above maxConfidence",
+		})
+		return
+	}
+
+	stations, err := h.storageService.GetPollingStationsByVotingProcess(processID)
+	if err != nil {
+		logger.WithError(err).Error("Voting process not found")
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "Voting process not found",
+			Code:    "PROCESS_NOT_FOUND",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	// Confidence levels stay visible under an embargo, but the counts do not
+	embargoed := false
+	if process, err := h.storageService.GetVotingProcess(processID); err == nil {
+		embargoed = process.ResultsEmbargoed()
+	}
+
+	matches := []models.StationConfidence{}
+	for _, station := range stations {
+		if station.Status != models.StationStatusVerified || station.ConfidenceLevel < minConfidence || station.ConfidenceLevel > maxConfidence {
+			continue
+		}
+		match := models.StationConfidence{
+			PollingStationID:  station.ID,
+			ConfidenceLevel:   station.ConfidenceLevel,
+			AverageConfidence: station.AverageConfidence,
+			VerifiedResults:   station.VerifiedResults,
+			ConsensusReached:  station.ConsensusReached,
+		}
+		if embargoed {
+			match.VerifiedResults = nil
+		}
+		matches = append(matches, match)
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].ConfidenceLevel != matches[j].ConfidenceLevel {
+			return matches[i].ConfidenceLevel < matches[j].ConfidenceLevel
+		}
+		return matches[i].PollingStationID < matches[j].PollingStationID
+	})
+
+	logger.WithField("matching_stations", len(matches)).Info("Stations by confidence retrieved successfully")
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":           true,
+		"voting_process_id": processID,
+		"min_confidence":    minConfidence,
+		"max_confidence":    maxConfidence,
+		"embargoed":         embargoed,
+		"stations":          matches,
+	})
+}
+
+// parseConfidenceBound parses a confidence query parameter, returning fallback when it is absent
+func parseConfidenceBound(value string, fallback float64) (float64, error) {
+	if value == "" {
+		return fallback, nil
+	}
+	bound, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, err
+	}
+	if !(bound >= 0 && bound <= 1) { // Also rejects NaN
+		return 0, fmt.Errorf("confidence bound %v is outside [0, 1]", bound)
+	}
+	return bound, nil
+}
+
+// GetVotingProcessTimeline handles GET /api/v1/voting-process/{id}/timeline requests
+func (h *VotingProcessHandler) GetVotingProcessTimeline(c *gin.Context) {
+	// Generate request ID for tracing
+	requestID := uuid.New().String()
+
+	// Get voting process ID from URL parameter
+	processID := c.Param("id")
+
+	// Create logger with request context
+	logger := h.logger.WithFields(logrus.Fields{
+		"request_id":        requestID,
+		"endpoint":          "getVotingProcessTimeline",
+		"method":            c.Request.Method,
+		"client_ip":         c.ClientIP(),
+		"voting_process_id": processID,
+	})
+
+	logger.Info("Processing get voting process timeline request")
+
+	// Get voting process
+	votingProcess, err := h.storageService.GetVotingProcess(processID)
+	if err != nil {
+		logger.WithError(err).Error("Voting process not found")
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "Voting process not found",
+			Code:    "PROCESS_NOT_FOUND",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	// Get polling stations for station verification events
+	stations, err := h.storageService.GetPollingStationsByVotingProcess(processID)
+	if err != nil {
+		logger.WithError(err).Error("Failed to get polling stations")
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "Failed to build timeline",
+			Code:    "STORAGE_ERROR",
+			Details: err.Error(),
+		})
+		return
+	}
+
+	timeline := h.buildTimeline(votingProcess, stations)
+
+	logger.WithField("event_count", len(timeline)).Info("Voting process timeline retrieved successfully")
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":           true,
+		"voting_process_id": processID,
+		"timeline":          timeline,
+	})
+}
+
+// buildTimeline assembles the chronological lifecycle events of a voting process from stored timestamps
+func (h *VotingProcessHandler) buildTimeline(votingProcess *models.VotingProcess, stations []*models.PollingStation) []models.TimelineEvent {
+	timeline := []models.TimelineEvent{
+		{
+			Type:        "created",
+			Timestamp:   votingProcess.CreatedAt,
+			Description: fmt.Sprintf("Voting process '%s' created", votingProcess.Title),
+		},
+	}
+
+	if votingProcess.StartedAt != nil {
+		timeline = append(timeline, models.TimelineEvent{
+			Type:        "started",
+			Timestamp:   *votingProcess.StartedAt,
+			Description: "Voting process started",
+		})
+	}
+
+	for _, station := range stations {
+		if station.Status == models.StationStatusVerified && station.ConsensusReached != nil {
+			timeline = append(timeline, models.TimelineEvent{
+				Type:             "station_verified",
+				Timestamp:        *station.ConsensusReached,
+				PollingStationID: station.ID,
+				Description:      fmt.Sprintf("Polling station %s verified with %.0f%% confidence", station.ID, station.ConfidenceLevel*100),
+			})
+		}
+	}
+
+	if votingProcess.CompletedAt != nil {
+		timeline = append(timeline, models.TimelineEvent{
+			Type:        "completed",
+			Timestamp:   *votingProcess.CompletedAt,
+			Description: "Voting process completed",
+		})
+	}
+
+	// Stable sort keeps lifecycle order for events sharing a timestamp
+	sort.SliceStable(timeline, func(i, j int) bool {
+		return timeline[i].Timestamp.Before(timeline[j].Timestamp)
+	})
+
+	return timeline
+}
+
+// validatePollingStationIDs checks the format of each polling station ID and rejects duplicates within the list
+func validatePollingStationIDs(pollingStations []string) error {
+	stationIDs := make(map[string]bool)
+	for i, stationID := range pollingStations {
+		if len(stationID) == 0 {
+			return fmt.Errorf("polling station %d: ID is required", i+1)
+		}
+		if len(stationID) > 50 {
+			return fmt.Errorf("polling station %d: ID must be less than 50 characters", i+1)
+		}
+		
+		// Check for duplicate station IDs
+		if stationIDs[stationID] {
+			return fmt.Errorf("duplicate polling station ID: %s", stationID)
+		}
+		stationIDs[stationID] = true
+	}
+	return nil
+}
+
 // validateVotingProcessRequest validates the voting process creation request
 func (h *VotingProcessHandler) validateVotingProcessRequest(req models.VotingProcessRequest) error {
 	// Title validation
@@ -252,6 +818,9 @@ func (h *VotingProcessHandler) validateVotingProcessRequest(req models.VotingPro
 	if len(req.Title) > 200 {
 		return fmt.Errorf("title must be less than 200 characters")
 	}
+	if err := validateTextCharacters(req.Title); err != nil {
+		return fmt.Errorf("title %w", err)
+	}
 
 	// Position validation
 	if len(req.Position) == 0 {
@@ -260,11 +829,17 @@ func (h *VotingProcessHandler) validateVotingProcessRequest(req models.VotingPro
 	if len(req.Position) > 100 {
 		return fmt.Errorf("position must be less than 100 characters")
 	}
+	if err := validateTextCharacters(req.Position); err != nil {
+		return fmt.Errorf("position %w", err)
+	}
 
 	// Candidates validation
 	if len(req.Candidates) == 0 {
 		return fmt.Errorf("at least one candidate is required")
 	}
+	if len(req.Candidates) < h.minCandidates {
+		return fmt.Errorf("%w: at least %d candidates are required, got %d", errInsufficientCandidates, h.minCandidates, len(req.Candidates))
+	}
 	if len(req.Candidates) > 50 {
 		return fmt.Errorf("maximum 50 candidates allowed")
 	}
@@ -276,12 +851,27 @@ func (h *VotingProcessHandler) validateVotingProcessRequest(req models.VotingPro
 		if len(candidate.ID) == 0 {
 			return fmt.Errorf("candidate %d: ID is required", i+1)
 		}
+		if h.candidateIDPattern != nil && !h.candidateIDPattern.MatchString(candidate.ID) {
+			return fmt.Errorf("candidate %d: %w: %q must match %s", i+1, errInvalidCandidateID, candidate.ID, h.candidateIDPattern.String())
+		}
 		if len(candidate.Name) == 0 {
 			return fmt.Errorf("candidate %d: name is required", i+1)
 		}
 		if len(candidate.Name) > 100 {
 			return fmt.Errorf("candidate %d: name must be less than 100 characters", i+1)
 		}
+		if err := validateTextCharacters(candidate.Name); err != nil {
+			return fmt.Errorf("candidate %d: name %w", i+1, err)
+		}
+		if len(candidate.Party) > 100 {
+			return fmt.Errorf("candidate %d: party must be less than 100 characters", i+1)
+		}
+		if err := validateTextCharacters(candidate.Party); err != nil {
+			return fmt.Errorf("candidate %d: party %w", i+1, err)
+		}
+		if candidate.Color != "" && !candidateColorPattern.MatchString(candidate.Color) {
+			return fmt.Errorf("candidate %d: color must be a hex code like #1E90FF", i+1)
+		}
 		
 		// Check for duplicate IDs
 		if candidateIDs[candidate.ID] {
@@ -296,6 +886,19 @@ func (h *VotingProcessHandler) validateVotingProcessRequest(req models.VotingPro
 		candidateNames[candidate.Name] = true
 	}
 
+	// Seats validation
+	if req.SeatsAvailable < 0 {
+		return fmt.Errorf("seats available cannot be negative")
+	}
+	if req.SeatsAvailable > len(req.Candidates) {
+		return fmt.Errorf("seats available (%d) cannot exceed the number of candidates (%d)", req.SeatsAvailable, len(req.Candidates))
+	}
+
+	// Geographic spread validation
+	if req.MinLocationClusters < 0 {
+		return fmt.Errorf("minimum location clusters cannot be negative")
+	}
+
 	// Polling stations validation
 	if len(req.PollingStations) == 0 {
 		return fmt.Errorf("at least one polling station is required")
@@ -304,22 +907,83 @@ func (h *VotingProcessHandler) validateVotingProcessRequest(req models.VotingPro
 		return fmt.Errorf("maximum 1000 polling stations allowed")
 	}
 
-	// Validate each polling station ID
-	stationIDs := make(map[string]bool)
-	for i, stationID := range req.PollingStations {
-		if len(stationID) == 0 {
-			return fmt.Errorf("polling station %d: ID is required", i+1)
+	if err := validatePollingStationIDs(req.PollingStations); err != nil {
+		return err
+	}
+	stationIDs := make(map[string]bool, len(req.PollingStations))
+	for _, stationID := range req.PollingStations {
+		stationIDs[stationID] = true
+	}
+
+	// Ballot totals cross-check validation
+	for stationID, expected := range req.ExpectedBallots {
+		if !stationIDs[stationID] {
+			return fmt.Errorf("expected ballots given for unknown polling station: %s", stationID)
 		}
-		if len(stationID) > 50 {
-			return fmt.Errorf("polling station %d: ID must be less than 50 characters", i+1)
+		if expected < 0 {
+			return fmt.Errorf("expected ballots for polling station %s cannot be negative", stationID)
 		}
-		
-		// Check for duplicate station IDs
-		if stationIDs[stationID] {
-			return fmt.Errorf("duplicate polling station ID: %s", stationID)
+	}
+	if req.BallotTolerance < 0 {
+		return fmt.Errorf("ballot tolerance cannot be negative")
+	}
+
+	// Auto-completion validation
+	if req.AutoCompleteFraction < 0 || req.AutoCompleteFraction > 1 {
+		return fmt.Errorf("auto-complete fraction must be between 0 and 1")
+	}
+
+	// Submission rules validation
+	if req.MaxVotes < 0 {
+		return fmt.Errorf("max votes cannot be negative")
+	}
+	for _, submissionType := range req.SubmissionTypes {
+		if submissionType != "image_ocr" && submissionType != "audio_stt" {
+			return fmt.Errorf("submission types must be 'image_ocr' or 'audio_stt', got %q", submissionType)
+		}
+	}
+
+	// Wallet allowlist validation
+	for _, wallet := range req.AllowedWallets {
+		if wallet == "" {
+			return fmt.Errorf("allowed wallets cannot contain an empty address")
+		}
+	}
+
+	// Write-in policy validation
+	switch req.WriteInPolicy {
+	case "", models.WriteInPolicyAllow, models.WriteInPolicyReject:
+	default:
+		return fmt.Errorf("write-in policy must be %q or %q", models.WriteInPolicyAllow, models.WriteInPolicyReject)
+	}
+
+	// Webhook URL validation
+	if req.WebhookURL != "" {
+		parsed, err := url.Parse(req.WebhookURL)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return fmt.Errorf("webhook URL must be an absolute http or https URL")
+		}
+	}
+
+	// Scheduled start validation
+	if req.ScheduledStart != nil && !req.ScheduledStart.After(time.Now()) {
+		return fmt.Errorf("scheduled start must be in the future")
+	}
+
+	return nil
+}
+
+// validateTextCharacters rejects text containing invalid UTF-8 or control characters other than whitespace
+func validateTextCharacters(text string) error {
+	if !utf8.ValidString(text) {
+		return errInvalidCharacters
+	}
+
+	for _, r := range text {
+		if unicode.IsControl(r) && !unicode.IsSpace(r) {
+			return errInvalidCharacters
 		}
-		stationIDs[stationID] = true
 	}
 
 	return nil
-}
\ No newline at end of file
+}
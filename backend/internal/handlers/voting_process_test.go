@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
@@ -34,7 +35,12 @@ func setupVotingProcessTestRouter() (*gin.Engine, *VotingProcessHandler, *servic
 	{
 		api.POST("/voting-process", handler.CreateVotingProcess)
 		api.PUT("/voting-process/:id/start", handler.StartVotingProcess)
+		api.POST("/voting-process/:id/stations", handler.AddPollingStations)
 		api.GET("/voting-process/:id", handler.GetVotingProcess)
+		api.GET("/voting-process/:id/candidates", handler.GetCandidates)
+		api.GET("/voting-process/:id/timeline", handler.GetVotingProcessTimeline)
+		api.GET("/voting-process/:id/submission-counts", handler.GetSubmissionCounts)
+		api.GET("/voting-process/:id/stations", handler.GetStationsByConfidence)
 	}
 
 	return router, handler, storage
@@ -117,6 +123,28 @@ func TestVotingProcessHandler_CreateVotingProcess(t *testing.T) {
 		assert.Equal(t, "INVALID_JSON", response.Code)
 	})
 
+	t.Run("MisspelledField", func(t *testing.T) {
+		body := `{
+			"title": "Test Election",
+			"position": "Mayor",
+			"candidates": [{"id": "c1", "name": "Alice"}, {"id": "c2", "name": "Bob"}],
+			"pollingStation": ["PS001"]
+		}`
+
+		req, err := http.NewRequest("POST", "/api/v1/voting-process", bytes.NewBufferString(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var response models.ErrorResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "UNKNOWN_FIELD", response.Code)
+		assert.Contains(t, response.Details, `"pollingStation"`)
+	})
+
 	t.Run("MissingTitle", func(t *testing.T) {
 		// Create request without title
 		request := models.VotingProcessRequest{
@@ -547,6 +575,7 @@ func TestVotingProcessHandler_ValidationEdgeCases(t *testing.T) {
 			Position: "Mayor",
 			Candidates: []models.Candidate{
 				{ID: "c1", Name: "Candidate 1"},
+				{ID: "c2", Name: "Candidate 2"},
 			},
 			PollingStations: []string{"PS001", "PS001"}, // Duplicate station
 		}
@@ -575,4 +604,872 @@ func TestVotingProcessHandler_ValidationEdgeCases(t *testing.T) {
 
 		assert.Contains(t, response.Details, "duplicate polling station ID")
 	})
-}
\ No newline at end of file
+}
+
+func TestVotingProcessHandler_InvalidCharacters(t *testing.T) {
+	router, _, _ := setupVotingProcessTestRouter()
+
+	t.Run("NullByteInTitle", func(t *testing.T) {
+		request := models.VotingProcessRequest{
+			Title:    "Test\x00Election",
+			Position: "Mayor",
+			Candidates: []models.Candidate{
+				{ID: "c1", Name: "Candidate 1"},
+			},
+			PollingStations: []string{"PS001"},
+		}
+
+		jsonData, err := json.Marshal(request)
+		require.NoError(t, err)
+
+		req, err := http.NewRequest("POST", "/api/v1/voting-process", bytes.NewBuffer(jsonData))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var response models.ErrorResponse
+		err = json.Unmarshal(w.Body.Bytes(), &response)
+		require.NoError(t, err)
+
+		assert.Equal(t, "INVALID_CHARACTERS", response.Code)
+		assert.Contains(t, response.Details, "title")
+	})
+
+	t.Run("ControlCharacterInCandidateName", func(t *testing.T) {
+		request := models.VotingProcessRequest{
+			Title:    "Test Election",
+			Position: "Mayor",
+			Candidates: []models.Candidate{
+				{ID: "c1", Name: "Candidate\x1b[31m 1"},
+				{ID: "c2", Name: "Candidate 2"},
+			},
+			PollingStations: []string{"PS001"},
+		}
+
+		jsonData, err := json.Marshal(request)
+		require.NoError(t, err)
+
+		req, err := http.NewRequest("POST", "/api/v1/voting-process", bytes.NewBuffer(jsonData))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var response models.ErrorResponse
+		err = json.Unmarshal(w.Body.Bytes(), &response)
+		require.NoError(t, err)
+
+		assert.Equal(t, "INVALID_CHARACTERS", response.Code)
+		assert.Contains(t, response.Details, "candidate 1: name")
+	})
+
+	t.Run("UnicodeNamesAccepted", func(t *testing.T) {
+		request := models.VotingProcessRequest{
+			Title:    "Uchaguzi Mkuu – Élection Générale",
+			Position: "Gouverneur",
+			Candidates: []models.Candidate{
+				{ID: "c1", Name: "Wanjiků Njoroge"},
+				{ID: "c2", Name: "José Álvarez"},
+				{ID: "c3", Name: "李小龍"},
+			},
+			PollingStations: []string{"PS001"},
+		}
+
+		jsonData, err := json.Marshal(request)
+		require.NoError(t, err)
+
+		req, err := http.NewRequest("POST", "/api/v1/voting-process", bytes.NewBuffer(jsonData))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+}
+
+func TestVotingProcessHandler_GetVotingProcessTimeline(t *testing.T) {
+	router, _, storage := setupVotingProcessTestRouter()
+
+	votingProcess := models.VotingProcess{
+		ID:       "vp-timeline",
+		Title:    "Timeline Election",
+		Position: "Mayor",
+		Candidates: []models.Candidate{
+			{ID: "c1", Name: "Alice"},
+			{ID: "c2", Name: "Bob"},
+		},
+		PollingStations: []string{"TL001", "TL002"},
+		Status:          "Setup",
+		CreatedAt:       time.Now().Add(-time.Minute),
+	}
+	require.NoError(t, storage.StoreVotingProcess(votingProcess))
+	require.NoError(t, storage.UpdateVotingProcessStatus("vp-timeline", "Active"))
+
+	getTimeline := func(t *testing.T, processID string) (int, []models.TimelineEvent) {
+		req, err := http.NewRequest("GET", "/api/v1/voting-process/"+processID+"/timeline", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		var response struct {
+			Timeline []models.TimelineEvent `json:"timeline"`
+		}
+		if w.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		}
+		return w.Code, response.Timeline
+	}
+
+	t.Run("CreatedAndStarted", func(t *testing.T) {
+		code, timeline := getTimeline(t, "vp-timeline")
+		assert.Equal(t, http.StatusOK, code)
+		require.Len(t, timeline, 2)
+		assert.Equal(t, "created", timeline[0].Type)
+		assert.Equal(t, "started", timeline[1].Type)
+	})
+
+	t.Run("StationVerifiedAfterConsensus", func(t *testing.T) {
+		require.NoError(t, storage.UpdatePollingStationStatus("TL001", "Verified", map[string]int{"Alice": 10, "Bob": 5}, 0.9))
+		require.NoError(t, storage.UpdateVotingProcessStatus("vp-timeline", "Complete"))
+
+		code, timeline := getTimeline(t, "vp-timeline")
+		assert.Equal(t, http.StatusOK, code)
+		require.Len(t, timeline, 4)
+		assert.Equal(t, "created", timeline[0].Type)
+		assert.Equal(t, "started", timeline[1].Type)
+		assert.Equal(t, "station_verified", timeline[2].Type)
+		assert.Equal(t, "TL001", timeline[2].PollingStationID)
+		assert.Equal(t, "completed", timeline[3].Type)
+
+		for i := 1; i < len(timeline); i++ {
+			assert.False(t, timeline[i].Timestamp.Before(timeline[i-1].Timestamp))
+		}
+	})
+
+	t.Run("ProcessNotFound", func(t *testing.T) {
+		code, _ := getTimeline(t, "missing")
+		assert.Equal(t, http.StatusNotFound, code)
+	})
+}
+
+func TestVotingProcessHandler_MinimumCandidates(t *testing.T) {
+	createProcess := func(t *testing.T, router *gin.Engine, candidates []models.Candidate) (int, models.ErrorResponse) {
+		request := models.VotingProcessRequest{
+			Title:           "Referendum",
+			Position:        "Constitutional Amendment",
+			Candidates:      candidates,
+			PollingStations: []string{"PS001"},
+		}
+
+		jsonData, err := json.Marshal(request)
+		require.NoError(t, err)
+
+		req, err := http.NewRequest("POST", "/api/v1/voting-process", bytes.NewBuffer(jsonData))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		var response models.ErrorResponse
+		if w.Code != http.StatusCreated {
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		}
+		return w.Code, response
+	}
+
+	oneCandidate := []models.Candidate{{ID: "yes", Name: "Yes"}}
+	twoCandidates := []models.Candidate{{ID: "yes", Name: "Yes"}, {ID: "no", Name: "No"}}
+
+	t.Run("SingleCandidateRejectedByDefault", func(t *testing.T) {
+		router, _, _ := setupVotingProcessTestRouter()
+
+		code, response := createProcess(t, router, oneCandidate)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, "INSUFFICIENT_CANDIDATES", response.Code)
+		assert.Contains(t, response.Details, "at least 2 candidates")
+	})
+
+	t.Run("TwoCandidatesAccepted", func(t *testing.T) {
+		router, _, _ := setupVotingProcessTestRouter()
+
+		code, _ := createProcess(t, router, twoCandidates)
+		assert.Equal(t, http.StatusCreated, code)
+	})
+
+	t.Run("LoweredMinimumAcceptsSingleCandidate", func(t *testing.T) {
+		router, handler, _ := setupVotingProcessTestRouter()
+		handler.SetMinimumCandidates(1)
+
+		code, _ := createProcess(t, router, oneCandidate)
+		assert.Equal(t, http.StatusCreated, code)
+	})
+}
+
+func TestVotingProcessHandler_ScheduledStart(t *testing.T) {
+	router, _, _ := setupVotingProcessTestRouter()
+
+	createProcess := func(t *testing.T, scheduledStart time.Time) *httptest.ResponseRecorder {
+		request := models.VotingProcessRequest{
+			Title:    "Scheduled Election",
+			Position: "Mayor",
+			Candidates: []models.Candidate{
+				{ID: "c1", Name: "Alice"},
+				{ID: "c2", Name: "Bob"},
+			},
+			PollingStations: []string{"PS001"},
+			ScheduledStart:  &scheduledStart,
+		}
+
+		jsonData, err := json.Marshal(request)
+		require.NoError(t, err)
+
+		req, err := http.NewRequest("POST", "/api/v1/voting-process", bytes.NewBuffer(jsonData))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("FutureStartStoredInSetup", func(t *testing.T) {
+		w := createProcess(t, time.Now().Add(time.Hour))
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var response struct {
+			VotingProcess models.VotingProcess `json:"voting_process"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "Setup", response.VotingProcess.Status)
+		assert.NotNil(t, response.VotingProcess.ScheduledStart)
+	})
+
+	t.Run("PastStartRejected", func(t *testing.T) {
+		w := createProcess(t, time.Now().Add(-time.Hour))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var response models.ErrorResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "VALIDATION_ERROR", response.Code)
+	})
+}
+
+func TestVotingProcessHandler_CandidateIDPattern(t *testing.T) {
+	createProcess := func(t *testing.T, router *gin.Engine, candidates []models.Candidate) (int, models.ErrorResponse) {
+		request := models.VotingProcessRequest{
+			Title:           "Candidate ID Election",
+			Position:        "Mayor",
+			Candidates:      candidates,
+			PollingStations: []string{"PS001"},
+		}
+
+		jsonData, err := json.Marshal(request)
+		require.NoError(t, err)
+
+		req, err := http.NewRequest("POST", "/api/v1/voting-process", bytes.NewBuffer(jsonData))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		var response models.ErrorResponse
+		if w.Code != http.StatusCreated {
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		}
+		return w.Code, response
+	}
+
+	t.Run("ConsistentIDsAccepted", func(t *testing.T) {
+		router, _, _ := setupVotingProcessTestRouter()
+
+		code, _ := createProcess(t, router, []models.Candidate{
+			{ID: "candidate-1", Name: "Alice"},
+			{ID: "candidate-2", Name: "Bob"},
+		})
+		assert.Equal(t, http.StatusCreated, code)
+	})
+
+	t.Run("DisallowedCharactersRejected", func(t *testing.T) {
+		router, _, _ := setupVotingProcessTestRouter()
+
+		code, response := createProcess(t, router, []models.Candidate{
+			{ID: "candidate-1", Name: "Alice"},
+			{ID: "candidate 2!", Name: "Bob"},
+		})
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, "INVALID_CANDIDATE_ID", response.Code)
+		assert.Contains(t, response.Details, "candidate 2")
+	})
+
+	t.Run("CustomPattern", func(t *testing.T) {
+		router, handler, _ := setupVotingProcessTestRouter()
+		require.NoError(t, handler.SetCandidateIDPattern(`^c[0-9]+$`))
+
+		code, response := createProcess(t, router, []models.Candidate{
+			{ID: "c1", Name: "Alice"},
+			{ID: "candidate-2", Name: "Bob"},
+		})
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, "INVALID_CANDIDATE_ID", response.Code)
+
+		code, _ = createProcess(t, router, []models.Candidate{
+			{ID: "c1", Name: "Alice"},
+			{ID: "c2", Name: "Bob"},
+		})
+		assert.Equal(t, http.StatusCreated, code)
+	})
+
+	t.Run("DisabledPatternAcceptsAnyID", func(t *testing.T) {
+		router, handler, _ := setupVotingProcessTestRouter()
+		require.NoError(t, handler.SetCandidateIDPattern(""))
+
+		code, _ := createProcess(t, router, []models.Candidate{
+			{ID: "candidate_1", Name: "Alice"},
+			{ID: "2", Name: "Bob"},
+		})
+		assert.Equal(t, http.StatusCreated, code)
+	})
+
+	t.Run("InvalidPatternRejected", func(t *testing.T) {
+		_, handler, _ := setupVotingProcessTestRouter()
+		assert.Error(t, handler.SetCandidateIDPattern(`[`))
+	})
+}
+
+func TestVotingProcessHandler_CandidatePartyMetadata(t *testing.T) {
+	router, _, storage := setupVotingProcessTestRouter()
+
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+	tallyHandler := NewTallyHandler(services.NewTallyService(storage, logger), services.NewErrorHandler(logger), logger)
+	router.GET("/api/v1/getTally/:votingProcessId", tallyHandler.GetTally)
+
+	createProcess := func(t *testing.T, candidates []models.Candidate) *httptest.ResponseRecorder {
+		jsonData, err := json.Marshal(models.VotingProcessRequest{
+			Title:           "Party Election",
+			Position:        "Governor",
+			Candidates:      candidates,
+			PollingStations: []string{"PARTY001"},
+		})
+		require.NoError(t, err)
+
+		req, err := http.NewRequest("POST", "/api/v1/voting-process", bytes.NewBuffer(jsonData))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("RoundTripsThroughTally", func(t *testing.T) {
+		candidates := []models.Candidate{
+			{ID: "c1", Name: "Alice", Party: "Green Alliance", Color: "#2E8B57"},
+			{ID: "c2", Name: "Bob", Party: "Blue Front", Color: "#1e90ff"},
+			{ID: "c3", Name: "Carol"}, // Independent, no metadata
+		}
+
+		w := createProcess(t, candidates)
+		require.Equal(t, http.StatusCreated, w.Code)
+
+		var created struct {
+			VotingProcess models.VotingProcess `json:"voting_process"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &created))
+		assert.Equal(t, candidates, created.VotingProcess.Candidates)
+
+		req, err := http.NewRequest("GET", "/api/v1/getTally/"+created.VotingProcess.ID, nil)
+		require.NoError(t, err)
+		w = httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var tally services.TallyResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &tally))
+		assert.Equal(t, candidates, tally.VotingProcess.Candidates)
+
+		// Candidates without metadata omit the optional fields
+		assert.NotContains(t, w.Body.String(), `"party":""`)
+		assert.NotContains(t, w.Body.String(), `"color":""`)
+	})
+
+	t.Run("InvalidColorRejected", func(t *testing.T) {
+		w := createProcess(t, []models.Candidate{
+			{ID: "c1", Name: "Alice", Color: "green"},
+			{ID: "c2", Name: "Bob"},
+		})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestVotingProcessHandler_SeatsAvailable(t *testing.T) {
+	router, _, _ := setupVotingProcessTestRouter()
+
+	createProcess := func(t *testing.T, seats int) *httptest.ResponseRecorder {
+		jsonData, err := json.Marshal(models.VotingProcessRequest{
+			Title:    "Council Election",
+			Position: "Ward Council",
+			Candidates: []models.Candidate{
+				{ID: "c1", Name: "Alice"},
+				{ID: "c2", Name: "Bob"},
+				{ID: "c3", Name: "Carol"},
+			},
+			PollingStations: []string{"SEAT001"},
+			SeatsAvailable:  seats,
+		})
+		require.NoError(t, err)
+
+		req, err := http.NewRequest("POST", "/api/v1/voting-process", bytes.NewBuffer(jsonData))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	seatsOf := func(t *testing.T, w *httptest.ResponseRecorder) int {
+		var response struct {
+			VotingProcess models.VotingProcess `json:"voting_process"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		return response.VotingProcess.SeatsAvailable
+	}
+
+	t.Run("DefaultsToSingleSeat", func(t *testing.T) {
+		w := createProcess(t, 0)
+		require.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, 1, seatsOf(t, w))
+	})
+
+	t.Run("MultiSeat", func(t *testing.T) {
+		w := createProcess(t, 2)
+		require.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, 2, seatsOf(t, w))
+	})
+
+	t.Run("MoreSeatsThanCandidates", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, createProcess(t, 4).Code)
+	})
+
+	t.Run("NegativeSeats", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, createProcess(t, -1).Code)
+	})
+}
+
+func TestVotingProcessHandler_AddPollingStations(t *testing.T) {
+	router, _, storage := setupVotingProcessTestRouter()
+
+	for _, process := range []models.VotingProcess{
+		{ID: "setup-process", PollingStations: []string{"PS001"}, Status: "Setup"},
+		{ID: "active-process", PollingStations: []string{"PS100"}, Status: "Active"},
+	} {
+		process.Title = "Test Election"
+		process.Position = "Mayor"
+		process.Candidates = []models.Candidate{{ID: "c1", Name: "Candidate 1"}, {ID: "c2", Name: "Candidate 2"}}
+		require.NoError(t, storage.StoreVotingProcess(process))
+	}
+
+	addStations := func(processID string, stations ...string) *httptest.ResponseRecorder {
+		reqBody, err := json.Marshal(models.AddPollingStationsRequest{PollingStations: stations})
+		require.NoError(t, err)
+
+		req, err := http.NewRequest("POST", "/api/v1/voting-process/"+processID+"/stations", bytes.NewBuffer(reqBody))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("AddToSetupProcess", func(t *testing.T) {
+		w := addStations("setup-process", "PS002", "PS003")
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response struct {
+			Success       bool                 `json:"success"`
+			VotingProcess models.VotingProcess `json:"voting_process"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.True(t, response.Success)
+		assert.Equal(t, []string{"PS001", "PS002", "PS003"}, response.VotingProcess.PollingStations)
+
+		station, err := storage.GetPollingStation("PS003")
+		require.NoError(t, err)
+		assert.Equal(t, "setup-process", station.VotingProcessID)
+		assert.Equal(t, "Pending", station.Status)
+	})
+
+	t.Run("RejectStationAlreadyInProcess", func(t *testing.T) {
+		w := addStations("setup-process", "PS004", "PS001")
+		assert.Equal(t, http.StatusConflict, w.Code)
+
+		var response models.ErrorResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "DUPLICATE_STATION", response.Code)
+
+		// Nothing is added when any station is rejected
+		_, err := storage.GetPollingStation("PS004")
+		assert.Error(t, err)
+	})
+
+	t.Run("RejectStationInAnotherProcess", func(t *testing.T) {
+		w := addStations("setup-process", "PS100")
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("RejectDuplicatesInRequest", func(t *testing.T) {
+		w := addStations("setup-process", "PS005", "PS005")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var response models.ErrorResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "VALIDATION_ERROR", response.Code)
+	})
+
+	t.Run("RejectActiveProcess", func(t *testing.T) {
+		w := addStations("active-process", "PS101")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var response models.ErrorResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "INVALID_STATUS", response.Code)
+
+		process, err := storage.GetVotingProcess("active-process")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"PS100"}, process.PollingStations)
+	})
+
+	t.Run("ProcessNotFound", func(t *testing.T) {
+		w := addStations("non-existent", "PS200")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestVotingProcessHandler_GetCandidates(t *testing.T) {
+	router, _, storage := setupVotingProcessTestRouter()
+
+	candidates := []models.Candidate{
+		{ID: "c1", Name: "Candidate 1", Party: "Green Party", Color: "#00AA00"},
+		{ID: "c2", Name: "Candidate 2"},
+	}
+	require.NoError(t, storage.StoreVotingProcess(models.VotingProcess{
+		ID:              "candidates-process",
+		Title:           "Test Election",
+		Position:        "Mayor",
+		Candidates:      candidates,
+		PollingStations: []string{"PS001"},
+		Status:          "Setup",
+	}))
+
+	t.Run("ExistingProcess", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/api/v1/voting-process/candidates-process/candidates", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response struct {
+			Success         bool               `json:"success"`
+			VotingProcessID string             `json:"voting_process_id"`
+			Candidates      []models.Candidate `json:"candidates"`
+			SpoiltKey       string             `json:"spoilt_key"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.True(t, response.Success)
+		assert.Equal(t, "candidates-process", response.VotingProcessID)
+		assert.Equal(t, candidates, response.Candidates)
+		assert.Equal(t, "spoilt", response.SpoiltKey)
+
+		// Only the candidate list is returned, not the full process
+		var raw map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &raw))
+		assert.NotContains(t, raw, "voting_process")
+		assert.NotContains(t, raw, "pollingStations")
+	})
+
+	t.Run("UnknownProcess", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/api/v1/voting-process/non-existent/candidates", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		var response models.ErrorResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "PROCESS_NOT_FOUND", response.Code)
+	})
+}
+
+func TestVotingProcessHandler_ExpectedBallots(t *testing.T) {
+	router, _, _ := setupVotingProcessTestRouter()
+
+	tests := []struct {
+		name            string
+		expectedBallots map[string]int
+		ballotTolerance int
+		expectedStatus  int
+	}{
+		{"Valid", map[string]int{"PS001": 300}, 5, http.StatusCreated},
+		{"UnknownStation", map[string]int{"PS999": 300}, 0, http.StatusBadRequest},
+		{"NegativeExpected", map[string]int{"PS001": -1}, 0, http.StatusBadRequest},
+		{"NegativeTolerance", map[string]int{"PS001": 300}, -1, http.StatusBadRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			reqBody, err := json.Marshal(models.VotingProcessRequest{
+				Title:           "Test Election",
+				Position:        "Mayor",
+				Candidates:      []models.Candidate{{ID: "c1", Name: "Candidate 1"}, {ID: "c2", Name: "Candidate 2"}},
+				PollingStations: []string{"PS001", "PS002"},
+				ExpectedBallots: test.expectedBallots,
+				BallotTolerance: test.ballotTolerance,
+			})
+			require.NoError(t, err)
+
+			req, err := http.NewRequest("POST", "/api/v1/voting-process", bytes.NewBuffer(reqBody))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			assert.Equal(t, test.expectedStatus, w.Code)
+
+			if test.expectedStatus == http.StatusCreated {
+				var response struct {
+					VotingProcess models.VotingProcess `json:"voting_process"`
+				}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, test.expectedBallots, response.VotingProcess.ExpectedBallots)
+				assert.Equal(t, test.ballotTolerance, response.VotingProcess.BallotTolerance)
+			}
+		})
+	}
+}
+
+func TestVotingProcessHandler_GetVotingProcess_VerificationCriteria(t *testing.T) {
+	router, handler, storage := setupVotingProcessTestRouter()
+
+	require.NoError(t, storage.StoreVotingProcess(models.VotingProcess{
+		ID:                  "criteria-process",
+		Title:               "Test Election",
+		Position:            "Mayor",
+		Candidates:          []models.Candidate{{ID: "c1", Name: "Candidate 1"}, {ID: "c2", Name: "Candidate 2"}},
+		PollingStations:     []string{"PS001"},
+		Status:              "Setup",
+		MinLocationClusters: 3,
+	}))
+
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+	consensusService := services.NewConsensusService(storage, logger)
+	consensusService.SetConsensusThreshold(7)
+	handler.SetConsensusService(consensusService)
+
+	req, err := http.NewRequest("GET", "/api/v1/voting-process/criteria-process", nil)
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var response struct {
+		VerificationCriteria services.VerificationCriteria `json:"verification_criteria"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, 7, response.VerificationCriteria.ConsensusThreshold)
+	assert.Equal(t, 7, response.VerificationCriteria.MinAgreeingWallets)
+	assert.Equal(t, 50.0, response.VerificationCriteria.MajorityPercentage)
+	assert.Equal(t, 3, response.VerificationCriteria.MinLocationClusters)
+}
+
+func TestVotingProcessHandler_GetSubmissionCounts(t *testing.T) {
+	router, _, storage := setupVotingProcessTestRouter()
+
+	require.NoError(t, storage.StoreVotingProcess(models.VotingProcess{
+		ID:              "counts-process",
+		Title:           "Test Election",
+		Position:        "Mayor",
+		Candidates:      []models.Candidate{{ID: "c1", Name: "Candidate 1"}, {ID: "c2", Name: "Candidate 2"}},
+		PollingStations: []string{"PS001", "PS002", "PS003"},
+		Status:          "Active",
+	}))
+
+	store := func(id, wallet, station string, votes int) {
+		require.NoError(t, storage.StoreSubmission(models.Submission{
+			ID:               id,
+			WalletAddress:    wallet,
+			PollingStationID: station,
+			Timestamp:        time.Now(),
+			Results:          map[string]int{"Candidate 1": votes},
+			SubmissionType:   "image_ocr",
+		}))
+	}
+	store("sub-1", "wallet-a", "PS001", 10)
+	store("sub-2", "wallet-b", "PS001", 10)
+	store("sub-3", "wallet-a", "PS001", 12) // Resubmission replaces wallet-a's earlier submission
+	store("sub-4", "wallet-a", "PS002", 10)
+
+	t.Run("CountsPerStation", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/api/v1/voting-process/counts-process/submission-counts", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response struct {
+			Success         bool                            `json:"success"`
+			DistinctWallets int                             `json:"distinct_wallets"`
+			Stations        []models.StationSubmissionCount `json:"stations"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.True(t, response.Success)
+		assert.Equal(t, 2, response.DistinctWallets) // wallet-a witnessed two stations but counts once
+		assert.Equal(t, []models.StationSubmissionCount{
+			{PollingStationID: "PS001", TotalSubmissions: 2, UniqueWallets: 2},
+			{PollingStationID: "PS002", TotalSubmissions: 1, UniqueWallets: 1},
+			{PollingStationID: "PS003", TotalSubmissions: 0, UniqueWallets: 0},
+		}, response.Stations)
+	})
+
+	t.Run("UnknownProcess", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/api/v1/voting-process/non-existent/submission-counts", nil)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestVotingProcessHandler_GetStationsByConfidence(t *testing.T) {
+	router, _, storage := setupVotingProcessTestRouter()
+
+	require.NoError(t, storage.StoreVotingProcess(models.VotingProcess{
+		ID:              "confidence-process",
+		Title:           "Test Election",
+		Position:        "Mayor",
+		Candidates:      []models.Candidate{{ID: "c1", Name: "Candidate 1"}, {ID: "c2", Name: "Candidate 2"}},
+		PollingStations: []string{"PS001", "PS002", "PS003", "PS004", "PS005"},
+		Status:          "Active",
+	}))
+
+	results := map[string]int{"Candidate 1": 10, "Candidate 2": 5}
+	require.NoError(t, storage.UpdatePollingStationStatus("PS001", "Verified", results, 0.95))
+	require.NoError(t, storage.UpdatePollingStationStatus("PS002", "Verified", results, 0.6))
+	require.NoError(t, storage.UpdatePollingStationStatus("PS003", "Verified", results, 0.85))
+	require.NoError(t, storage.UpdatePollingStationStatus("PS004", "Verified", results, 0.8))
+	require.NoError(t, storage.UpdatePollingStationStatus("PS005", "Pending", nil, 0.9))
+
+	get := func(query string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest("GET", "/api/v1/voting-process/confidence-process/stations"+query, nil)
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	stationIDs := func(w *httptest.ResponseRecorder) []string {
+		var response struct {
+			Success  bool                       `json:"success"`
+			Stations []models.StationConfidence `json:"stations"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.True(t, response.Success)
+		ids := make([]string, len(response.Stations))
+		for i, station := range response.Stations {
+			ids[i] = station.PollingStationID
+		}
+		return ids
+	}
+
+	t.Run("FiltersToConfidenceBandAscending", func(t *testing.T) {
+		w := get("?minConfidence=0.8&maxConfidence=1.0")
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, []string{"PS004", "PS003", "PS001"}, stationIDs(w))
+	})
+
+	t.Run("LowConfidenceBand", func(t *testing.T) {
+		w := get("?maxConfidence=0.8")
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, []string{"PS002", "PS004"}, stationIDs(w))
+	})
+
+	t.Run("DefaultsToAllVerifiedStations", func(t *testing.T) {
+		w := get("")
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, []string{"PS002", "PS004", "PS003", "PS001"}, stationIDs(w))
+	})
+
+	t.Run("InvalidRange", func(t *testing.T) {
+		for _, query := range []string{"?minConfidence=0.9&maxConfidence=0.5", "?minConfidence=abc", "?maxConfidence=1.5", "?minConfidence=NaN"} {
+			w := get(query)
+			assert.Equal(t, http.StatusBadRequest, w.Code, query)
+		}
+	})
+
+	t.Run("EmbargoWithholdsResults", func(t *testing.T) {
+		require.NoError(t, storage.StoreVotingProcess(models.VotingProcess{
+			ID:                   "embargo-confidence-process",
+			Title:                "Embargoed Election",
+			Position:             "Mayor",
+			Candidates:           []models.Candidate{{ID: "c1", Name: "Candidate 1"}, {ID: "c2", Name: "Candidate 2"}},
+			PollingStations:      []string{"PS101"},
+			Status:               "Active",
+			EmbargoUntilComplete: true,
+		}))
+		require.NoError(t, storage.UpdatePollingStationStatus("PS101", "Verified", results, 0.9))
+
+		getEmbargoed := func() (bool, []models.StationConfidence) {
+			req, err := http.NewRequest("GET", "/api/v1/voting-process/embargo-confidence-process/stations", nil)
+			require.NoError(t, err)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			require.Equal(t, http.StatusOK, w.Code)
+
+			var response struct {
+				Embargoed bool                       `json:"embargoed"`
+				Stations  []models.StationConfidence `json:"stations"`
+			}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			return response.Embargoed, response.Stations
+		}
+
+		embargoed, stations := getEmbargoed()
+		assert.True(t, embargoed)
+		require.Len(t, stations, 1)
+		assert.Equal(t, 0.9, stations[0].ConfidenceLevel)
+		assert.Nil(t, stations[0].VerifiedResults)
+
+		require.NoError(t, storage.UpdateVotingProcessStatus("embargo-confidence-process", "Complete"))
+		embargoed, stations = getEmbargoed()
+		assert.False(t, embargoed)
+		require.Len(t, stations, 1)
+		assert.Equal(t, results, stations[0].VerifiedResults)
+	})
+
+	t.Run("ProcessNotFound", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/api/v1/voting-process/non-existent/stations", nil)
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -1,14 +1,21 @@
 package handlers
 
 import (
+	"encoding/json"
+	"io"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
 
+	"oyah-backend/internal/models"
 	"oyah-backend/internal/services"
 )
 
+// eventStreamKeepAlive is how often an idle event stream sends a comment, so proxies do not close the connection
+const eventStreamKeepAlive = 15 * time.Second
+
 // WebSocketHandler handles WebSocket connections for real-time updates
 type WebSocketHandler struct {
 	webSocketService *services.WebSocketService
@@ -47,6 +54,60 @@ func (h *WebSocketHandler) HandleWebSocket(c *gin.Context) {
 	h.webSocketService.HandleConnection(c)
 }
 
+// StreamTallyEvents handles GET /api/v1/voting-process/{id}/events requests.
+// It is a server-sent events alternative to the WebSocket for clients that cannot use one: the current tally
+// is sent first as a resync, then every tally update the WebSocket broadcasts for the process, as "tally_update" events.
+func (h *WebSocketHandler) StreamTallyEvents(c *gin.Context) {
+	votingProcessID := c.Param("id")
+
+	logger := h.logger.WithFields(logrus.Fields{
+		"handler":           "event_stream",
+		"remote_addr":       c.Request.RemoteAddr,
+		"user_agent":        c.Request.UserAgent(),
+		"voting_process_id": votingProcessID,
+	})
+
+	logger.Info("Handling event stream request")
+
+	stream, err := h.webSocketService.SubscribeTallyEvents(votingProcessID)
+	if err != nil {
+		logger.WithError(err).Warn("Event stream rejected")
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "Voting process not found",
+			Code:    "NOT_FOUND",
+			Details: err.Error(),
+		})
+		return
+	}
+	defer h.webSocketService.UnsubscribeTallyEvents(stream)
+
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no") // Stop nginx from buffering the stream
+
+	keepAlive := time.NewTicker(eventStreamKeepAlive)
+	defer keepAlive.Stop()
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case message, ok := <-stream.Send:
+			if !ok {
+				logger.Warn("Event stream closed by the server")
+				return false
+			}
+			c.SSEvent("tally_update", json.RawMessage(message))
+			return true
+		case <-keepAlive.C:
+			_, err := io.WriteString(w, ": keep-alive\n\n")
+			return err == nil
+		case <-c.Request.Context().Done():
+			return false
+		}
+	})
+
+	logger.Info("Event stream ended")
+}
+
 // GetWebSocketStats returns WebSocket connection statistics
 func (h *WebSocketHandler) GetWebSocketStats(c *gin.Context) {
 	logger := h.logger.WithField("handler", "websocket_stats")
@@ -54,6 +115,7 @@ func (h *WebSocketHandler) GetWebSocketStats(c *gin.Context) {
 
 	stats := gin.H{
 		"connected_clients": h.webSocketService.GetConnectedClientCount(),
+		"event_streams":     h.webSocketService.GetEventStreamCount(),
 		"status":           "active",
 	}
 
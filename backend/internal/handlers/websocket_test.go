@@ -1,14 +1,22 @@
 package handlers
 
 import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"oyah-backend/internal/models"
 	"oyah-backend/internal/services"
 )
 
@@ -89,4 +97,127 @@ func TestWebSocketHandler_HandleWebSocket_ValidUpgrade(t *testing.T) {
 	// Skip this test as httptest.ResponseRecorder doesn't support WebSocket hijacking
 	// This functionality is tested in the integration test in websocket_test.go
 	t.Skip("WebSocket upgrade cannot be tested with httptest.ResponseRecorder - tested in integration tests")
-}
\ No newline at end of file
+}
+
+func TestWebSocketHandler_StreamTallyEvents(t *testing.T) {
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel) // Suppress logs during testing
+
+	storageService := services.NewStorageService()
+	tallyService := services.NewTallyService(storageService, logger)
+	consensusService := services.NewConsensusService(storageService, logger)
+	webSocketService := services.NewWebSocketService(tallyService, logger)
+	consensusService.SetWebSocketService(webSocketService)
+	handler := NewWebSocketHandler(webSocketService, logger)
+
+	require.NoError(t, storageService.StoreVotingProcess(models.VotingProcess{
+		ID:              "sse-process",
+		Title:           "Streamed Election",
+		Position:        "Mayor",
+		Candidates:      []models.Candidate{{ID: "c1", Name: "Alice"}, {ID: "c2", Name: "Bob"}},
+		PollingStations: []string{"SSE001"},
+		Status:          "Setup",
+	}))
+	require.NoError(t, storageService.UpdateVotingProcessStatus("sse-process", "Active"))
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/api/v1/voting-process/:id/events", handler.StreamTallyEvents)
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	t.Run("UnknownProcess", func(t *testing.T) {
+		resp, err := http.Get(server.URL + "/api/v1/voting-process/missing/events")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("ReceivesUpdateAfterStationVerifies", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		req, err := http.NewRequestWithContext(ctx, "GET", server.URL+"/api/v1/voting-process/sse-process/events", nil)
+		require.NoError(t, err)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Contains(t, resp.Header.Get("Content-Type"), "text/event-stream")
+
+		// Decode the "data:" line of each "tally_update" event
+		events := make(chan services.TallyUpdate, 16)
+		go func() {
+			defer close(events)
+			scanner := bufio.NewScanner(resp.Body)
+			scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+			eventType := ""
+			for scanner.Scan() {
+				line := scanner.Text()
+				switch {
+				case strings.HasPrefix(line, "event:"):
+					eventType = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+				case strings.HasPrefix(line, "data:") && eventType == "tally_update":
+					var update services.TallyUpdate
+					if json.Unmarshal([]byte(strings.TrimPrefix(line, "data:")), &update) == nil {
+						events <- update
+					}
+				}
+			}
+		}()
+
+		stationStatus := func(update services.TallyUpdate) string {
+			data, _ := json.Marshal(update.Data)
+			var tally services.TallyResponse
+			require.NoError(t, json.Unmarshal(data, &tally))
+			for _, station := range tally.PollingStations {
+				if station.ID == "SSE001" {
+					return station.Status
+				}
+			}
+			return ""
+		}
+		next := func() services.TallyUpdate {
+			select {
+			case update, ok := <-events:
+				require.True(t, ok, "event stream ended early")
+				return update
+			case <-time.After(5 * time.Second):
+				t.Fatal("timed out waiting for a tally_update event")
+				return services.TallyUpdate{}
+			}
+		}
+
+		// The stream opens with the current tally
+		snapshot := next()
+		assert.True(t, snapshot.Resync)
+		assert.Equal(t, "sse-process", snapshot.VotingProcessID)
+		assert.Equal(t, "Pending", stationStatus(snapshot))
+		require.Eventually(t, func() bool { return webSocketService.GetEventStreamCount() == 1 }, time.Second, 10*time.Millisecond)
+
+		for i := 0; i < 3; i++ {
+			require.NoError(t, storageService.StoreSubmission(models.Submission{
+				ID:               fmt.Sprintf("sse-sub-%d", i),
+				WalletAddress:    fmt.Sprintf("sse-wallet-%d", i),
+				PollingStationID: "SSE001",
+				Timestamp:        time.Now(),
+				Results:          map[string]int{"Alice": 120, "Bob": 80},
+				SubmissionType:   "image_ocr",
+				Confidence:       0.9,
+			}))
+		}
+		result, err := consensusService.ProcessConsensus("SSE001")
+		require.NoError(t, err)
+		require.Equal(t, "Verified", result.Status)
+
+		update := next()
+		assert.False(t, update.Resync)
+		assert.Equal(t, "tally_update", update.Type)
+		assert.Equal(t, "Verified", stationStatus(update))
+
+		// Closing the connection unregisters the stream
+		cancel()
+		assert.Eventually(t, func() bool { return webSocketService.GetEventStreamCount() == 0 }, time.Second, 10*time.Millisecond)
+	})
+}
@@ -0,0 +1,106 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"oyah-backend/internal/models"
+)
+
+// AdminActor is the actor recorded for requests authenticated with the shared admin token
+const AdminActor = "admin"
+
+// AdminCredentials maps each accepted admin bearer token to the actor recorded in the audit log for its requests
+type AdminCredentials map[string]string
+
+// ParseAdminCredentials builds the admin credentials from the shared admin token, recorded as AdminActor, and a
+// comma-separated list of "name:token" operator tokens, e.g. "alice:s3cret,bob:t0ken", whose requests are recorded
+// under the operator's name. Either may be empty.
+func ParseAdminCredentials(sharedToken, operatorTokens string) (AdminCredentials, error) {
+	credentials := AdminCredentials{}
+	if sharedToken != "" {
+		credentials[sharedToken] = AdminActor
+	}
+
+	for i, entry := range strings.Split(operatorTokens, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		name, token, ok := strings.Cut(entry, ":")
+		name, token = strings.TrimSpace(name), strings.TrimSpace(token)
+		if !ok || name == "" || token == "" {
+			// The entry itself is not echoed, since it may hold a token
+			return nil, fmt.Errorf("invalid operator token entry %d: expected name:token", i+1)
+		}
+		if _, duplicate := credentials[token]; duplicate {
+			return nil, fmt.Errorf("operator %s reuses a token that is already configured", name)
+		}
+		credentials[token] = name
+	}
+
+	return credentials, nil
+}
+
+// RequireAdminToken restricts a route to requests carrying "Authorization: Bearer <token>".
+// With no token configured every request is denied, so admin-only data is never served unprotected.
+func RequireAdminToken(token string) gin.HandlerFunc {
+	credentials := AdminCredentials{}
+	if token != "" {
+		credentials[token] = AdminActor
+	}
+	return RequireAdminCredentials(credentials)
+}
+
+// RequireAdminCredentials restricts a route to requests carrying one of the configured admin bearer tokens,
+// recording the token's actor for the audit log. With no credentials configured every request is denied.
+func RequireAdminCredentials(credentials AdminCredentials) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		actor, ok := credentials.authenticate(c)
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusForbidden, models.ErrorResponse{
+				Error:   "Admin access required",
+				Code:    "FORBIDDEN",
+				Details: "this endpoint requires an admin bearer token",
+			})
+			return
+		}
+
+		// Stored under the same key handlers read the authenticated actor from, for the audit log
+		c.Set("actor", actor)
+		c.Next()
+	}
+}
+
+// RequireAdminCredentialsIfConfigured behaves like RequireAdminCredentials once any admin credentials are configured,
+// and lets every request through otherwise. It guards routes that were public before admin tokens existed, so
+// deployments without tokens keep working; their audit entries then record the actor as "anonymous".
+func RequireAdminCredentialsIfConfigured(credentials AdminCredentials) gin.HandlerFunc {
+	if len(credentials) == 0 {
+		return func(c *gin.Context) {
+			c.Next()
+		}
+	}
+	return RequireAdminCredentials(credentials)
+}
+
+// authenticate returns the actor of the bearer token a request presents, comparing against every configured
+// token in constant time
+func (credentials AdminCredentials) authenticate(c *gin.Context) (string, bool) {
+	presented, ok := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+	if !ok || presented == "" {
+		return "", false
+	}
+
+	actor, matched := "", false
+	for token, name := range credentials {
+		if subtle.ConstantTimeCompare([]byte(presented), []byte(token)) == 1 {
+			actor, matched = name, true
+		}
+	}
+	return actor, matched
+}
@@ -0,0 +1,88 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"oyah-backend/internal/models"
+)
+
+// envelopeWriter buffers the response body so it can be wrapped before being sent
+type envelopeWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *envelopeWriter) Write(data []byte) (int, error) {
+	return w.body.Write(data)
+}
+
+func (w *envelopeWriter) WriteString(s string) (int, error) {
+	return w.body.WriteString(s)
+}
+
+// WriteHeaderNow defers the header until the body has been wrapped
+func (w *envelopeWriter) WriteHeaderNow() {}
+
+func (w *envelopeWriter) Written() bool {
+	return w.body.Len() > 0 || w.ResponseWriter.Written()
+}
+
+func (w *envelopeWriter) Size() int {
+	return w.body.Len()
+}
+
+// ResponseEnvelopeMiddleware wraps every successful JSON response as {success, data, timestamp}.
+// A top-level "success" flag in the original body moves to the envelope; error responses keep the ErrorResponse shape.
+func ResponseEnvelopeMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// Long-lived WebSocket connections are never buffered
+		if strings.EqualFold(c.GetHeader("Upgrade"), "websocket") {
+			c.Next()
+			return
+		}
+
+		original := c.Writer
+		writer := &envelopeWriter{ResponseWriter: original}
+		c.Writer = writer
+
+		c.Next()
+
+		c.Writer = original
+		if writer.body.Len() == 0 {
+			return
+		}
+
+		body := writer.body.Bytes()
+		status := original.Status()
+		if status >= 200 && status < 300 && strings.HasPrefix(original.Header().Get("Content-Type"), "application/json") {
+			if wrapped, err := wrapResponse(body); err == nil {
+				body = wrapped
+			}
+		}
+
+		_, _ = original.Write(body)
+	}
+}
+
+// wrapResponse places a JSON body in the data field of a response envelope
+func wrapResponse(body []byte) ([]byte, error) {
+	var data interface{}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return nil, err
+	}
+
+	if fields, ok := data.(map[string]interface{}); ok {
+		delete(fields, "success")
+	}
+
+	return json.Marshal(models.ResponseEnvelope{
+		Success:   true,
+		Data:      data,
+		Timestamp: time.Now().UTC(),
+	})
+}
@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"oyah-backend/internal/models"
+	"oyah-backend/internal/services"
+)
+
+// ErrorLocalizationMiddleware translates the Error and Details text of error responses into the locale preferred
+// by the request's Accept-Language header. The Code stays unchanged; untranslated codes and English keep the original text.
+func ErrorLocalizationMiddleware(translator *services.ErrorTranslator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		locale := translator.MatchLocale(c.GetHeader("Accept-Language"))
+
+		// Nothing to translate, and long-lived WebSocket connections are never buffered
+		if locale == "" || strings.EqualFold(c.GetHeader("Upgrade"), "websocket") {
+			c.Next()
+			return
+		}
+
+		original := c.Writer
+		writer := &envelopeWriter{ResponseWriter: original}
+		c.Writer = writer
+
+		c.Next()
+
+		c.Writer = original
+		if writer.body.Len() == 0 {
+			return
+		}
+
+		body := writer.body.Bytes()
+		if original.Status() >= 400 && strings.HasPrefix(original.Header().Get("Content-Type"), "application/json") {
+			if translated, ok := translateErrorResponse(body, translator, locale); ok {
+				body = translated
+				original.Header().Set("Content-Language", locale)
+			}
+		}
+
+		_, _ = original.Write(body)
+	}
+}
+
+// translateErrorResponse rewrites the text of an ErrorResponse body, reporting whether a translation applied
+func translateErrorResponse(body []byte, translator *services.ErrorTranslator, locale string) ([]byte, bool) {
+	var response models.ErrorResponse
+	if err := json.Unmarshal(body, &response); err != nil || response.Code == "" {
+		return nil, false
+	}
+
+	message, ok := translator.Translate(response.Code, locale)
+	if !ok {
+		return nil, false
+	}
+
+	response.Error = message.Error
+	if message.Details != "" {
+		response.Details = message.Details
+	}
+
+	translated, err := json.Marshal(response)
+	if err != nil {
+		return nil, false
+	}
+	return translated, true
+}
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"net/http"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -45,4 +46,13 @@ func RequestTracingMiddleware() gin.HandlerFunc {
 			c.Header("X-Response-Time", duration.String())
 		}
 	}
-}
\ No newline at end of file
+}
+
+// DisableWriteTimeout clears the server write deadline for long-lived connections such as WebSockets
+func DisableWriteTimeout() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// Ignore the error: writers that don't support deadlines have no write timeout to clear
+		_ = http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{})
+		c.Next()
+	}
+}
@@ -0,0 +1,82 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Polling station statuses
+const (
+	StationStatusPending             = "Pending"
+	StationStatusVerifiedProvisional = "VerifiedProvisional" // Qualified for verification, waiting out the stability window
+	StationStatusVerified            = "Verified"
+
+	// StationStatusChallenged is reported in tallies for a verified station under an open observer challenge.
+	// The stored status stays Verified, so the station's result keeps counting until the challenge is resolved.
+	StationStatusChallenged = "Challenged"
+)
+
+// Voting process statuses
+const (
+	ProcessStatusSetup    = "Setup"
+	ProcessStatusActive   = "Active"
+	ProcessStatusComplete = "Complete"
+)
+
+// ErrInvalidStatusTransition is returned when a status change is not allowed by the status state machine
+var ErrInvalidStatusTransition = errors.New("invalid status transition")
+
+// stationTransitions lists the statuses a polling station may move to from each status.
+// A verified station returns to Pending when submissions are flagged, removed or contradicted,
+// but never back to VerifiedProvisional.
+var stationTransitions = map[string][]string{
+	StationStatusPending:             {StationStatusVerifiedProvisional, StationStatusVerified},
+	StationStatusVerifiedProvisional: {StationStatusPending, StationStatusVerified},
+	StationStatusVerified:            {StationStatusPending},
+}
+
+// processTransitions lists the statuses a voting process may move to from each status.
+// Complete is final: reopening a process needs its own path rather than a plain status change.
+var processTransitions = map[string][]string{
+	ProcessStatusSetup:  {ProcessStatusActive},
+	ProcessStatusActive: {ProcessStatusComplete},
+}
+
+// ValidateStationTransition reports whether a polling station may move from one status to another.
+// Staying in the same status is always allowed, since consensus re-evaluates stations repeatedly.
+func ValidateStationTransition(from, to string) error {
+	return validateTransition("polling station", stationTransitions, from, to)
+}
+
+// ValidateProcessTransition reports whether a voting process may move from one status to another.
+// Staying in the same status is always allowed.
+func ValidateProcessTransition(from, to string) error {
+	return validateTransition("voting process", processTransitions, from, to)
+}
+
+func validateTransition(subject string, transitions map[string][]string, from, to string) error {
+	if _, known := transitions[to]; !known && !isFinalStatus(transitions, to) {
+		return fmt.Errorf("%w: unknown %s status %q", ErrInvalidStatusTransition, subject, to)
+	}
+	if from == to {
+		return nil
+	}
+	for _, allowed := range transitions[from] {
+		if allowed == to {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s cannot move from %s to %s", ErrInvalidStatusTransition, subject, from, to)
+}
+
+// isFinalStatus reports whether a status is only ever a transition target, like a completed voting process
+func isFinalStatus(transitions map[string][]string, status string) bool {
+	for _, targets := range transitions {
+		for _, target := range targets {
+			if target == status {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -20,7 +20,12 @@ type Submission struct {
 	Results          map[string]int    `json:"results" binding:"required"`
 	SubmissionType   string            `json:"submissionType" binding:"required,oneof=image_ocr audio_stt"`
 	Confidence       float64           `json:"confidence"`
+	EvidenceURI      string            `json:"evidenceUri,omitempty"`  // Where the photo or recording behind the results can be fetched
+	EvidenceHash     string            `json:"evidenceHash,omitempty"` // Hex SHA-256 of the evidence content
+	Tags             map[string]string `json:"tags,omitempty"`         // Free-form metadata such as device model or observer organisation
 	ProcessedAt      time.Time         `json:"processedAt"`
+	Flagged          bool              `json:"flagged,omitempty"`    // Flagged as fraudulent by an administrator; excluded from consensus
+	FlagReason       string            `json:"flagReason,omitempty"`
 }
 
 // SubmissionRequest represents the incoming request payload for submissions
@@ -32,44 +37,247 @@ type SubmissionRequest struct {
 	Results          map[string]int    `json:"results" binding:"required"`
 	SubmissionType   string            `json:"submissionType" binding:"required,oneof=image_ocr audio_stt"`
 	Confidence       float64           `json:"confidence"`
+	EvidenceURI      string            `json:"evidenceUri,omitempty"`  // Optional location of the photo or recording behind the results
+	EvidenceHash     string            `json:"evidenceHash,omitempty"` // Optional hex SHA-256 of the evidence, verified at ingest when enabled
+	Tags             map[string]string `json:"tags,omitempty"`         // Optional free-form metadata for analytics, e.g. {"org": "redcross"}
+}
+
+// CompactSubmissionRequest carries a submission in the signed compact encoding field devices put in QR codes
+type CompactSubmissionRequest struct {
+	Data string `json:"data" binding:"required"` // "<payload>.<signature>", both unpadded base64url
 }
 
 // PollingStation represents a polling station with its submissions and status
 type PollingStation struct {
 	ID              string            `json:"id"`
 	VotingProcessID string            `json:"votingProcessId"`
-	Status          string            `json:"status"` // "Pending" | "Verified"
+	Status          string            `json:"status"` // "Pending" | "VerifiedProvisional" | "Verified"
 	VerifiedResults map[string]int    `json:"verifiedResults,omitempty"`
 	Submissions     []Submission      `json:"submissions"`
 	ConsensusReached *time.Time       `json:"consensusReached,omitempty"`
+	VerifiedAt      *time.Time        `json:"verifiedAt,omitempty"` // When the station last became Verified; later consensus runs leave it unchanged
 	ConfidenceLevel float64           `json:"confidenceLevel"`
+	AverageConfidence float64         `json:"averageConfidence,omitempty"` // Mean submission confidence of the group that formed the verified result
+	AgreementScore  float64           `json:"agreementScore,omitempty"`    // Share of distinct witness wallets whose submissions match the verified result
+	Compacted       bool               `json:"compacted,omitempty"`
+	SubmissionSummary *SubmissionSummary `json:"submissionSummary,omitempty"`
+	ConsensusExplanation string          `json:"consensusExplanation,omitempty"` // Why the last consensus run verified the station or left it pending
+	VerifiedEmpty   bool                 `json:"verifiedEmpty,omitempty"` // Marked by an administrator as having no turnout; verified with zero votes
+	Challenge       *StationChallenge    `json:"challenge,omitempty"`     // Latest observer challenge against the verified result
+	FirstSubmissionAt *time.Time         `json:"firstSubmissionAt,omitempty"` // When the station's first submission arrived
+	LastSubmissionAt  *time.Time         `json:"lastSubmissionAt,omitempty"`  // When its most recent submission arrived, resubmissions included
+}
+
+// StationChallenge records an observer's dispute of a polling station's verified result
+type StationChallenge struct {
+	ID              string         `json:"id"`
+	Challenger      string         `json:"challenger"`
+	Reason          string         `json:"reason"`
+	DisputedResults map[string]int `json:"disputedResults,omitempty"` // The result the challenger believes is correct
+	VerifiedResults map[string]int `json:"verifiedResults"`           // The verified result at the time of the challenge
+	FiledAt         time.Time      `json:"filedAt"`
+	ResolvedAt      *time.Time     `json:"resolvedAt,omitempty"`
+	Resolution      string         `json:"resolution,omitempty"`
+}
+
+// Open reports whether the challenge is still awaiting resolution by an administrator
+func (c *StationChallenge) Open() bool {
+	return c != nil && c.ResolvedAt == nil
+}
+
+// SubmissionSummary records what a polling station received once its raw submissions have been compacted
+type SubmissionSummary struct {
+	TotalSubmissions int            `json:"totalSubmissions"`
+	UniqueWallets    int            `json:"uniqueWallets"`
+	SubmissionTypes  map[string]int `json:"submissionTypes"`
+	CompactedAt      time.Time      `json:"compactedAt"`
+}
+
+// AuditEntry records an administrative action for election integrity reviews
+type AuditEntry struct {
+	ID         string                 `json:"id"`
+	Actor      string                 `json:"actor"`
+	Action     string                 `json:"action"`
+	TargetType string                 `json:"targetType"`
+	TargetID   string                 `json:"targetId"`
+	Timestamp  time.Time              `json:"timestamp"`
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
+}
+
+// TallySnapshot records a voting process's aggregated tally right after a polling station verified
+type TallySnapshot struct {
+	Timestamp        time.Time      `json:"timestamp"`
+	PollingStationID string         `json:"pollingStationId"` // Station whose verification changed the aggregate
+	VerifiedStations int            `json:"verifiedStations"`
+	AggregatedTally  map[string]int `json:"aggregatedTally"`
+}
+
+// SubmissionAck confirms to a client that its submission has been stored.
+// Clients should only delete their local copy once Durable is true.
+type SubmissionAck struct {
+	SubmissionID     string    `json:"submissionId"`
+	PollingStationID string    `json:"pollingStationId"`
+	Stored           bool      `json:"stored"`
+	Durable          bool      `json:"durable"` // True once the submission survives a server restart
+	StoredAt         time.Time `json:"storedAt"`
+}
+
+// StationSubmissionCount reports how many submissions, and from how many wallets, a polling station has received
+type StationSubmissionCount struct {
+	PollingStationID string `json:"pollingStationId"`
+	TotalSubmissions int    `json:"totalSubmissions"`
+	UniqueWallets    int    `json:"uniqueWallets"`
+}
+
+// StationConfidence reports the consensus confidence of a verified polling station, for reviewing weak verifications
+type StationConfidence struct {
+	PollingStationID  string         `json:"pollingStationId"`
+	ConfidenceLevel   float64        `json:"confidenceLevel"`
+	AverageConfidence float64        `json:"averageConfidence,omitempty"`
+	VerifiedResults   map[string]int `json:"verifiedResults,omitempty"`
+	ConsensusReached  *time.Time     `json:"consensusReached,omitempty"`
+}
+
+// Witness represents a wallet that submitted results for a polling station
+type Witness struct {
+	WalletAddress  string    `json:"walletAddress"`
+	SubmissionID   string    `json:"submissionId"`
+	SubmissionType string    `json:"submissionType"`
+	SubmittedAt    time.Time `json:"submittedAt"`
+}
+
+// SpoiltVotesKey is the results key under which spoilt (rejected) ballots are reported
+const SpoiltVotesKey = "spoilt"
+
+// Write-in policies: whether results may name candidates the voting process did not declare
+const (
+	WriteInPolicyAllow  = "allow"  // Write-ins are accepted and tallied separately from declared candidates
+	WriteInPolicyReject = "reject" // Submissions naming an undeclared candidate are rejected
+)
+
+// ValidationRules gathers the rules a voting process's submissions are validated against.
+// It is embedded in voting processes and their creation requests, so each rule keeps its own top-level JSON field.
+type ValidationRules struct {
+	MaxVotes               int      `json:"maxVotes,omitempty"`               // Most votes a submission may report in total, spoilt included; 0 means no limit
+	SubmissionTypes        []string `json:"submissionTypes,omitempty"`        // Capture channels submissions must use, e.g. ["audio_stt"]; empty accepts every channel
+	AllowedWallets         []string `json:"allowedWallets,omitempty"`         // Wallets allowed to submit in a closed election; empty means open
+	RejectEmptyTally       bool     `json:"rejectEmptyTally"`                 // Reject submissions whose votes sum to zero; leave off where zero turnout is possible
+	WriteInPolicy          string   `json:"writeInPolicy,omitempty"`          // "allow" (default) tallies write-ins separately | "reject" refuses them
+	RequireCompleteResults bool     `json:"requireCompleteResults,omitempty"` // Submissions must report a count for every declared candidate
 }
 
 // Candidate represents a candidate in a voting process
 type Candidate struct {
-	ID   string `json:"id" binding:"required"`
-	Name string `json:"name" binding:"required"`
+	ID    string `json:"id" binding:"required"`
+	Name  string `json:"name" binding:"required"`
+	Party string `json:"party,omitempty"` // Optional party or affiliation label
+	Color string `json:"color,omitempty"` // Optional display color as a hex code, e.g. "#1E90FF"
 }
 
 // VotingProcess represents a voting process with multiple polling stations
 type VotingProcess struct {
-	ID              string      `json:"id"`
-	Title           string      `json:"title" binding:"required"`
-	Position        string      `json:"position" binding:"required"`
-	Candidates      []Candidate `json:"candidates" binding:"required,min=1"`
-	PollingStations []string    `json:"pollingStations" binding:"required,min=1"`
-	Status          string      `json:"status"` // "Setup" | "Active" | "Complete"
-	CreatedAt       time.Time   `json:"createdAt"`
-	StartedAt       *time.Time  `json:"startedAt,omitempty"`
-	CompletedAt     *time.Time  `json:"completedAt,omitempty"`
+	ID                   string         `json:"id"`
+	Title                string         `json:"title" binding:"required"`
+	Position             string         `json:"position" binding:"required"`
+	Candidates           []Candidate    `json:"candidates" binding:"required,min=1"`
+	PollingStations      []string       `json:"pollingStations" binding:"required,min=1"`
+	Status               string         `json:"status"` // "Setup" | "Active" | "Complete"
+	CreatedAt            time.Time      `json:"createdAt"`
+	ScheduledStart       *time.Time     `json:"scheduledStart,omitempty"`
+	WebhookURL           string         `json:"webhookUrl,omitempty"`           // Notified when a station is verified
+	SeatsAvailable       int            `json:"seatsAvailable"`                 // Number of winners, e.g. 5 for council seats
+	MinLocationClusters  int            `json:"minLocationClusters,omitempty"`  // Distinct GPS locations the agreeing witnesses must span
+	ExpectedBallots      map[string]int `json:"expectedBallots,omitempty"`      // Declared ballots cast per station, cross-checked against verified totals
+	BallotTolerance      int            `json:"ballotTolerance,omitempty"`      // Allowed difference between verified and expected ballots
+	AutoCompleteFraction float64        `json:"autoCompleteFraction,omitempty"` // Share of verified stations that completes the process; 0 disables
+	EmbargoUntilComplete bool           `json:"embargoUntilComplete,omitempty"` // Withhold vote counts from the tally until the process is Complete
+	Referendum           bool           `json:"referendum,omitempty"`           // Candidates are the referendum's options; results may only name them and spoilt
+	ValidationRules                     // Per-process submission rules
+	StartedAt            *time.Time     `json:"startedAt,omitempty"`
+	CompletedAt          *time.Time     `json:"completedAt,omitempty"`
+	CompactedAt          *time.Time     `json:"compactedAt,omitempty"`
+	CompactedWallets     int            `json:"compactedWallets,omitempty"`     // Distinct wallets across the process's stations when it was compacted
+}
+
+// ResultsEmbargoed reports whether the process's vote counts are currently withheld: it embargoes them until it is Complete
+func (p *VotingProcess) ResultsEmbargoed() bool {
+	return p.EmbargoUntilComplete && p.Status != ProcessStatusComplete
 }
 
 // VotingProcessRequest represents the incoming request payload for creating voting processes
 type VotingProcessRequest struct {
-	Title           string      `json:"title" binding:"required"`
-	Position        string      `json:"position" binding:"required"`
-	Candidates      []Candidate `json:"candidates" binding:"required,min=1"`
-	PollingStations []string    `json:"pollingStations" binding:"required,min=1"`
+	Title                string         `json:"title" binding:"required"`
+	Position             string         `json:"position" binding:"required"`
+	Candidates           []Candidate    `json:"candidates" binding:"required,min=1"`
+	PollingStations      []string       `json:"pollingStations" binding:"required,min=1"`
+	ScheduledStart       *time.Time     `json:"scheduledStart,omitempty"`       // Optional time at which the process starts automatically
+	WebhookURL           string         `json:"webhookUrl,omitempty"`           // Optional http(s) URL notified when a station is verified
+	SeatsAvailable       int            `json:"seatsAvailable,omitempty"`       // Number of winners for multi-seat positions; defaults to 1
+	MinLocationClusters  int            `json:"minLocationClusters,omitempty"`  // Optional number of distinct GPS locations agreeing witnesses must come from
+	ExpectedBallots      map[string]int `json:"expectedBallots,omitempty"`      // Optional declared ballots cast per station; enables the totals cross-check
+	BallotTolerance      int            `json:"ballotTolerance,omitempty"`      // Votes by which a verified total may differ from the expected ballots
+	AutoCompleteFraction float64        `json:"autoCompleteFraction,omitempty"` // Optional share of stations (0-1] whose verification completes the process automatically
+	EmbargoUntilComplete bool           `json:"embargoUntilComplete,omitempty"` // Publish only station statuses until the process is Complete, where the law requires it
+	Referendum           bool           `json:"referendum,omitempty"`           // Treat candidates as a fixed option set, e.g. Yes and No, and reject results naming anything else
+	ValidationRules                     // Optional submission rules, e.g. maxVotes, submissionTypes, allowedWallets or rejectEmptyTally
+}
+
+// AddPollingStationsRequest represents the incoming request payload for registering stations to an existing voting process
+type AddPollingStationsRequest struct {
+	PollingStations []string `json:"pollingStations" binding:"required,min=1"`
+}
+
+// TallyBatchRequest represents the incoming request payload for fetching several tallies at once
+type TallyBatchRequest struct {
+	VotingProcessIDs []string `json:"votingProcessIds" binding:"required,min=1"`
+}
+
+// FlagSubmissionRequest represents the incoming request payload for flagging a submission as fraudulent
+type FlagSubmissionRequest struct {
+	Reason string `json:"reason" binding:"required,max=500"`
+}
+
+// MaintenanceModeRequest represents the incoming request payload for turning maintenance mode on or off
+type MaintenanceModeRequest struct {
+	Enabled *bool `json:"enabled" binding:"required"`
+}
+
+// ChallengeStationRequest represents the incoming request payload for challenging a verified polling station result
+type ChallengeStationRequest struct {
+	Challenger      string         `json:"challenger" binding:"required,max=200"`
+	Reason          string         `json:"reason" binding:"required,max=500"`
+	DisputedResults map[string]int `json:"disputedResults,omitempty"`
+}
+
+// ResolveChallengeRequest represents the incoming request payload for resolving a polling station challenge
+type ResolveChallengeRequest struct {
+	Resolution string `json:"resolution" binding:"required,max=500"`
+}
+
+// SeedRequest represents the incoming request payload for generating synthetic load test data
+type SeedRequest struct {
+	Processes             int     `json:"processes" binding:"required,min=1,max=100"`
+	StationsPerProcess    int     `json:"stationsPerProcess" binding:"required,min=1,max=1000"`
+	SubmissionsPerStation int     `json:"submissionsPerStation" binding:"min=0,max=20"`
+	CandidatesPerProcess  int     `json:"candidatesPerProcess,omitempty" binding:"omitempty,min=1,max=20"` // Defaults to 3
+	AgreementRate         float64 `json:"agreementRate,omitempty" binding:"omitempty,gt=0,max=1"`          // Share of witnesses reporting the true result; defaults to 0.8
+	RandomSeed            int64   `json:"randomSeed,omitempty"`                                            // Reuse to reproduce the same vote counts
+	ProcessConsensus      bool    `json:"processConsensus"`                                                // Run consensus on every seeded station
+}
+
+// TimelineEvent represents a single event in a voting process lifecycle
+type TimelineEvent struct {
+	Type             string    `json:"type"` // "created" | "started" | "station_verified" | "completed"
+	Timestamp        time.Time `json:"timestamp"`
+	PollingStationID string    `json:"pollingStationId,omitempty"`
+	Description      string    `json:"description"`
+}
+
+// ResponseEnvelope is the uniform shape of successful API responses when the response envelope is enabled
+type ResponseEnvelope struct {
+	Success   bool        `json:"success"`
+	Data      interface{} `json:"data"`
+	Timestamp time.Time   `json:"timestamp"`
 }
 
 // ErrorResponse represents API error responses
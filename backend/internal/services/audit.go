@@ -0,0 +1,98 @@
+package services
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"oyah-backend/internal/models"
+)
+
+// Audited administrative actions
+const (
+	AuditActionCreateVotingProcess  = "voting_process.create"
+	AuditActionStartVotingProcess   = "voting_process.start"
+	AuditActionCompactVotingProcess = "voting_process.compact"
+	AuditActionAddPollingStations   = "voting_process.add_stations"
+	AuditActionRepairVotingProcess  = "voting_process.repair"
+	AuditActionMarkStationEmpty     = "polling_station.mark_empty"
+	AuditActionResolveChallenge     = "polling_station.resolve_challenge"
+	AuditActionFlagSubmission       = "submission.flag"
+	AuditActionRemoveSubmission     = "submission.remove"
+	AuditActionSetMaintenanceMode   = "server.maintenance"
+)
+
+// AuditFilter narrows an audit log query; empty fields match everything
+type AuditFilter struct {
+	Actor    string
+	Action   string
+	TargetID string
+}
+
+// AuditService keeps an append-only log of administrative actions.
+// Every entry is also written to the structured log so it outlives the in-memory store.
+type AuditService struct {
+	entries []models.AuditEntry
+	logger  *logrus.Logger
+	mutex   sync.RWMutex
+}
+
+// NewAuditService creates a new audit service instance
+func NewAuditService(logger *logrus.Logger) *AuditService {
+	return &AuditService{
+		entries: []models.AuditEntry{},
+		logger:  logger,
+	}
+}
+
+// Record appends an administrative action to the audit log
+func (a *AuditService) Record(actor, action, targetType, targetID string, parameters map[string]interface{}) models.AuditEntry {
+	entry := models.AuditEntry{
+		ID:         uuid.New().String(),
+		Actor:      actor,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Timestamp:  time.Now(),
+		Parameters: parameters,
+	}
+
+	a.mutex.Lock()
+	a.entries = append(a.entries, entry)
+	a.mutex.Unlock()
+
+	a.logger.WithFields(logrus.Fields{
+		"audit_id":    entry.ID,
+		"actor":       entry.Actor,
+		"action":      entry.Action,
+		"target_type": entry.TargetType,
+		"target_id":   entry.TargetID,
+		"parameters":  entry.Parameters,
+		"service":     "audit",
+	}).Info("Admin action recorded")
+
+	return entry
+}
+
+// Query returns the audit entries matching the filter in the order they were recorded
+func (a *AuditService) Query(filter AuditFilter) []models.AuditEntry {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+
+	result := []models.AuditEntry{}
+	for _, entry := range a.entries {
+		if filter.Actor != "" && entry.Actor != filter.Actor {
+			continue
+		}
+		if filter.Action != "" && entry.Action != filter.Action {
+			continue
+		}
+		if filter.TargetID != "" && entry.TargetID != filter.TargetID {
+			continue
+		}
+		result = append(result, entry)
+	}
+	return result
+}
@@ -0,0 +1,32 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditService_RecordAndQuery(t *testing.T) {
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+
+	audit := NewAuditService(logger)
+	first := audit.Record("alice", AuditActionCreateVotingProcess, "voting_process", "vp-1", map[string]interface{}{"title": "Election"})
+	audit.Record("bob", AuditActionStartVotingProcess, "voting_process", "vp-1", nil)
+	audit.Record("alice", AuditActionCreateVotingProcess, "voting_process", "vp-2", nil)
+
+	assert.NotEmpty(t, first.ID)
+	assert.False(t, first.Timestamp.IsZero())
+
+	all := audit.Query(AuditFilter{})
+	require.Len(t, all, 3)
+	assert.Equal(t, first, all[0])
+
+	assert.Len(t, audit.Query(AuditFilter{Actor: "alice"}), 2)
+	assert.Len(t, audit.Query(AuditFilter{Action: AuditActionStartVotingProcess}), 1)
+	assert.Len(t, audit.Query(AuditFilter{TargetID: "vp-1"}), 2)
+	assert.Len(t, audit.Query(AuditFilter{Actor: "alice", TargetID: "vp-2"}), 1)
+	assert.Empty(t, audit.Query(AuditFilter{Actor: "carol"}))
+}
@@ -0,0 +1,209 @@
+package services
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/blake2b"
+
+	"oyah-backend/internal/models"
+)
+
+// Compact submission errors
+var (
+	// ErrCompactMalformed reports a compact submission that cannot be decoded
+	ErrCompactMalformed = errors.New("malformed compact submission")
+
+	// ErrCompactSignature reports a compact submission whose signature was not made by its wallet's key
+	ErrCompactSignature = errors.New("invalid compact submission signature")
+)
+
+// compactSubmissionTypes maps the one-letter submission type codes used in QR codes to submission types
+var compactSubmissionTypes = map[string]string{
+	"o": "image_ocr",
+	"a": "audio_stt",
+}
+
+// compactSubmission is the short-keyed payload field devices encode into QR codes
+type compactSubmission struct {
+	Wallet       string            `json:"w"`
+	Station      string            `json:"s"`
+	Latitude     float64           `json:"la"`
+	Longitude    float64           `json:"lo"`
+	Timestamp    int64             `json:"t"` // Unix seconds
+	Results      map[string]int    `json:"r"`
+	Type         string            `json:"y"` // "o" for image_ocr, "a" for audio_stt
+	Confidence   float64           `json:"c"`
+	EvidenceURI  string            `json:"eu,omitempty"`
+	EvidenceHash string            `json:"eh,omitempty"`
+	Tags         map[string]string `json:"tg,omitempty"`
+}
+
+// EncodeCompactSubmission encodes a submission in the QR code format "<payload>.<signature>", both unpadded
+// base64url, where the payload is short-keyed JSON and the signature is the wallet's ed25519 signature of it
+func EncodeCompactSubmission(req models.SubmissionRequest, privateKey ed25519.PrivateKey) (string, error) {
+	compact := compactSubmission{
+		Wallet:       req.WalletAddress,
+		Station:      req.PollingStationID,
+		Latitude:     req.GPSCoordinates.Latitude,
+		Longitude:    req.GPSCoordinates.Longitude,
+		Timestamp:    req.Timestamp.Unix(),
+		Results:      req.Results,
+		Confidence:   req.Confidence,
+		EvidenceURI:  req.EvidenceURI,
+		EvidenceHash: req.EvidenceHash,
+		Tags:         req.Tags,
+	}
+	for code, submissionType := range compactSubmissionTypes {
+		if submissionType == req.SubmissionType {
+			compact.Type = code
+		}
+	}
+	if compact.Type == "" {
+		return "", fmt.Errorf("submission type %q has no compact code", req.SubmissionType)
+	}
+
+	payload, err := json.Marshal(compact)
+	if err != nil {
+		return "", err
+	}
+	signature := ed25519.Sign(privateKey, payload)
+
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// DecodeCompactSubmission decodes a QR code compact submission and verifies that it was signed by the ed25519 key
+// of the wallet it names. The decoded request still has to pass the usual submission validation.
+func DecodeCompactSubmission(encoded string) (models.SubmissionRequest, error) {
+	encodedPayload, encodedSignature, found := strings.Cut(strings.TrimSpace(encoded), ".")
+	if !found {
+		return models.SubmissionRequest{}, fmt.Errorf("%w: expected <payload>.<signature>", ErrCompactMalformed)
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return models.SubmissionRequest{}, fmt.Errorf("%w: payload is not base64url: %v", ErrCompactMalformed, err)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return models.SubmissionRequest{}, fmt.Errorf("%w: signature is not base64url: %v", ErrCompactMalformed, err)
+	}
+
+	var compact compactSubmission
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&compact); err != nil {
+		return models.SubmissionRequest{}, fmt.Errorf("%w: payload: %v", ErrCompactMalformed, err)
+	}
+
+	submissionType, ok := compactSubmissionTypes[compact.Type]
+	if !ok {
+		return models.SubmissionRequest{}, fmt.Errorf("%w: unknown submission type code %q", ErrCompactMalformed, compact.Type)
+	}
+
+	publicKey, err := ss58PublicKey(compact.Wallet)
+	if err != nil {
+		return models.SubmissionRequest{}, fmt.Errorf("%w: wallet address: %v", ErrCompactMalformed, err)
+	}
+	if len(signature) != ed25519.SignatureSize || !ed25519.Verify(publicKey, payload, signature) {
+		return models.SubmissionRequest{}, fmt.Errorf("%w: not signed by wallet %s", ErrCompactSignature, compact.Wallet)
+	}
+
+	return models.SubmissionRequest{
+		WalletAddress:    compact.Wallet,
+		PollingStationID: compact.Station,
+		GPSCoordinates:   models.GPSCoordinates{Latitude: compact.Latitude, Longitude: compact.Longitude},
+		Timestamp:        time.Unix(compact.Timestamp, 0).UTC(),
+		Results:          compact.Results,
+		SubmissionType:   submissionType,
+		Confidence:       compact.Confidence,
+		EvidenceURI:      compact.EvidenceURI,
+		EvidenceHash:     compact.EvidenceHash,
+		Tags:             compact.Tags,
+	}, nil
+}
+
+// base58Alphabet is the Bitcoin base58 alphabet used by SS58 addresses
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// ss58ChecksumPrefix is hashed before an SS58 address's prefix and key to compute its checksum
+var ss58ChecksumPrefix = []byte("SS58PRE")
+
+// SS58Address encodes a 32-byte public key as an SS58 address for the given network prefix (0-63), e.g. 42 for generic Substrate
+func SS58Address(publicKey []byte, network byte) string {
+	data := append([]byte{network}, publicKey...)
+	checksum := ss58Checksum(data)
+	data = append(data, checksum[:2]...)
+
+	value := new(big.Int).SetBytes(data)
+	base := big.NewInt(58)
+	remainder := new(big.Int)
+
+	var encoded []byte
+	for value.Sign() > 0 {
+		value.DivMod(value, base, remainder)
+		encoded = append(encoded, base58Alphabet[remainder.Int64()])
+	}
+	for _, b := range data {
+		if b != 0 {
+			break
+		}
+		encoded = append(encoded, base58Alphabet[0])
+	}
+
+	for i, j := 0, len(encoded)-1; i < j; i, j = i+1, j-1 {
+		encoded[i], encoded[j] = encoded[j], encoded[i]
+	}
+	return string(encoded)
+}
+
+// ss58PublicKey extracts the 32-byte public key from an SS58 address after checking its checksum
+func ss58PublicKey(address string) (ed25519.PublicKey, error) {
+	value := new(big.Int)
+	base := big.NewInt(58)
+	for _, char := range address {
+		digit := strings.IndexRune(base58Alphabet, char)
+		if digit < 0 {
+			return nil, fmt.Errorf("invalid base58 character %q", char)
+		}
+		value.Mul(value, base)
+		value.Add(value, big.NewInt(int64(digit)))
+	}
+
+	data := value.Bytes()
+	for _, char := range address {
+		if char != rune(base58Alphabet[0]) {
+			break
+		}
+		data = append([]byte{0}, data...)
+	}
+
+	// Networks 0-63 use a one-byte prefix, 64-16383 a two-byte prefix
+	prefixLength := 1
+	if len(data) > 0 && data[0]&0x40 != 0 {
+		prefixLength = 2
+	}
+	if len(data) != prefixLength+ed25519.PublicKeySize+2 {
+		return nil, fmt.Errorf("unexpected decoded length %d", len(data))
+	}
+
+	body := data[:prefixLength+ed25519.PublicKeySize]
+	checksum := ss58Checksum(body)
+	if !bytes.Equal(checksum[:2], data[len(body):]) {
+		return nil, errors.New("checksum mismatch")
+	}
+
+	return ed25519.PublicKey(body[prefixLength:]), nil
+}
+
+// ss58Checksum hashes an SS58 prefix and public key with the "SS58PRE" context
+func ss58Checksum(data []byte) [blake2b.Size]byte {
+	return blake2b.Sum512(append(append([]byte{}, ss58ChecksumPrefix...), data...))
+}
@@ -0,0 +1,80 @@
+package services
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"oyah-backend/internal/models"
+)
+
+func TestSS58Address_KnownVector(t *testing.T) {
+	// The well-known development account "Alice" on the generic Substrate network
+	publicKey, _ := hex.DecodeString("d43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d")
+	address := "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY"
+
+	if got := SS58Address(publicKey, 42); got != address {
+		t.Errorf("SS58Address() = %s, want %s", got, address)
+	}
+
+	decoded, err := ss58PublicKey(address)
+	if err != nil {
+		t.Fatalf("ss58PublicKey() error = %v", err)
+	}
+	if !reflect.DeepEqual([]byte(decoded), publicKey) {
+		t.Errorf("ss58PublicKey() = %x, want %x", decoded, publicKey)
+	}
+
+	// Changing a character breaks the checksum
+	if _, err := ss58PublicKey("5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQZ"); err == nil {
+		t.Error("Expected a checksum error for a mistyped address")
+	}
+}
+
+func TestCompactSubmission_RoundTrip(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+
+	original := models.SubmissionRequest{
+		WalletAddress:    SS58Address(publicKey, 42),
+		PollingStationID: "STATION_001",
+		GPSCoordinates:   models.GPSCoordinates{Latitude: -1.2921, Longitude: 36.8219},
+		Timestamp:        time.Date(2026, 8, 9, 17, 30, 0, 0, time.UTC),
+		Results:          map[string]int{"Alice": 120, "Bob": 80},
+		SubmissionType:   "audio_stt",
+		Confidence:       0.9,
+		EvidenceHash:     strings.Repeat("ab", 32),
+	}
+
+	encoded, err := EncodeCompactSubmission(original, privateKey)
+	if err != nil {
+		t.Fatalf("EncodeCompactSubmission() error = %v", err)
+	}
+
+	decoded, err := DecodeCompactSubmission(encoded)
+	if err != nil {
+		t.Fatalf("DecodeCompactSubmission() error = %v", err)
+	}
+	if !reflect.DeepEqual(decoded, original) {
+		t.Errorf("DecodeCompactSubmission() = %+v, want %+v", decoded, original)
+	}
+
+	// Changing a vote count in the signed payload invalidates the signature
+	encodedPayload, signature, _ := strings.Cut(encoded, ".")
+	payload, _ := base64.RawURLEncoding.DecodeString(encodedPayload)
+	tamperedPayload := strings.Replace(string(payload), `"Alice":120`, `"Alice":920`, 1)
+	if tamperedPayload == string(payload) {
+		t.Fatalf("Payload %s does not contain the expected vote count", payload)
+	}
+	tampered := base64.RawURLEncoding.EncodeToString([]byte(tamperedPayload)) + "." + signature
+	if _, err := DecodeCompactSubmission(tampered); !errors.Is(err, ErrCompactSignature) {
+		t.Errorf("Expected ErrCompactSignature for a tampered payload, got %v", err)
+	}
+}
@@ -2,7 +2,13 @@ package services
 
 import (
 	"fmt"
+	"maps"
+	"math"
 	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"oyah-backend/internal/models"
@@ -10,37 +16,142 @@ import (
 
 // ConsensusResult represents the result of consensus processing
 type ConsensusResult struct {
-	Status          string         `json:"status"` // "Pending" | "Verified"
-	VerifiedResults map[string]int `json:"verifiedResults,omitempty"`
-	ConfidenceLevel float64        `json:"confidenceLevel"`
-	Message         string         `json:"message"`
+	Status             string         `json:"status"` // "Pending" | "VerifiedProvisional" | "Verified"
+	VerifiedResults    map[string]int `json:"verifiedResults,omitempty"`
+	ConfidenceLevel    float64        `json:"confidenceLevel"`
+	AverageConfidence  float64        `json:"averageConfidence,omitempty"` // Mean submission confidence of the agreeing group
+	AgreementScore     float64        `json:"agreementScore,omitempty"`    // Share of distinct witness wallets in the agreeing group
+	Message            string         `json:"message"`
+	Explanation        string         `json:"explanation,omitempty"` // Group sizes, rules applied and the reason for the decision
+	EffectiveThreshold int            `json:"effectiveThreshold"`    // Agreeing wallets the result needs, after threshold scaling for the station's submission volume
+}
+
+// majorityPercentage is the share of a station's submissions the leading result group must exceed
+const majorityPercentage = 50.0
+
+// VerificationCriteria describes the rules a polling station's submissions must meet to be verified,
+// so observers can check how results are accepted
+type VerificationCriteria struct {
+	ConsensusThreshold     int      `json:"consensusThreshold"` // Submissions required before consensus is evaluated
+	MinAgreeingWallets     int      `json:"minAgreeingWallets"` // Wallets that must report the same result
+	MajorityPercentage     float64  `json:"majorityPercentage"` // Share of submissions the agreeing wallets must exceed
+	ToleranceAbsolute      int      `json:"toleranceAbsolute,omitempty"`
+	TolerancePercent       float64  `json:"tolerancePercent,omitempty"`
+	MinLocationClusters    int      `json:"minLocationClusters,omitempty"`
+	ClusterRadiusMeters    float64  `json:"clusterRadiusMeters,omitempty"`
+	StabilityWindowSeconds float64  `json:"stabilityWindowSeconds,omitempty"`
+	ConfidenceTieBreak     bool     `json:"confidenceTieBreak,omitempty"`     // Ties go to the group with the higher average confidence
+	ThresholdScaling       float64  `json:"thresholdScaling,omitempty"`       // Busy stations need at least this share of submissions to agree
+	PostVerificationPolicy string   `json:"postVerificationPolicy,omitempty"` // How submissions after verification are handled; omitted for "recompute"
+	RejectEmptyTally       bool     `json:"rejectEmptyTally"`
+	SubmissionTypes        []string `json:"submissionTypes,omitempty"` // Capture channels accepted; omitted when every channel is
+	MaxVotes               int      `json:"maxVotes,omitempty"`        // Most votes a submission may report in total; omitted without a limit
 }
 
 // ConsensusService handles consensus processing for polling station submissions
 type ConsensusService struct {
 	storageService   *StorageService
 	webSocketService *WebSocketService
+	webhookService   *WebhookService
 	logger           *logrus.Logger
 	threshold        int // Minimum submissions required for consensus
+	minAgreeing      int // Minimum wallets that must agree on a result; 0 falls back to threshold
+	thresholdScaling float64 // Share of a station's submissions the agreeing wallets must also reach; 0 disables scaling
+
+	// Tolerance mode groups near-identical results; both zero means exact matching
+	toleranceAbsolute int     // Maximum per-candidate difference in votes
+	tolerancePercent  float64 // Maximum per-candidate difference as a percentage of the larger count
+
+	confidencePrecision int // Decimal places confidence levels are quantized to before comparison or storage
+
+	clusterRadiusMeters float64 // Witnesses closer than this count as one location for geographic spread checks
+
+	confidenceTieBreak bool // Verify the tied group with the highest average submission confidence instead of staying pending
+
+	postVerificationPolicy string // How submissions arriving after a station was verified are handled
+
+	auditLogger *ConsensusAuditLogger // Optional dedicated sink recording every consensus decision
+
+	// Per-station locks so a station is never evaluated by two consensus runs at once, whichever path starts them
+	stationLocks      map[string]*sync.Mutex
+	stationLocksMutex sync.Mutex
+
+	// Stations that newly qualified for verification wait out the stability window as "VerifiedProvisional"
+	stabilityWindow  time.Duration
+	provisional      map[string]*provisionalVerification
+	provisionalMutex sync.Mutex
+
+	// Consensus outcome counters
+	verifiedCounter *Counter
+	pendingCounter  *Counter
+	tieCounter      *Counter
+
+	// Tally broadcasts sent, and skipped because a consensus run left the station unchanged
+	broadcastCounter        *Counter
+	skippedBroadcastCounter *Counter
 }
 
 // NewConsensusService creates a new consensus service instance
 func NewConsensusService(storage *StorageService, logger *logrus.Logger) *ConsensusService {
 	return &ConsensusService{
-		storageService: storage,
-		logger:         logger,
-		threshold:      3, // Minimum 3 submissions for consensus
+		storageService:      storage,
+		logger:              logger,
+		threshold:           3, // Minimum 3 submissions for consensus
+		confidencePrecision: DefaultConfidencePrecision,
+		clusterRadiusMeters: DefaultClusterRadiusMeters,
+		postVerificationPolicy: PostVerificationRecompute,
+		provisional:         make(map[string]*provisionalVerification),
+		stationLocks:        make(map[string]*sync.Mutex),
+		verifiedCounter:     NewCounter("oyah_consensus_verified_total", "Consensus evaluations that verified a result"),
+		pendingCounter:      NewCounter("oyah_consensus_pending_total", "Consensus evaluations that left a station pending"),
+		tieCounter:          NewCounter("oyah_consensus_tie_total", "Consensus evaluations where the largest result groups tied"),
+		broadcastCounter:        NewCounter("oyah_consensus_broadcasts_total", "Tally updates broadcast after a consensus evaluation changed a station"),
+		skippedBroadcastCounter: NewCounter("oyah_consensus_broadcasts_skipped_total", "Tally broadcasts skipped because a consensus evaluation left a station unchanged"),
 	}
 }
 
+// Metrics returns the consensus outcome counters for registration with a metrics registry
+func (c *ConsensusService) Metrics() []*Counter {
+	return []*Counter{c.verifiedCounter, c.pendingCounter, c.tieCounter, c.broadcastCounter, c.skippedBroadcastCounter}
+}
+
 // SetWebSocketService sets the WebSocket service for broadcasting updates
 func (c *ConsensusService) SetWebSocketService(wsService *WebSocketService) {
 	c.webSocketService = wsService
 	c.logger.Info("WebSocket service attached to consensus engine")
 }
 
-// ProcessConsensus processes consensus for a polling station after a new submission
+// SetWebhookService sets the webhook service used to notify integrators when a station is verified
+func (c *ConsensusService) SetWebhookService(webhookService *WebhookService) {
+	c.webhookService = webhookService
+	c.logger.Info("Webhook service attached to consensus engine")
+}
+
+// ProcessConsensus processes consensus for a polling station after a new submission.
+// Runs for the same station are serialized, so overlapping callers cannot both see the station as newly verified.
 func (c *ConsensusService) ProcessConsensus(pollingStationID string) (*ConsensusResult, error) {
+	unlock := c.lockStation(pollingStationID)
+	defer unlock()
+
+	return c.processConsensus(pollingStationID)
+}
+
+// lockStation acquires the consensus lock of a polling station and returns the function releasing it
+func (c *ConsensusService) lockStation(pollingStationID string) func() {
+	c.stationLocksMutex.Lock()
+	stationLock, exists := c.stationLocks[pollingStationID]
+	if !exists {
+		stationLock = &sync.Mutex{}
+		c.stationLocks[pollingStationID] = stationLock
+	}
+	c.stationLocksMutex.Unlock()
+
+	stationLock.Lock()
+	return stationLock.Unlock
+}
+
+// processConsensus evaluates a polling station's submissions. Must be called with the station's lock held.
+func (c *ConsensusService) processConsensus(pollingStationID string) (*ConsensusResult, error) {
 	logger := c.logger.WithFields(logrus.Fields{
 		"polling_station_id": pollingStationID,
 		"service":           "consensus",
@@ -56,6 +167,24 @@ func (c *ConsensusService) ProcessConsensus(pollingStationID string) (*Consensus
 
 	logger.WithField("submission_count", len(submissions)).Info("Found submissions for consensus processing")
 
+	// Submissions flagged as fraudulent no longer count towards consensus
+	allSubmissions := submissions
+	if active := unflaggedSubmissions(submissions); len(active) < len(submissions) {
+		logger.WithField("flagged_submissions", len(submissions)-len(active)).Info("Excluding flagged submissions from consensus")
+		submissions = active
+	}
+
+	// Remember the previous status so webhooks only fire when a station first becomes verified,
+	// and so a station that loses its verification is announced
+	previousStatus := ""
+	previous, err := c.storageService.GetPollingStation(pollingStationID)
+	if err == nil {
+		previousStatus = previous.Status
+	}
+
+	// Submissions arriving after verification are handled according to the post-verification policy
+	submissions, reopened := c.applyPostVerificationPolicy(pollingStationID, previousStatus, submissions, logger)
+
 	// Group submissions by identical results and enforce wallet uniqueness
 	resultGroups := c.groupSubmissionsByResults(submissions)
 	
@@ -65,10 +194,12 @@ func (c *ConsensusService) ProcessConsensus(pollingStationID string) (*Consensus
 	if len(submissions) < c.threshold {
 		logger.WithField("threshold", c.threshold).Info("Minimum threshold not yet reached")
 		result := &ConsensusResult{
-			Status:          "Pending",
+			Status:          models.StationStatusPending,
 			ConfidenceLevel: 0.0,
 			Message:         fmt.Sprintf("Waiting for more submissions - %d received (threshold: %d)", len(submissions), c.threshold),
 		}
+		result.Explanation = c.explainConsensus(result, resultGroups, len(submissions))
+		result.EffectiveThreshold = c.scaledMinAgreeingWallets(len(submissions))
 
 		// Update polling station status
 		err := c.storageService.UpdatePollingStationStatus(
@@ -81,31 +212,28 @@ func (c *ConsensusService) ProcessConsensus(pollingStationID string) (*Consensus
 			logger.WithError(err).Error("Failed to update polling station status")
 			return nil, fmt.Errorf("failed to update polling station status: %w", err)
 		}
-
-		// Trigger WebSocket broadcast for pending status update if WebSocket service is available
-		if c.webSocketService != nil {
-			// Get the voting process ID for this polling station
-			station, err := c.storageService.GetPollingStation(pollingStationID)
-			if err == nil && station.VotingProcessID != "" {
-				// Broadcast tally update for the voting process
-				broadcastErr := c.webSocketService.BroadcastTallyUpdate(station.VotingProcessID)
-				if broadcastErr != nil {
-					logger.WithError(broadcastErr).Error("Failed to broadcast tally update via WebSocket")
-					// Don't return error - consensus processing succeeded, broadcast failure is not critical
-				} else {
-					logger.WithField("voting_process_id", station.VotingProcessID).Info("WebSocket tally update broadcast triggered for pending status")
-				}
-			}
+		if err := c.storageService.SetPollingStationConsensusExplanation(pollingStationID, result.Explanation); err != nil {
+			logger.WithError(err).Error("Failed to record consensus explanation")
+			return nil, fmt.Errorf("failed to record consensus explanation: %w", err)
 		}
+		c.recordConsensusAudit(pollingStationID, previousStatus, allSubmissions, resultGroups, result)
+		c.broadcastStatusRegression(pollingStationID, previousStatus, result.Status, logger)
+		c.broadcastTallyIfChanged(pollingStationID, previous, logger)
 
 		return result, nil
 	}
 
 	// Process consensus with majority-based verification
-	result := c.calculateMajorityConsensus(resultGroups, len(submissions), logger)
+	result := reopened
+	if result == nil {
+		result = c.calculateMajorityConsensus(resultGroups, len(submissions), c.minLocationClusters(pollingStationID), logger)
+		result = c.applyStabilityWindow(pollingStationID, previousStatus, result, submissions, logger)
+	}
+	result.Explanation = c.explainConsensus(result, resultGroups, len(submissions))
+	result.EffectiveThreshold = c.scaledMinAgreeingWallets(len(submissions))
 
 	// Update polling station status
-	err := c.storageService.UpdatePollingStationStatus(
+	err = c.storageService.UpdatePollingStationStatus(
 		pollingStationID,
 		result.Status,
 		result.VerifiedResults,
@@ -115,43 +243,246 @@ func (c *ConsensusService) ProcessConsensus(pollingStationID string) (*Consensus
 		logger.WithError(err).Error("Failed to update polling station status")
 		return nil, fmt.Errorf("failed to update polling station status: %w", err)
 	}
+	if result.Status == models.StationStatusVerified {
+		if err := c.storageService.SetPollingStationAverageConfidence(pollingStationID, result.AverageConfidence); err != nil {
+			logger.WithError(err).Error("Failed to record average submission confidence")
+			return nil, fmt.Errorf("failed to record average submission confidence: %w", err)
+		}
+		if err := c.storageService.SetPollingStationAgreementScore(pollingStationID, result.AgreementScore); err != nil {
+			logger.WithError(err).Error("Failed to record agreement score")
+			return nil, fmt.Errorf("failed to record agreement score: %w", err)
+		}
+	}
+	if err := c.storageService.SetPollingStationConsensusExplanation(pollingStationID, result.Explanation); err != nil {
+		logger.WithError(err).Error("Failed to record consensus explanation")
+		return nil, fmt.Errorf("failed to record consensus explanation: %w", err)
+	}
+
+	c.recordConsensusAudit(pollingStationID, previousStatus, allSubmissions, resultGroups, result)
 
 	logger.WithFields(logrus.Fields{
 		"status":           result.Status,
 		"confidence_level": result.ConfidenceLevel,
 	}).Info("Consensus processing completed")
 
-	// Trigger WebSocket broadcast if consensus status changed and WebSocket service is available
-	if c.webSocketService != nil {
-		// Get the voting process ID for this polling station
-		station, err := c.storageService.GetPollingStation(pollingStationID)
-		if err == nil && station.VotingProcessID != "" {
-			// Broadcast tally update for the voting process
-			broadcastErr := c.webSocketService.BroadcastTallyUpdate(station.VotingProcessID)
-			if broadcastErr != nil {
-				logger.WithError(broadcastErr).Error("Failed to broadcast tally update via WebSocket")
-				// Don't return error - consensus processing succeeded, broadcast failure is not critical
-			} else {
-				logger.WithField("voting_process_id", station.VotingProcessID).Info("WebSocket tally update broadcast triggered")
-			}
-		}
+	if result.Status == models.StationStatusVerified && previousStatus != models.StationStatusVerified {
+		c.notifyStationVerified(pollingStationID, result, logger)
+		c.autoCompleteVotingProcess(pollingStationID, logger)
+	}
+	c.broadcastStatusRegression(pollingStationID, previousStatus, result.Status, logger)
+	c.broadcastTallyIfChanged(pollingStationID, previous, logger)
+
+	return result, nil
+}
+
+// RecomputeConsensus re-evaluates a polling station after one of its submissions was flagged or removed.
+// Unlike ProcessConsensus it also accepts a station left without submissions, which returns to Pending.
+func (c *ConsensusService) RecomputeConsensus(pollingStationID string) (*ConsensusResult, error) {
+	unlock := c.lockStation(pollingStationID)
+	defer unlock()
+
+	if len(c.storageService.GetSubmissionsByStation(pollingStationID)) > 0 {
+		return c.processConsensus(pollingStationID)
+	}
+
+	logger := c.logger.WithFields(logrus.Fields{
+		"polling_station_id": pollingStationID,
+		"service":           "consensus",
+	})
+
+	station, err := c.storageService.GetPollingStation(pollingStationID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ConsensusResult{
+		Status:          models.StationStatusPending,
+		ConfidenceLevel: 0.0,
+		Message:         "No submissions remain - waiting for submissions",
+	}
+	result.Explanation = fmt.Sprintf("Received 0 submissions. Decision: %s - %s.", result.Status, result.Message)
+	result.EffectiveThreshold = c.scaledMinAgreeingWallets(0)
+
+	if err := c.storageService.UpdatePollingStationStatus(pollingStationID, result.Status, nil, 0); err != nil {
+		return nil, fmt.Errorf("failed to update polling station status: %w", err)
 	}
+	if err := c.storageService.SetPollingStationConsensusExplanation(pollingStationID, result.Explanation); err != nil {
+		return nil, fmt.Errorf("failed to record consensus explanation: %w", err)
+	}
+	c.recordConsensusAudit(pollingStationID, station.Status, nil, nil, result)
+	c.broadcastStatusRegression(pollingStationID, station.Status, result.Status, logger)
+	c.broadcastTallyIfChanged(pollingStationID, station, logger)
 
+	logger.Info("Polling station has no submissions left and returned to pending")
 	return result, nil
 }
 
-// GetConsensusStatus returns the current consensus status for a polling station
+// broadcastTallyIfChanged broadcasts a tally update for the station's voting process, unless the consensus run left
+// the station's stored status, verified results and confidence exactly as they were before it
+func (c *ConsensusService) broadcastTallyIfChanged(pollingStationID string, previous *models.PollingStation, logger *logrus.Entry) {
+	if c.webSocketService == nil {
+		return
+	}
+
+	station, err := c.storageService.GetPollingStation(pollingStationID)
+	if err != nil || station.VotingProcessID == "" {
+		return
+	}
+
+	// Agreeing submissions to an already verified station would otherwise re-send an identical tally each time
+	if previous != nil && previous.Status == station.Status && previous.ConfidenceLevel == station.ConfidenceLevel &&
+		maps.Equal(previous.VerifiedResults, station.VerifiedResults) {
+		c.skippedBroadcastCounter.Inc()
+		logger.WithField("status", station.Status).Debug("Polling station unchanged - skipping tally broadcast")
+		return
+	}
+
+	if err := c.webSocketService.BroadcastTallyUpdate(station.VotingProcessID); err != nil {
+		// Consensus processing succeeded, so a failed broadcast is logged rather than returned
+		logger.WithError(err).Error("Failed to broadcast tally update via WebSocket")
+		return
+	}
+	c.broadcastCounter.Inc()
+	logger.WithField("voting_process_id", station.VotingProcessID).Info("WebSocket tally update broadcast triggered")
+}
+
+// broadcastStatusRegression announces over WebSocket that a verified station lost its verification,
+// e.g. after submissions of its agreeing group were flagged or removed
+func (c *ConsensusService) broadcastStatusRegression(pollingStationID, previousStatus, status string, logger *logrus.Entry) {
+	if previousStatus != models.StationStatusVerified || status == models.StationStatusVerified {
+		return
+	}
+
+	logger.WithFields(logrus.Fields{
+		"previous_status": previousStatus,
+		"status":          status,
+	}).Warning("Polling station lost its verification")
+
+	if c.webSocketService == nil {
+		return
+	}
+
+	station, err := c.storageService.GetPollingStation(pollingStationID)
+	if err != nil {
+		return
+	}
+
+	if err := c.webSocketService.BroadcastMessage("station_status_changed", map[string]interface{}{
+		"votingProcessId":  station.VotingProcessID,
+		"pollingStationId": pollingStationID,
+		"previousStatus":   previousStatus,
+		"status":           status,
+	}); err != nil {
+		logger.WithError(err).Error("Failed to broadcast station status change via WebSocket")
+	}
+}
+
+// unflaggedSubmissions returns the submissions that have not been flagged as fraudulent
+func unflaggedSubmissions(submissions []models.Submission) []models.Submission {
+	active := make([]models.Submission, 0, len(submissions))
+	for _, submission := range submissions {
+		if !submission.Flagged {
+			active = append(active, submission)
+		}
+	}
+	return active
+}
+
+// explainConsensus describes, in plain language, the result groups a consensus run saw, the rules it applied
+// and why it reached its decision, so observers can follow how a station was verified or why it is pending
+func (c *ConsensusService) explainConsensus(result *ConsensusResult, resultGroups map[string]*SubmissionGroup, totalSubmissions int) string {
+	groupSizes := make([]int, 0, len(resultGroups))
+	uniqueWallets := 0
+	for _, group := range resultGroups {
+		groupSizes = append(groupSizes, group.WalletCount)
+		uniqueWallets += group.WalletCount
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(groupSizes)))
+
+	sizes := make([]string, len(groupSizes))
+	for i, size := range groupSizes {
+		sizes[i] = fmt.Sprintf("%d", size)
+	}
+
+	var explanation strings.Builder
+	fmt.Fprintf(&explanation, "Received %d submissions from %d unique wallets, forming %d distinct result groups", totalSubmissions, uniqueWallets, len(resultGroups))
+	if len(sizes) > 0 {
+		fmt.Fprintf(&explanation, " of %s wallets", strings.Join(sizes, ", "))
+	}
+	fmt.Fprintf(&explanation, ". Rules applied: a threshold of %d submissions, at least %d agreeing wallets, and a majority of more than %.0f%% of submissions (more than %.1f of %d).",
+		c.threshold, c.scaledMinAgreeingWallets(totalSubmissions), majorityPercentage, float64(totalSubmissions)*majorityPercentage/100, totalSubmissions)
+	fmt.Fprintf(&explanation, " Decision: %s - %s.", result.Status, result.Message)
+
+	return explanation.String()
+}
+
+// notifyStationVerified sends the verification webhook configured on the station's voting process, if any
+func (c *ConsensusService) notifyStationVerified(pollingStationID string, result *ConsensusResult, logger *logrus.Entry) {
+	if c.webhookService == nil {
+		return
+	}
+
+	station, err := c.storageService.GetPollingStation(pollingStationID)
+	if err != nil || station.VotingProcessID == "" {
+		return
+	}
+	process, err := c.storageService.GetVotingProcess(station.VotingProcessID)
+	if err != nil || process.WebhookURL == "" {
+		return
+	}
+
+	c.webhookService.NotifyStationVerified(process.WebhookURL, WebhookPayload{
+		VotingProcessID:  process.ID,
+		PollingStationID: pollingStationID,
+		Results:          result.VerifiedResults,
+		ConfidenceLevel:  result.ConfidenceLevel,
+	})
+	logger.WithField("voting_process_id", process.ID).Info("Station verification webhook queued")
+}
+
+// autoCompleteVotingProcess completes the station's voting process once its configured share of stations is verified
+func (c *ConsensusService) autoCompleteVotingProcess(pollingStationID string, logger *logrus.Entry) {
+	station, err := c.storageService.GetPollingStation(pollingStationID)
+	if err != nil || station.VotingProcessID == "" {
+		return
+	}
+	process, err := c.storageService.GetVotingProcess(station.VotingProcessID)
+	if err != nil || process.AutoCompleteFraction <= 0 {
+		return
+	}
+
+	completed, err := c.storageService.CompleteVotingProcessIfVerified(process.ID, process.AutoCompleteFraction)
+	if err != nil {
+		logger.WithError(err).Error("Failed to auto-complete voting process")
+		return
+	}
+	if completed {
+		logger.WithFields(logrus.Fields{
+			"voting_process_id":      process.ID,
+			"auto_complete_fraction": process.AutoCompleteFraction,
+		}).Info("Voting process completed automatically")
+	}
+}
+
+// GetConsensusStatus returns the current consensus status for a polling station, including the agreeing wallets
+// its result needs given the unflagged submissions it has received so far
 func (c *ConsensusService) GetConsensusStatus(pollingStationID string) (*ConsensusResult, error) {
 	station, err := c.storageService.GetPollingStation(pollingStationID)
 	if err != nil {
 		return nil, err
 	}
 
+	submissions := unflaggedSubmissions(c.storageService.GetSubmissionsByStation(pollingStationID))
+
 	result := &ConsensusResult{
-		Status:          station.Status,
-		VerifiedResults: station.VerifiedResults,
-		ConfidenceLevel: station.ConfidenceLevel,
-		Message:         fmt.Sprintf("Current status: %s", station.Status),
+		Status:             station.Status,
+		VerifiedResults:    station.VerifiedResults,
+		ConfidenceLevel:    station.ConfidenceLevel,
+		AverageConfidence:  station.AverageConfidence,
+		AgreementScore:     station.AgreementScore,
+		Message:            fmt.Sprintf("Current status: %s", station.Status),
+		Explanation:        station.ConsensusExplanation,
+		EffectiveThreshold: c.scaledMinAgreeingWallets(len(submissions)),
 	}
 
 	return result, nil
@@ -165,6 +496,158 @@ func (c *ConsensusService) SetConsensusThreshold(threshold int) {
 	}
 }
 
+// VerificationCriteria returns the verification rules that apply to the stations of a voting process
+func (c *ConsensusService) VerificationCriteria(process *models.VotingProcess) VerificationCriteria {
+	criteria := VerificationCriteria{
+		ConsensusThreshold:     c.threshold,
+		MinAgreeingWallets:     c.minAgreeingWallets(),
+		MajorityPercentage:     majorityPercentage,
+		ToleranceAbsolute:      c.toleranceAbsolute,
+		TolerancePercent:       c.tolerancePercent,
+		StabilityWindowSeconds: c.stabilityWindow.Seconds(),
+		ConfidenceTieBreak:     c.confidenceTieBreak,
+		ThresholdScaling:       c.thresholdScaling,
+	}
+
+	// The default recompute policy is left out so existing criteria reports are unchanged
+	if c.postVerificationPolicy != PostVerificationRecompute {
+		criteria.PostVerificationPolicy = c.postVerificationPolicy
+	}
+
+	if process != nil {
+		criteria.RejectEmptyTally = process.RejectEmptyTally
+		criteria.SubmissionTypes = process.SubmissionTypes
+		criteria.MaxVotes = process.MaxVotes
+		if process.MinLocationClusters > 1 {
+			criteria.MinLocationClusters = process.MinLocationClusters
+			criteria.ClusterRadiusMeters = c.clusterRadiusMeters
+		}
+	}
+
+	return criteria
+}
+
+// SetMinAgreeingWallets sets how many wallets must agree on a result before it can verify, independently of
+// the total submission threshold. Passing zero restores the default of requiring threshold agreeing wallets.
+func (c *ConsensusService) SetMinAgreeingWallets(minAgreeing int) {
+	if minAgreeing < 0 {
+		return
+	}
+
+	c.minAgreeing = minAgreeing
+	c.logger.WithField("min_agreeing_wallets", minAgreeing).Info("Minimum agreeing wallets updated")
+}
+
+// minAgreeingWallets returns the number of wallets the leading result group needs before it can verify
+func (c *ConsensusService) minAgreeingWallets() int {
+	if c.minAgreeing > 0 {
+		return c.minAgreeing
+	}
+	return c.threshold
+}
+
+// SetThresholdScaling makes busier stations require proportionally more agreement: the leading group needs at
+// least max(minimum agreeing wallets, ceil(fraction * submissions)) wallets. Zero disables scaling.
+func (c *ConsensusService) SetThresholdScaling(fraction float64) {
+	if fraction < 0 || fraction > 1 {
+		return
+	}
+
+	c.thresholdScaling = fraction
+	c.logger.WithField("threshold_scaling", fraction).Info("Consensus threshold scaling updated")
+}
+
+// scaledMinAgreeingWallets returns the agreeing wallets a station with the given number of submissions needs,
+// scaling the fixed minimum up with submission volume when threshold scaling is enabled
+func (c *ConsensusService) scaledMinAgreeingWallets(totalSubmissions int) int {
+	minAgreeing := c.minAgreeingWallets()
+	if c.thresholdScaling <= 0 {
+		return minAgreeing
+	}
+	return max(minAgreeing, int(math.Ceil(c.thresholdScaling*float64(totalSubmissions))))
+}
+
+// SetConfidenceTieBreak sets whether a tie between the largest result groups is broken in favour of the group
+// with the highest average submission confidence. When disabled, or when confidences also tie, the station stays pending.
+func (c *ConsensusService) SetConfidenceTieBreak(enabled bool) {
+	c.confidenceTieBreak = enabled
+	c.logger.WithField("confidence_tie_break", enabled).Info("Confidence tie-break policy updated")
+}
+
+// SetClusterRadius sets how close, in meters, two witnesses must be to count as the same location
+func (c *ConsensusService) SetClusterRadius(radiusMeters float64) {
+	if radiusMeters <= 0 {
+		return
+	}
+
+	c.clusterRadiusMeters = radiusMeters
+	c.logger.WithField("cluster_radius_meters", radiusMeters).Info("Location cluster radius updated")
+}
+
+// minLocationClusters returns the geographic spread required by the station's voting process, or 0 when none is required
+func (c *ConsensusService) minLocationClusters(pollingStationID string) int {
+	station, err := c.storageService.GetPollingStation(pollingStationID)
+	if err != nil || station.VotingProcessID == "" {
+		return 0
+	}
+	process, err := c.storageService.GetVotingProcess(station.VotingProcessID)
+	if err != nil {
+		return 0
+	}
+	return process.MinLocationClusters
+}
+
+// SetTolerance enables tolerance mode, grouping submissions whose per-candidate counts differ by at most
+// the absolute delta or the percentage delta. Passing zero for both restores exact matching.
+func (c *ConsensusService) SetTolerance(absolute int, percent float64) {
+	if absolute < 0 || percent < 0 {
+		return
+	}
+
+	c.toleranceAbsolute = absolute
+	c.tolerancePercent = percent
+	c.logger.WithFields(logrus.Fields{
+		"tolerance_absolute": absolute,
+		"tolerance_percent":  percent,
+	}).Info("Consensus tolerance updated")
+}
+
+// SetConfidencePrecision sets the number of decimal places confidence levels are quantized to
+func (c *ConsensusService) SetConfidencePrecision(precision int) {
+	if precision < 0 || precision > MaxConfidencePrecision {
+		return
+	}
+
+	c.confidencePrecision = precision
+	c.logger.WithField("confidence_precision", precision).Info("Confidence precision updated")
+}
+
+// toleranceEnabled reports whether near-identical results are grouped together
+func (c *ConsensusService) toleranceEnabled() bool {
+	return c.toleranceAbsolute > 0 || c.tolerancePercent > 0
+}
+
+// GetLeadingGroup returns the result group with the most unique wallets for a polling station, or nil if it has no submissions
+func (c *ConsensusService) GetLeadingGroup(pollingStationID string) *SubmissionGroup {
+	submissions := unflaggedSubmissions(c.storageService.GetSubmissionsByStation(pollingStationID))
+	if len(submissions) == 0 {
+		return nil
+	}
+
+	var leadingGroup *SubmissionGroup
+	leadingKey := ""
+	for key, group := range c.groupSubmissionsByResults(submissions) {
+		// Break ties on the result key so the leader is stable between calls
+		if leadingGroup == nil || group.WalletCount > leadingGroup.WalletCount ||
+			(group.WalletCount == leadingGroup.WalletCount && key < leadingKey) {
+			leadingGroup = group
+			leadingKey = key
+		}
+	}
+
+	return leadingGroup
+}
+
 // SubmissionGroup represents a group of submissions with identical results
 type SubmissionGroup struct {
 	Results     map[string]int     `json:"results"`
@@ -172,8 +655,13 @@ type SubmissionGroup struct {
 	WalletCount int                `json:"walletCount"`
 }
 
-// groupSubmissionsByResults groups submissions by identical results and enforces wallet uniqueness
+// groupSubmissionsByResults groups submissions by identical results and enforces wallet uniqueness.
+// In tolerance mode, near-identical results are grouped together and each group's results are the per-candidate median.
 func (c *ConsensusService) groupSubmissionsByResults(submissions []models.Submission) map[string]*SubmissionGroup {
+	if c.toleranceEnabled() {
+		return c.groupSubmissionsWithinTolerance(submissions)
+	}
+
 	groups := make(map[string]*SubmissionGroup)
 	walletTracker := make(map[string]map[string]bool) // resultKey -> walletAddress -> bool
 
@@ -208,36 +696,120 @@ func (c *ConsensusService) groupSubmissionsByResults(submissions []models.Submis
 	return groups
 }
 
+// groupSubmissionsWithinTolerance groups submissions whose results are within tolerance of a group's first submission.
+// Comparing against the first submission rather than the running median keeps groups from drifting apart.
+func (c *ConsensusService) groupSubmissionsWithinTolerance(submissions []models.Submission) map[string]*SubmissionGroup {
+	groups := make(map[string]*SubmissionGroup)
+	anchors := []string{} // Group keys in creation order, so a submission joins the earliest matching group
+	anchorResults := make(map[string]map[string]int)
+	walletTracker := make(map[string]map[string]bool) // resultKey -> walletAddress -> bool
+
+	for _, submission := range submissions {
+		resultKey := ""
+		for _, anchor := range anchors {
+			if c.areResultsWithinTolerance(anchorResults[anchor], submission.Results) {
+				resultKey = anchor
+				break
+			}
+		}
+
+		// Start a new group anchored on this submission
+		if resultKey == "" {
+			resultKey = c.createResultKey(submission.Results)
+			if _, exists := groups[resultKey]; !exists {
+				groups[resultKey] = &SubmissionGroup{
+					Submissions: []models.Submission{},
+				}
+				anchors = append(anchors, resultKey)
+				anchorResults[resultKey] = submission.Results
+				walletTracker[resultKey] = make(map[string]bool)
+			}
+		}
+
+		// Check wallet uniqueness for this result group
+		if !walletTracker[resultKey][submission.WalletAddress] {
+			groups[resultKey].Submissions = append(groups[resultKey].Submissions, submission)
+			groups[resultKey].WalletCount++
+			walletTracker[resultKey][submission.WalletAddress] = true
+		}
+	}
+
+	for _, group := range groups {
+		group.Results = medianResults(group.Submissions)
+	}
+
+	return groups
+}
+
+// areResultsWithinTolerance reports whether two results maps have the same keys and every count is within tolerance
+func (c *ConsensusService) areResultsWithinTolerance(results1, results2 map[string]int) bool {
+	if len(results1) != len(results2) {
+		return false
+	}
+
+	for candidate, votes1 := range results1 {
+		votes2, exists := results2[candidate]
+		if !exists {
+			return false
+		}
+
+		delta := votes1 - votes2
+		if delta < 0 {
+			delta = -delta
+		}
+		if delta <= c.toleranceAbsolute {
+			continue
+		}
+
+		larger := votes1
+		if votes2 > larger {
+			larger = votes2
+		}
+		if float64(delta) > float64(larger)*c.tolerancePercent/100 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// medianResults returns the per-candidate median of a group's results, using the lower median for even counts
+func medianResults(submissions []models.Submission) map[string]int {
+	votesByCandidate := make(map[string][]int)
+	for _, submission := range submissions {
+		for candidate, votes := range submission.Results {
+			votesByCandidate[candidate] = append(votesByCandidate[candidate], votes)
+		}
+	}
+
+	results := make(map[string]int, len(votesByCandidate))
+	for candidate, votes := range votesByCandidate {
+		sort.Ints(votes)
+		results[candidate] = votes[(len(votes)-1)/2]
+	}
+	return results
+}
+
 // createResultKey creates a consistent string key for a results map
 func (c *ConsensusService) createResultKey(results map[string]int) string {
-	// Create a deterministic string representation of the results map
-	// We'll use a simple format: "candidate1:votes1,candidate2:votes2" (sorted by candidate name)
-	key := ""
-	
-	// Get all candidate names and sort them for consistency
+	return canonicalResultKey(results)
+}
+
+// canonicalResultKey encodes a results map as a deterministic, collision-free key.
+// Candidates are sorted and each entry is written as "<name length>:<name>=<votes>;", so the length prefix
+// delimits the name and candidate names containing ':', ',', '=' or ';' cannot be confused with separators.
+func canonicalResultKey(results map[string]int) string {
 	candidates := make([]string, 0, len(results))
 	for candidate := range results {
 		candidates = append(candidates, candidate)
 	}
-	
-	// Simple sort (bubble sort for small arrays)
-	for i := 0; i < len(candidates)-1; i++ {
-		for j := 0; j < len(candidates)-i-1; j++ {
-			if candidates[j] > candidates[j+1] {
-				candidates[j], candidates[j+1] = candidates[j+1], candidates[j]
-			}
-		}
-	}
-	
-	// Build the key
-	for i, candidate := range candidates {
-		if i > 0 {
-			key += ","
-		}
-		key += fmt.Sprintf("%s:%d", candidate, results[candidate])
+	sort.Strings(candidates)
+
+	var key strings.Builder
+	for _, candidate := range candidates {
+		fmt.Fprintf(&key, "%d:%s=%d;", len(candidate), candidate, results[candidate])
 	}
-	
-	return key
+	return key.String()
 }
 
 // areResultsIdentical compares two results maps for exact equality
@@ -245,16 +817,114 @@ func (c *ConsensusService) areResultsIdentical(results1, results2 map[string]int
 	return reflect.DeepEqual(results1, results2)
 }
 
-// calculateMajorityConsensus implements the majority-based verification algorithm
-func (c *ConsensusService) calculateMajorityConsensus(resultGroups map[string]*SubmissionGroup, totalSubmissions int, logger *logrus.Entry) *ConsensusResult {
+// checkLocationSpread returns a pending result when the group's witnesses come from fewer than minClusters
+// distinct locations, and nil when the group is spread out enough or the check is disabled
+func (c *ConsensusService) checkLocationSpread(group *SubmissionGroup, minClusters int, logger *logrus.Entry) *ConsensusResult {
+	if minClusters <= 1 {
+		return nil
+	}
+
+	clusters := countLocationClusters(group.Submissions, c.clusterRadiusMeters)
+	if clusters >= minClusters {
+		return nil
+	}
+
+	c.pendingCounter.Inc()
+	logger.WithFields(logrus.Fields{
+		"location_clusters":     clusters,
+		"min_location_clusters": minClusters,
+	}).Info("Majority reached but witnesses lack geographic spread")
+	return &ConsensusResult{
+		Status:          models.StationStatusPending,
+		ConfidenceLevel: 0.0,
+		Message:         fmt.Sprintf("Majority group comes from %d distinct locations (minimum: %d)", clusters, minClusters),
+	}
+}
+
+// verifiedResult records and returns a verified consensus result for the given group
+func (c *ConsensusService) verifiedResult(group *SubmissionGroup, totalSubmissions, totalWallets int, logger *logrus.Entry, message string) *ConsensusResult {
+	c.verifiedCounter.Inc()
+	confidenceLevel := c.calculateConfidenceLevel(group, totalSubmissions)
+
+	logger.WithFields(logrus.Fields{
+		"verified_results":  group.Results,
+		"confidence_level":  confidenceLevel,
+		"consensus_wallets": group.WalletCount,
+	}).Info("Consensus reached - results verified")
+
+	return &ConsensusResult{
+		Status:            models.StationStatusVerified,
+		VerifiedResults:   group.Results,
+		ConfidenceLevel:   confidenceLevel,
+		AverageConfidence: c.averageSubmissionConfidence(group.Submissions),
+		AgreementScore:    c.agreementScore(group, totalWallets),
+		Message:           message,
+	}
+}
+
+// agreementScore returns the share of a station's distinct witness wallets that belong to the agreeing group.
+// A low score marks a contested station even when it verified.
+func (c *ConsensusService) agreementScore(group *SubmissionGroup, totalWallets int) float64 {
+	if totalWallets == 0 {
+		return 0
+	}
+	return QuantizeConfidence(float64(group.WalletCount)/float64(totalWallets), c.confidencePrecision)
+}
+
+// distinctWallets counts the different wallets that submitted to a station across all of its result groups
+func distinctWallets(resultGroups map[string]*SubmissionGroup) int {
+	wallets := make(map[string]bool)
+	for _, group := range resultGroups {
+		for _, submission := range group.Submissions {
+			wallets[submission.WalletAddress] = true
+		}
+	}
+	return len(wallets)
+}
+
+// breakTieByConfidence returns the group with the highest average submission confidence among those with
+// walletCount wallets, along with that confidence. It returns nil when the highest confidence is itself tied.
+func (c *ConsensusService) breakTieByConfidence(resultGroups map[string]*SubmissionGroup, walletCount int) (*SubmissionGroup, float64) {
+	var winner *SubmissionGroup
+	bestConfidence := -1.0
+	tied := false
+
+	for _, group := range resultGroups {
+		if group.WalletCount != walletCount {
+			continue
+		}
+
+		confidence := c.averageSubmissionConfidence(group.Submissions)
+		if confidence > bestConfidence {
+			winner = group
+			bestConfidence = confidence
+			tied = false
+		} else if confidence == bestConfidence {
+			tied = true
+		}
+	}
+
+	if tied {
+		return nil, 0
+	}
+	return winner, bestConfidence
+}
+
+// calculateMajorityConsensus implements the majority-based verification algorithm.
+// minClusters is the number of distinct GPS locations the majority group's witnesses must come from; 0 or 1 disables the check.
+func (c *ConsensusService) calculateMajorityConsensus(resultGroups map[string]*SubmissionGroup, totalSubmissions int, minClusters int, logger *logrus.Entry) *ConsensusResult {
 	var largestGroup *SubmissionGroup
 	maxWalletCount := 0
+	tiedGroups := 0
 
 	// Find the group with the most unique wallet addresses
 	for _, group := range resultGroups {
 		if group.WalletCount > maxWalletCount {
 			maxWalletCount = group.WalletCount
 			largestGroup = group
+			tiedGroups = 1
+		} else if group.WalletCount == maxWalletCount {
+			tiedGroups++
 		}
 	}
 
@@ -264,38 +934,56 @@ func (c *ConsensusService) calculateMajorityConsensus(resultGroups map[string]*S
 		"result_groups_count":   len(resultGroups),
 	}).Info("Analyzing consensus groups")
 
-	// Check if the largest group meets the minimum threshold
-	if maxWalletCount < c.threshold {
+	// Check if the largest group has enough agreeing wallets, regardless of its share of submissions
+	if minAgreeing := c.scaledMinAgreeingWallets(totalSubmissions); maxWalletCount < minAgreeing {
+		c.pendingCounter.Inc()
 		return &ConsensusResult{
-			Status:          "Pending",
+			Status:          models.StationStatusPending,
 			ConfidenceLevel: 0.0,
-			Message:         fmt.Sprintf("Largest consensus group has %d wallets (threshold: %d)", maxWalletCount, c.threshold),
+			Message:         fmt.Sprintf("Largest consensus group has %d wallets (minimum agreeing: %d)", maxWalletCount, minAgreeing),
 		}
 	}
 
 	// Check if the largest group constitutes a majority (>50% of submissions)
-	majorityThreshold := float64(totalSubmissions) * 0.5
+	majorityThreshold := float64(totalSubmissions) * majorityPercentage / 100
 	if float64(maxWalletCount) > majorityThreshold {
+		// Resist a single location flooding submissions by requiring agreeing witnesses to be spread out
+		if pending := c.checkLocationSpread(largestGroup, minClusters, logger); pending != nil {
+			return pending
+		}
+
 		// We have consensus!
-		confidenceLevel := c.calculateConfidenceLevel(largestGroup, totalSubmissions)
-		
-		logger.WithFields(logrus.Fields{
-			"verified_results":   largestGroup.Results,
-			"confidence_level":   confidenceLevel,
-			"consensus_wallets":  maxWalletCount,
-		}).Info("Consensus reached - results verified")
+		return c.verifiedResult(largestGroup, totalSubmissions, distinctWallets(resultGroups), logger,
+			fmt.Sprintf("Consensus reached with %d wallets (%.1f%% of submissions)", maxWalletCount, float64(maxWalletCount)/float64(totalSubmissions)*100))
+	}
+
+	// Several result groups share the largest wallet count
+	if tiedGroups > 1 {
+		c.tieCounter.Inc()
+
+		// Optionally let the most confident of the tied groups win rather than waiting indefinitely
+		if c.confidenceTieBreak {
+			if winner, confidence := c.breakTieByConfidence(resultGroups, maxWalletCount); winner != nil {
+				if pending := c.checkLocationSpread(winner, minClusters, logger); pending != nil {
+					return pending
+				}
+				logger.WithField("average_confidence", confidence).Info("Tie broken by average submission confidence")
+				return c.verifiedResult(winner, totalSubmissions, distinctWallets(resultGroups), logger,
+					fmt.Sprintf("%d result groups tied with %d wallets each - tie broken by average submission confidence (%.2f)", tiedGroups, maxWalletCount, confidence))
+			}
+		}
 
 		return &ConsensusResult{
-			Status:          "Verified",
-			VerifiedResults: largestGroup.Results,
-			ConfidenceLevel: confidenceLevel,
-			Message:         fmt.Sprintf("Consensus reached with %d wallets (%.1f%% of submissions)", maxWalletCount, float64(maxWalletCount)/float64(totalSubmissions)*100),
+			Status:          models.StationStatusPending,
+			ConfidenceLevel: 0.0,
+			Message:         fmt.Sprintf("No majority consensus - %d result groups tied with %d wallets each", tiedGroups, maxWalletCount),
 		}
 	}
 
 	// No majority consensus
+	c.pendingCounter.Inc()
 	return &ConsensusResult{
-		Status:          "Pending",
+		Status:          models.StationStatusPending,
 		ConfidenceLevel: 0.0,
 		Message:         fmt.Sprintf("No majority consensus - largest group: %d wallets (%.1f%% of %d submissions)", maxWalletCount, float64(maxWalletCount)/float64(totalSubmissions)*100, totalSubmissions),
 	}
@@ -321,5 +1009,32 @@ func (c *ConsensusService) calculateConfidenceLevel(group *SubmissionGroup, tota
 		baseConfidence = 1.0
 	}
 	
-	return baseConfidence
+	return QuantizeConfidence(baseConfidence, c.confidencePrecision)
+}
+
+// averageSubmissionConfidence returns the mean client-reported confidence of a group's submissions.
+// Unlike the confidence level, which reflects how many wallets agree, it reflects how sure the OCR/STT readings were.
+func (c *ConsensusService) averageSubmissionConfidence(submissions []models.Submission) float64 {
+	if len(submissions) == 0 {
+		return 0
+	}
+
+	total := 0.0
+	for _, submission := range submissions {
+		total += submission.Confidence
+	}
+	return QuantizeConfidence(total/float64(len(submissions)), c.confidencePrecision)
+}
+
+// Confidence quantization bounds
+const (
+	DefaultConfidencePrecision = 4
+	MaxConfidencePrecision     = 10
+)
+
+// QuantizeConfidence rounds a confidence level to the given number of decimal places so that
+// recomputing it from the same inputs always yields a bit-identical value
+func QuantizeConfidence(confidence float64, precision int) float64 {
+	scale := math.Pow10(precision)
+	return math.Round(confidence*scale) / scale
 }
\ No newline at end of file
@@ -0,0 +1,79 @@
+package services
+
+import (
+	"maps"
+	"sort"
+
+	"oyah-backend/internal/models"
+)
+
+// Adjudication is the full submission set behind a polling station's result, grouped the way consensus grouped it,
+// so an official reviewing a challenge can see every competing result and the witnesses who reported it
+type Adjudication struct {
+	PollingStationID   string                   `json:"pollingStationId"`
+	VotingProcessID    string                   `json:"votingProcessId,omitempty"`
+	Status             string                   `json:"status"`
+	VerifiedResults    map[string]int           `json:"verifiedResults,omitempty"`
+	Challenge          *models.StationChallenge `json:"challenge,omitempty"`
+	TotalSubmissions   int                      `json:"totalSubmissions"`   // Unflagged submissions counted towards consensus
+	Groups             []AdjudicationGroup      `json:"groups"`             // Largest group first
+	FlaggedSubmissions []models.Submission      `json:"flaggedSubmissions"` // Excluded from consensus, listed for completeness
+}
+
+// AdjudicationGroup is one result reported for a station and the submissions that reported it
+type AdjudicationGroup struct {
+	Results     map[string]int      `json:"results"`
+	WalletCount int                 `json:"walletCount"`
+	Verified    bool                `json:"verified"` // The group whose result the station was verified with
+	Submissions []models.Submission `json:"submissions"`
+}
+
+// GetAdjudication returns every submission to a polling station, grouped by result with the verified group marked
+func (c *ConsensusService) GetAdjudication(pollingStationID string) (*Adjudication, error) {
+	station, err := c.storageService.GetPollingStation(pollingStationID)
+	if err != nil {
+		return nil, err
+	}
+
+	submissions := c.storageService.GetSubmissionsByStation(pollingStationID)
+	active := unflaggedSubmissions(submissions)
+
+	adjudication := &Adjudication{
+		PollingStationID:   pollingStationID,
+		VotingProcessID:    station.VotingProcessID,
+		Status:             station.Status,
+		VerifiedResults:    station.VerifiedResults,
+		Challenge:          station.Challenge,
+		TotalSubmissions:   len(active),
+		Groups:             []AdjudicationGroup{},
+		FlaggedSubmissions: []models.Submission{},
+	}
+
+	for _, submission := range submissions {
+		if submission.Flagged {
+			adjudication.FlaggedSubmissions = append(adjudication.FlaggedSubmissions, submission)
+		}
+	}
+
+	for _, group := range c.groupSubmissionsByResults(active) {
+		sort.Slice(group.Submissions, func(i, j int) bool {
+			return group.Submissions[i].ProcessedAt.Before(group.Submissions[j].ProcessedAt)
+		})
+		adjudication.Groups = append(adjudication.Groups, AdjudicationGroup{
+			Results:     group.Results,
+			WalletCount: group.WalletCount,
+			Verified:    station.VerifiedResults != nil && maps.Equal(group.Results, station.VerifiedResults),
+			Submissions: group.Submissions,
+		})
+	}
+
+	// Largest group first, breaking ties on the result key so the order is stable between calls
+	sort.Slice(adjudication.Groups, func(i, j int) bool {
+		if adjudication.Groups[i].WalletCount != adjudication.Groups[j].WalletCount {
+			return adjudication.Groups[i].WalletCount > adjudication.Groups[j].WalletCount
+		}
+		return canonicalResultKey(adjudication.Groups[i].Results) < canonicalResultKey(adjudication.Groups[j].Results)
+	})
+
+	return adjudication, nil
+}
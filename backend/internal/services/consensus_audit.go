@@ -0,0 +1,135 @@
+package services
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"oyah-backend/internal/models"
+)
+
+// ConsensusAuditInput is one submission as seen by a consensus evaluation
+type ConsensusAuditInput struct {
+	SubmissionID   string            `json:"submissionId"`
+	WalletAddress  string            `json:"walletAddress"`
+	SubmissionType string            `json:"submissionType"`
+	Confidence     float64           `json:"confidence"`
+	Results        map[string]int    `json:"results"`
+	Flagged        bool              `json:"flagged,omitempty"` // Excluded from the evaluation
+	Tags           map[string]string `json:"tags,omitempty"`
+}
+
+// ConsensusAuditGroup is one result group of a consensus evaluation
+type ConsensusAuditGroup struct {
+	Results       map[string]int `json:"results"`
+	WalletCount   int            `json:"walletCount"`
+	SubmissionIDs []string       `json:"submissionIds"`
+}
+
+// ConsensusAuditRecord is a single consensus decision with everything needed to re-check it after the election
+type ConsensusAuditRecord struct {
+	Timestamp        time.Time             `json:"timestamp"`
+	PollingStationID string                `json:"pollingStationId"`
+	VotingProcessID  string                `json:"votingProcessId,omitempty"`
+	PreviousStatus   string                `json:"previousStatus,omitempty"`
+	Inputs           []ConsensusAuditInput `json:"inputs"`
+	Groups           []ConsensusAuditGroup `json:"groups"` // Largest group first
+	Decision         ConsensusResult       `json:"decision"`
+	Config           VerificationCriteria  `json:"config"`
+}
+
+// ConsensusAuditLogger appends every consensus decision as a JSON line to a dedicated sink, such as a file
+// kept for post-election audits. It writes regardless of the application log level.
+type ConsensusAuditLogger struct {
+	encoder *json.Encoder
+	logger  *logrus.Logger
+	mutex   sync.Mutex
+}
+
+// NewConsensusAuditLogger creates a consensus audit logger writing to the given sink
+func NewConsensusAuditLogger(sink io.Writer, logger *logrus.Logger) *ConsensusAuditLogger {
+	return &ConsensusAuditLogger{
+		encoder: json.NewEncoder(sink),
+		logger:  logger,
+	}
+}
+
+// Record appends a consensus decision to the audit sink.
+// A failing sink is reported in the application log but never fails consensus processing.
+func (a *ConsensusAuditLogger) Record(record ConsensusAuditRecord) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if err := a.encoder.Encode(record); err != nil {
+		a.logger.WithError(err).WithFields(logrus.Fields{
+			"polling_station_id": record.PollingStationID,
+			"service":            "consensus_audit",
+		}).Error("Failed to write consensus audit record")
+	}
+}
+
+// SetAuditLogger sets the sink every consensus decision is recorded to; nil disables consensus audit logging
+func (c *ConsensusService) SetAuditLogger(auditLogger *ConsensusAuditLogger) {
+	c.auditLogger = auditLogger
+}
+
+// recordConsensusAudit writes a consensus decision, its inputs and the rules in force to the audit sink, if configured
+func (c *ConsensusService) recordConsensusAudit(pollingStationID, previousStatus string, submissions []models.Submission, resultGroups map[string]*SubmissionGroup, result *ConsensusResult) {
+	if c.auditLogger == nil {
+		return
+	}
+
+	record := ConsensusAuditRecord{
+		Timestamp:        time.Now(),
+		PollingStationID: pollingStationID,
+		PreviousStatus:   previousStatus,
+		Inputs:           make([]ConsensusAuditInput, 0, len(submissions)),
+		Groups:           make([]ConsensusAuditGroup, 0, len(resultGroups)),
+		Decision:         *result,
+	}
+
+	var process *models.VotingProcess
+	if station, err := c.storageService.GetPollingStation(pollingStationID); err == nil {
+		record.VotingProcessID = station.VotingProcessID
+		if station.VotingProcessID != "" {
+			process, _ = c.storageService.GetVotingProcess(station.VotingProcessID)
+		}
+	}
+	record.Config = c.VerificationCriteria(process)
+
+	for _, submission := range submissions {
+		record.Inputs = append(record.Inputs, ConsensusAuditInput{
+			SubmissionID:   submission.ID,
+			WalletAddress:  submission.WalletAddress,
+			SubmissionType: submission.SubmissionType,
+			Confidence:     submission.Confidence,
+			Results:        submission.Results,
+			Flagged:        submission.Flagged,
+			Tags:           submission.Tags,
+		})
+	}
+
+	for _, group := range resultGroups {
+		auditGroup := ConsensusAuditGroup{
+			Results:       group.Results,
+			WalletCount:   group.WalletCount,
+			SubmissionIDs: make([]string, 0, len(group.Submissions)),
+		}
+		for _, submission := range group.Submissions {
+			auditGroup.SubmissionIDs = append(auditGroup.SubmissionIDs, submission.ID)
+		}
+		record.Groups = append(record.Groups, auditGroup)
+	}
+	sort.Slice(record.Groups, func(i, j int) bool {
+		if record.Groups[i].WalletCount != record.Groups[j].WalletCount {
+			return record.Groups[i].WalletCount > record.Groups[j].WalletCount
+		}
+		return canonicalResultKey(record.Groups[i].Results) < canonicalResultKey(record.Groups[j].Results)
+	})
+
+	c.auditLogger.Record(record)
+}
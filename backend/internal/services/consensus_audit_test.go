@@ -0,0 +1,123 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"oyah-backend/internal/models"
+)
+
+func TestConsensusAuditLogger_RecordsVerificationDecision(t *testing.T) {
+	consensusService, storageService := setupConsensusTest()
+
+	// The audit sink is written even though the application logger only logs panics
+	var sink bytes.Buffer
+	consensusService.SetAuditLogger(NewConsensusAuditLogger(&sink, logrus.New()))
+
+	majority := map[string]int{"Candidate A": 120, "Candidate B": 80}
+	minority := map[string]int{"Candidate A": 90, "Candidate B": 110}
+	for i, results := range []map[string]int{majority, majority, majority, minority} {
+		submission := models.Submission{
+			ID:               fmt.Sprintf("audit-%d", i),
+			WalletAddress:    fmt.Sprintf("audit-wallet-%d", i),
+			PollingStationID: "AUDIT_STATION",
+			Timestamp:        time.Now(),
+			Results:          results,
+			SubmissionType:   "image_ocr",
+			Confidence:       0.9,
+		}
+		if err := storageService.StoreSubmission(submission); err != nil {
+			t.Fatalf("StoreSubmission() error = %v", err)
+		}
+	}
+
+	if _, err := consensusService.ProcessConsensus("AUDIT_STATION"); err != nil {
+		t.Fatalf("ProcessConsensus() error = %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(sink.String()), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("Expected 1 audit record, got %d: %q", len(lines), sink.String())
+	}
+
+	var record ConsensusAuditRecord
+	if err := json.Unmarshal([]byte(lines[0]), &record); err != nil {
+		t.Fatalf("Audit record is not valid JSON: %v", err)
+	}
+
+	if record.PollingStationID != "AUDIT_STATION" {
+		t.Errorf("Expected polling station AUDIT_STATION, got %q", record.PollingStationID)
+	}
+	if record.Decision.Status != "Verified" {
+		t.Errorf("Expected Verified decision, got %q", record.Decision.Status)
+	}
+	if !reflect.DeepEqual(record.Decision.VerifiedResults, majority) {
+		t.Errorf("Expected verified results %v, got %v", majority, record.Decision.VerifiedResults)
+	}
+	if len(record.Inputs) != 4 {
+		t.Errorf("Expected 4 inputs, got %d", len(record.Inputs))
+	}
+	if record.Config.ConsensusThreshold != 3 {
+		t.Errorf("Expected consensus threshold 3 in config, got %d", record.Config.ConsensusThreshold)
+	}
+
+	if len(record.Groups) != 2 {
+		t.Fatalf("Expected 2 result groups, got %d", len(record.Groups))
+	}
+	if record.Groups[0].WalletCount != 3 || !reflect.DeepEqual(record.Groups[0].Results, majority) {
+		t.Errorf("Expected the majority group of 3 wallets first, got %+v", record.Groups[0])
+	}
+	if record.Groups[1].WalletCount != 1 || !reflect.DeepEqual(record.Groups[1].SubmissionIDs, []string{"audit-3"}) {
+		t.Errorf("Expected the minority group with submission audit-3, got %+v", record.Groups[1])
+	}
+}
+
+func TestConsensusAuditLogger_RecordsEveryEvaluation(t *testing.T) {
+	consensusService, storageService := setupConsensusTest()
+
+	var sink bytes.Buffer
+	consensusService.SetAuditLogger(NewConsensusAuditLogger(&sink, logrus.New()))
+
+	for i := 0; i < 3; i++ {
+		submission := models.Submission{
+			ID:               fmt.Sprintf("audit-pending-%d", i),
+			WalletAddress:    fmt.Sprintf("audit-pending-wallet-%d", i),
+			PollingStationID: "AUDIT_PENDING_STATION",
+			Timestamp:        time.Now(),
+			Results:          map[string]int{"Candidate A": 100, "Candidate B": 50},
+			SubmissionType:   "audio_stt",
+			Confidence:       0.8,
+		}
+		if err := storageService.StoreSubmission(submission); err != nil {
+			t.Fatalf("StoreSubmission() error = %v", err)
+		}
+		if _, err := consensusService.ProcessConsensus("AUDIT_PENDING_STATION"); err != nil {
+			t.Fatalf("ProcessConsensus() error = %v", err)
+		}
+	}
+
+	lines := strings.Split(strings.TrimSpace(sink.String()), "\n")
+	expectedStatuses := []string{"Pending", "Pending", "Verified"}
+	if len(lines) != len(expectedStatuses) {
+		t.Fatalf("Expected %d audit records, got %d", len(expectedStatuses), len(lines))
+	}
+	for i, line := range lines {
+		var record ConsensusAuditRecord
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatalf("Audit record %d is not valid JSON: %v", i, err)
+		}
+		if record.Decision.Status != expectedStatuses[i] {
+			t.Errorf("Record %d: expected %s decision, got %s", i, expectedStatuses[i], record.Decision.Status)
+		}
+		if len(record.Inputs) != i+1 {
+			t.Errorf("Record %d: expected %d inputs, got %d", i, i+1, len(record.Inputs))
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package services
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// ConsensusWorkerPool processes consensus jobs in the background with a fixed number of workers.
+// Results reach clients through the WebSocket broadcast triggered by ProcessConsensus.
+type ConsensusWorkerPool struct {
+	consensusService *ConsensusService
+	recoveryService  *ConsensusRecoveryService
+	logger           *logrus.Logger
+	workers          int
+	jobs             chan string
+
+	// Stations waiting in the queue, with the ID of the request that queued them; a station is only
+	// queued once until a worker picks it up. ProcessConsensus serializes runs for the same station.
+	queued map[string]string
+	mutex  sync.Mutex
+
+	inFlight sync.WaitGroup
+	started  bool
+}
+
+// NewConsensusWorkerPool creates a new consensus worker pool
+func NewConsensusWorkerPool(consensus *ConsensusService, recovery *ConsensusRecoveryService, logger *logrus.Logger, workers int, queueSize int) *ConsensusWorkerPool {
+	if workers <= 0 {
+		workers = 1
+	}
+	if queueSize <= 0 {
+		queueSize = 1
+	}
+
+	return &ConsensusWorkerPool{
+		consensusService: consensus,
+		recoveryService:  recovery,
+		logger:           logger,
+		workers:          workers,
+		jobs:             make(chan string, queueSize),
+		queued:           make(map[string]string),
+	}
+}
+
+// Start launches the pool's workers
+func (p *ConsensusWorkerPool) Start() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.started {
+		return
+	}
+	p.started = true
+
+	for i := 0; i < p.workers; i++ {
+		go p.worker(i)
+	}
+
+	p.logger.WithFields(logrus.Fields{
+		"workers":    p.workers,
+		"queue_size": cap(p.jobs),
+	}).Info("Consensus worker pool started")
+}
+
+// Enqueue queues consensus processing for a polling station on behalf of the given request.
+// Returns an error if the queue is full so the caller can fall back to synchronous processing.
+func (p *ConsensusWorkerPool) Enqueue(pollingStationID string, requestID string) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	// A queued job reads the latest submissions when it runs, so one pending job per station is enough
+	if _, queued := p.queued[pollingStationID]; queued {
+		return nil
+	}
+
+	p.inFlight.Add(1)
+	select {
+	case p.jobs <- pollingStationID:
+		p.queued[pollingStationID] = requestID
+		return nil
+	default:
+		p.inFlight.Done()
+		return fmt.Errorf("consensus queue is full (capacity: %d)", cap(p.jobs))
+	}
+}
+
+// QueueLength returns the number of jobs waiting to be processed
+func (p *ConsensusWorkerPool) QueueLength() int {
+	return len(p.jobs)
+}
+
+// Wait blocks until every queued job has been processed
+func (p *ConsensusWorkerPool) Wait() {
+	p.inFlight.Wait()
+}
+
+// worker processes consensus jobs until the pool's job channel is closed
+func (p *ConsensusWorkerPool) worker(id int) {
+	logger := p.logger.WithFields(logrus.Fields{
+		"service":   "consensus_pool",
+		"worker_id": id,
+	})
+
+	for pollingStationID := range p.jobs {
+		p.mutex.Lock()
+		requestID := p.queued[pollingStationID]
+		delete(p.queued, pollingStationID)
+		p.mutex.Unlock()
+
+		p.process(pollingStationID, requestID, logger.WithFields(logrus.Fields{
+			"polling_station_id": pollingStationID,
+			"request_id":         requestID,
+		}))
+
+		p.inFlight.Done()
+	}
+}
+
+// process runs consensus for a polling station, falling back to consensus recovery on failure
+func (p *ConsensusWorkerPool) process(pollingStationID string, requestID string, logger *logrus.Entry) {
+	result, err := p.consensusService.ProcessConsensus(pollingStationID)
+	if err == nil {
+		logger.WithField("consensus_status", result.Status).Debug("Queued consensus processing completed")
+		return
+	}
+
+	logger.WithError(err).Warning("Queued consensus processing failed, attempting recovery")
+	if p.recoveryService == nil {
+		return
+	}
+
+	recoveryResult := p.recoveryService.RecoverConsensusProcessing(pollingStationID, requestID, err)
+	if !recoveryResult.Success {
+		logger.WithFields(logrus.Fields{
+			"recovery_attempts": recoveryResult.AttemptsUsed,
+			"recovery_error":    recoveryResult.Error,
+		}).Error("Queued consensus recovery failed")
+	}
+}
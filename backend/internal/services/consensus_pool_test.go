@@ -0,0 +1,70 @@
+package services
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oyah-backend/internal/models"
+)
+
+func TestConsensusWorkerPool_ConvergesForAllStations(t *testing.T) {
+	consensusService, storageService := setupConsensusTest()
+	logger := logrus.New()
+	logger.SetLevel(logrus.PanicLevel)
+
+	pool := NewConsensusWorkerPool(consensusService, nil, logger, 4, 500)
+	pool.Start()
+
+	results := map[string]int{"Candidate A": 120, "Candidate B": 80}
+	stationCount := 100
+
+	for station := 0; station < stationCount; station++ {
+		stationID := fmt.Sprintf("POOL_%03d", station)
+		for witness := 0; witness < 3; witness++ {
+			submission := models.Submission{
+				ID:               fmt.Sprintf("pool-%d-%d", station, witness),
+				WalletAddress:    fmt.Sprintf("wallet-%d", witness),
+				PollingStationID: stationID,
+				Timestamp:        time.Now(),
+				Results:          copyResults(results),
+				SubmissionType:   "image_ocr",
+			}
+			require.NoError(t, storageService.StoreSubmission(submission))
+			require.NoError(t, pool.Enqueue(stationID, ""))
+		}
+	}
+
+	pool.Wait()
+	assert.Equal(t, 0, pool.QueueLength())
+
+	for station := 0; station < stationCount; station++ {
+		stationID := fmt.Sprintf("POOL_%03d", station)
+		pollingStation, err := storageService.GetPollingStation(stationID)
+		require.NoError(t, err)
+		assert.Equal(t, "Verified", pollingStation.Status, "station %s should be verified", stationID)
+		assert.Equal(t, results, pollingStation.VerifiedResults)
+	}
+}
+
+func TestConsensusWorkerPool_QueueFull(t *testing.T) {
+	consensusService, _ := setupConsensusTest()
+	logger := logrus.New()
+	logger.SetLevel(logrus.PanicLevel)
+
+	// Workers are not started, so jobs stay queued
+	pool := NewConsensusWorkerPool(consensusService, nil, logger, 1, 2)
+
+	assert.NoError(t, pool.Enqueue("FULL_001", ""))
+	assert.NoError(t, pool.Enqueue("FULL_001", "")) // Already queued, not added twice
+	assert.NoError(t, pool.Enqueue("FULL_002", ""))
+	assert.Equal(t, 2, pool.QueueLength())
+
+	err := pool.Enqueue("FULL_003", "")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "queue is full")
+}
@@ -0,0 +1,92 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"oyah-backend/internal/models"
+)
+
+// Policies for submissions that arrive after a polling station was verified
+const (
+	// PostVerificationRecompute re-evaluates verified stations with every submission, as if they were pending (default)
+	PostVerificationRecompute = "recompute"
+	// PostVerificationLock ignores submissions that arrive after verification
+	PostVerificationLock = "lock"
+	// PostVerificationUpdateConfidence lets agreeing late submissions raise confidence and ignores disagreeing ones
+	PostVerificationUpdateConfidence = "update-confidence"
+	// PostVerificationReopen reverts a verified station to Pending as soon as a late submission disagrees
+	PostVerificationReopen = "reopen"
+)
+
+// SetPostVerificationPolicy sets how submissions arriving after a station was verified are handled.
+// An empty policy restores the default of recomputing consensus.
+func (c *ConsensusService) SetPostVerificationPolicy(policy string) error {
+	switch policy {
+	case "":
+		policy = PostVerificationRecompute
+	case PostVerificationRecompute, PostVerificationLock, PostVerificationUpdateConfidence, PostVerificationReopen:
+	default:
+		return fmt.Errorf("unknown post-verification policy %q", policy)
+	}
+
+	c.postVerificationPolicy = policy
+	c.logger.WithField("post_verification_policy", policy).Info("Post-verification policy updated")
+	return nil
+}
+
+// applyPostVerificationPolicy filters the submissions a verified station is re-evaluated with, according to the
+// post-verification policy. Under the reopen policy it instead returns a Pending result when a late submission disagrees.
+func (c *ConsensusService) applyPostVerificationPolicy(pollingStationID, previousStatus string, submissions []models.Submission, logger *logrus.Entry) ([]models.Submission, *ConsensusResult) {
+	if c.postVerificationPolicy == "" || c.postVerificationPolicy == PostVerificationRecompute || previousStatus != models.StationStatusVerified {
+		return submissions, nil
+	}
+
+	station, err := c.storageService.GetPollingStation(pollingStationID)
+	if err != nil || station.VerifiedAt == nil {
+		return submissions, nil
+	}
+
+	kept := make([]models.Submission, 0, len(submissions))
+	agreeing, disagreeing := 0, 0
+	for _, submission := range submissions {
+		if !submission.ProcessedAt.After(*station.VerifiedAt) {
+			kept = append(kept, submission)
+			continue
+		}
+
+		if c.resultsAgree(submission.Results, station.VerifiedResults) {
+			agreeing++
+			if c.postVerificationPolicy != PostVerificationLock {
+				kept = append(kept, submission)
+			}
+			continue
+		}
+
+		disagreeing++
+		if c.postVerificationPolicy == PostVerificationReopen {
+			kept = append(kept, submission)
+		}
+	}
+
+	if agreeing == 0 && disagreeing == 0 {
+		return submissions, nil
+	}
+
+	logger.WithFields(logrus.Fields{
+		"post_verification_policy": c.postVerificationPolicy,
+		"agreeing_submissions":     agreeing,
+		"disagreeing_submissions":  disagreeing,
+	}).Info("Applying post-verification policy")
+
+	if c.postVerificationPolicy == PostVerificationReopen && disagreeing > 0 {
+		return kept, &ConsensusResult{
+			Status:          models.StationStatusPending,
+			ConfidenceLevel: 0.0,
+			Message:         fmt.Sprintf("Verification reopened - %d submissions received after verification disagree with the verified result", disagreeing),
+		}
+	}
+
+	return kept, nil
+}
@@ -2,12 +2,17 @@ package services
 
 import (
 	"fmt"
+	"maps"
+	"sort"
 	"time"
 
 	"github.com/sirupsen/logrus"
 	"oyah-backend/internal/models"
 )
 
+// DefaultConsensusRetryAfter is how long after consensus and recovery both failed a submission's station is retried
+const DefaultConsensusRetryAfter = 30 * time.Second
+
 // ConsensusRecoveryService handles consensus engine error recovery
 type ConsensusRecoveryService struct {
 	storageService   *StorageService
@@ -35,12 +40,15 @@ type RecoveryResult struct {
 	FinalResult     *ConsensusResult    `json:"final_result,omitempty"`
 	RecoveryActions []string            `json:"recovery_actions"`
 	Error           string              `json:"error,omitempty"`
+	RequestID       string              `json:"request_id,omitempty"` // Request that stored the submission which triggered recovery
 }
 
-// RecoverConsensusProcessing attempts to recover from consensus processing errors
-func (crs *ConsensusRecoveryService) RecoverConsensusProcessing(pollingStationID string, originalError error) *RecoveryResult {
+// RecoverConsensusProcessing attempts to recover from consensus processing errors.
+// requestID is the ID of the submission request that triggered consensus, so recovery logs can be correlated with it.
+func (crs *ConsensusRecoveryService) RecoverConsensusProcessing(pollingStationID string, requestID string, originalError error) *RecoveryResult {
 	logger := crs.logger.WithFields(logrus.Fields{
 		"polling_station_id": pollingStationID,
+		"request_id":         requestID,
 		"service":           "consensus_recovery",
 		"original_error":    originalError.Error(),
 	})
@@ -51,6 +59,7 @@ func (crs *ConsensusRecoveryService) RecoverConsensusProcessing(pollingStationID
 		Success:         false,
 		AttemptsUsed:    0,
 		RecoveryActions: []string{},
+		RequestID:       requestID,
 	}
 
 	// Step 1: Validate polling station exists
@@ -66,7 +75,7 @@ func (crs *ConsensusRecoveryService) RecoverConsensusProcessing(pollingStationID
 	// Step 2: Check data integrity
 	if err := crs.validateDataIntegrity(station); err != nil {
 		logger.WithError(err).Warning("Data integrity issues found, attempting repair")
-		if repairErr := crs.repairDataIntegrity(station); repairErr != nil {
+		if repairErr := crs.repairDataIntegrity(station, logger); repairErr != nil {
 			result.Error = fmt.Sprintf("data integrity repair failed: %v", repairErr)
 			logger.WithError(repairErr).Error("Failed to repair data integrity")
 			return result
@@ -120,7 +129,7 @@ func (crs *ConsensusRecoveryService) RecoverConsensusProcessing(pollingStationID
 
 	// Step 4: If all retries failed, attempt emergency recovery
 	logger.Warning("All consensus retries failed, attempting emergency recovery")
-	if emergencyResult := crs.attemptEmergencyRecovery(pollingStationID); emergencyResult != nil {
+	if emergencyResult := crs.attemptEmergencyRecovery(pollingStationID, logger); emergencyResult != nil {
 		result.Success = true
 		result.FinalResult = emergencyResult
 		result.RecoveryActions = append(result.RecoveryActions, "emergency_recovery_success")
@@ -137,38 +146,147 @@ func (crs *ConsensusRecoveryService) RecoverConsensusProcessing(pollingStationID
 
 // validateDataIntegrity checks for data integrity issues
 func (crs *ConsensusRecoveryService) validateDataIntegrity(station *models.PollingStation) error {
+	if issues := findDataIntegrityIssues(station); len(issues) > 0 {
+		return fmt.Errorf("%s", issues[0])
+	}
+	return nil
+}
+
+// findDataIntegrityIssues lists every data integrity issue of a polling station, in submission order
+func findDataIntegrityIssues(station *models.PollingStation) []string {
 	// Check for nil or empty submissions
 	if station.Submissions == nil {
-		return fmt.Errorf("submissions list is nil")
+		return []string{"submissions list is nil"}
 	}
 
+	var issues []string
+
 	// Check for duplicate wallet addresses in submissions
 	walletAddresses := make(map[string]bool)
 	for _, submission := range station.Submissions {
 		if walletAddresses[submission.WalletAddress] {
-			return fmt.Errorf("duplicate wallet address found: %s", submission.WalletAddress)
+			issues = append(issues, fmt.Sprintf("duplicate wallet address found: %s", submission.WalletAddress))
 		}
 		walletAddresses[submission.WalletAddress] = true
 
 		// Validate submission data
-		if submission.Results == nil || len(submission.Results) == 0 {
-			return fmt.Errorf("submission %s has empty results", submission.ID)
+		if len(submission.Results) == 0 {
+			issues = append(issues, fmt.Sprintf("submission %s has empty results", submission.ID))
 		}
 
-		// Check for negative vote counts
-		for candidate, votes := range submission.Results {
-			if votes < 0 {
-				return fmt.Errorf("negative vote count for candidate %s in submission %s", candidate, submission.ID)
+		// Check for negative vote counts, in a stable candidate order
+		candidates := make([]string, 0, len(submission.Results))
+		for candidate := range submission.Results {
+			candidates = append(candidates, candidate)
+		}
+		sort.Strings(candidates)
+		for _, candidate := range candidates {
+			if submission.Results[candidate] < 0 {
+				issues = append(issues, fmt.Sprintf("negative vote count for candidate %s in submission %s", candidate, submission.ID))
 			}
 		}
 	}
 
-	return nil
+	return issues
+}
+
+// StationRepairReport describes the integrity issues found and repaired at one polling station
+type StationRepairReport struct {
+	PollingStationID string   `json:"pollingStationId"`
+	IssuesFound      []string `json:"issuesFound"`
+	Repaired         bool     `json:"repaired"`
+	ConsensusStatus  string   `json:"consensusStatus,omitempty"` // Station status after consensus was reprocessed
+	Error            string   `json:"error,omitempty"`
+}
+
+// IntegrityRepairReport summarizes a data integrity validation and repair run across a voting process
+type IntegrityRepairReport struct {
+	VotingProcessID  string                `json:"votingProcessId"`
+	StationsChecked  int                   `json:"stationsChecked"`
+	StationsRepaired int                   `json:"stationsRepaired"`
+	IssuesFound      int                   `json:"issuesFound"`
+	Stations         []StationRepairReport `json:"stations"`
+	GeneratedAt      time.Time             `json:"generatedAt"`
+}
+
+// RepairVotingProcess validates the data integrity of every station in a voting process, repairs and persists
+// any issues found, and reprocesses consensus for each station with submissions. Compacted stations are skipped.
+func (crs *ConsensusRecoveryService) RepairVotingProcess(processID string) (*IntegrityRepairReport, error) {
+	logger := crs.logger.WithFields(logrus.Fields{
+		"voting_process_id": processID,
+		"service":           "consensus_recovery",
+	})
+
+	stations, err := crs.storageService.GetPollingStationsByVotingProcess(processID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &IntegrityRepairReport{
+		VotingProcessID: processID,
+		Stations:        []StationRepairReport{},
+	}
+
+	for _, station := range stations {
+		if station.Compacted {
+			continue
+		}
+
+		// Repair works on copies so stored results only change once the repair is persisted
+		submissions := crs.storageService.GetSubmissionsByStation(station.ID)
+		for i := range submissions {
+			submissions[i].Results = maps.Clone(submissions[i].Results)
+		}
+		station.Submissions = submissions
+
+		stationReport := StationRepairReport{
+			PollingStationID: station.ID,
+			IssuesFound:      findDataIntegrityIssues(station),
+		}
+		report.StationsChecked++
+		report.IssuesFound += len(stationReport.IssuesFound)
+
+		if len(stationReport.IssuesFound) > 0 {
+			if err := crs.repairDataIntegrity(station, logger); err != nil {
+				stationReport.Error = fmt.Sprintf("data integrity repair failed: %v", err)
+				report.Stations = append(report.Stations, stationReport)
+				continue
+			}
+			if err := crs.storageService.ReplaceStationSubmissions(station.ID, station.Submissions); err != nil {
+				stationReport.Error = fmt.Sprintf("failed to store repaired submissions: %v", err)
+				report.Stations = append(report.Stations, stationReport)
+				continue
+			}
+			stationReport.Repaired = true
+			report.StationsRepaired++
+		}
+
+		if len(station.Submissions) > 0 {
+			if _, err := crs.consensusService.ProcessConsensus(station.ID); err != nil {
+				stationReport.Error = fmt.Sprintf("consensus reprocessing failed: %v", err)
+			}
+			if updated, err := crs.storageService.GetPollingStation(station.ID); err == nil {
+				stationReport.ConsensusStatus = updated.Status
+			}
+		}
+
+		report.Stations = append(report.Stations, stationReport)
+	}
+
+	report.GeneratedAt = time.Now()
+
+	logger.WithFields(logrus.Fields{
+		"stations_checked":  report.StationsChecked,
+		"stations_repaired": report.StationsRepaired,
+		"issues_found":      report.IssuesFound,
+	}).Info("Voting process data integrity repair completed")
+
+	return report, nil
 }
 
-// repairDataIntegrity attempts to repair data integrity issues
-func (crs *ConsensusRecoveryService) repairDataIntegrity(station *models.PollingStation) error {
-	logger := crs.logger.WithField("polling_station_id", station.ID)
+// repairDataIntegrity attempts to repair data integrity issues, logging through the caller's logger
+func (crs *ConsensusRecoveryService) repairDataIntegrity(station *models.PollingStation, parentLogger *logrus.Entry) error {
+	logger := parentLogger.WithField("polling_station_id", station.ID)
 
 	// Initialize submissions if nil
 	if station.Submissions == nil {
@@ -214,9 +332,9 @@ func (crs *ConsensusRecoveryService) repairDataIntegrity(station *models.Polling
 	return nil
 }
 
-// attemptEmergencyRecovery performs emergency recovery when normal consensus fails
-func (crs *ConsensusRecoveryService) attemptEmergencyRecovery(pollingStationID string) *ConsensusResult {
-	logger := crs.logger.WithFields(logrus.Fields{
+// attemptEmergencyRecovery performs emergency recovery when normal consensus fails, logging through the caller's logger
+func (crs *ConsensusRecoveryService) attemptEmergencyRecovery(pollingStationID string, parentLogger *logrus.Entry) *ConsensusResult {
+	logger := parentLogger.WithFields(logrus.Fields{
 		"polling_station_id": pollingStationID,
 		"recovery_type":     "emergency",
 	})
@@ -256,7 +374,7 @@ func (crs *ConsensusRecoveryService) attemptEmergencyRecovery(pollingStationID s
 		confidence := float64(maxCount) / float64(len(submissions)) * 0.7 // Reduced confidence for emergency recovery
 
 		result := &ConsensusResult{
-			Status:          "Verified",
+			Status:          models.StationStatusVerified,
 			VerifiedResults: largestResults,
 			ConfidenceLevel: confidence,
 			Message:         fmt.Sprintf("Emergency recovery: %d identical submissions found", maxCount),
@@ -290,32 +408,7 @@ func (crs *ConsensusRecoveryService) attemptEmergencyRecovery(pollingStationID s
 
 // createResultKey creates a consistent string key for results (same as consensus service)
 func (crs *ConsensusRecoveryService) createResultKey(results map[string]int) string {
-	key := ""
-	
-	// Get all candidate names and sort them for consistency
-	candidates := make([]string, 0, len(results))
-	for candidate := range results {
-		candidates = append(candidates, candidate)
-	}
-	
-	// Simple sort (bubble sort for small arrays)
-	for i := 0; i < len(candidates)-1; i++ {
-		for j := 0; j < len(candidates)-i-1; j++ {
-			if candidates[j] > candidates[j+1] {
-				candidates[j], candidates[j+1] = candidates[j+1], candidates[j]
-			}
-		}
-	}
-	
-	// Build the key
-	for i, candidate := range candidates {
-		if i > 0 {
-			key += ","
-		}
-		key += fmt.Sprintf("%s:%d", candidate, results[candidate])
-	}
-	
-	return key
+	return canonicalResultKey(results)
 }
 
 // SetRetryConfiguration allows customizing retry behavior
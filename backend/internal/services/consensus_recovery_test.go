@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/sirupsen/logrus"
+	logtest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -69,7 +70,7 @@ func TestConsensusRecoveryService_RecoverConsensusProcessing(t *testing.T) {
 
 	// Test recovery with a simulated error
 	originalError := errors.New("simulated consensus error")
-	result := recoveryService.RecoverConsensusProcessing("station-1", originalError)
+	result := recoveryService.RecoverConsensusProcessing("station-1", "", originalError)
 
 	// Should succeed because we have valid data and consensus should work on retry
 	assert.True(t, result.Success)
@@ -87,12 +88,56 @@ func TestConsensusRecoveryService_RecoverConsensusProcessing_NonExistentStation(
 	recoveryService := NewConsensusRecoveryService(storage, consensusService, logger)
 
 	originalError := errors.New("simulated error")
-	result := recoveryService.RecoverConsensusProcessing("non-existent-station", originalError)
+	result := recoveryService.RecoverConsensusProcessing("non-existent-station", "", originalError)
 
 	assert.False(t, result.Success)
 	assert.Contains(t, result.Error, "polling station validation failed")
 }
 
+func TestConsensusRecoveryService_RecoverConsensusProcessing_RequestCorrelation(t *testing.T) {
+	storage := NewStorageService()
+	logger, hook := logtest.NewNullLogger()
+	logger.SetLevel(logrus.InfoLevel)
+	consensusService := NewConsensusService(storage, logger)
+	recoveryService := NewConsensusRecoveryService(storage, consensusService, logger)
+	recoveryService.SetRetryConfiguration(1, time.Millisecond)
+
+	require.NoError(t, storage.StoreVotingProcess(models.VotingProcess{
+		ID:              "process-corr",
+		Title:           "Correlation Election",
+		Position:        "President",
+		Candidates:      []models.Candidate{{ID: "1", Name: "Alice"}, {ID: "2", Name: "Bob"}},
+		PollingStations: []string{"station-corr"},
+		Status:          "Active",
+		CreatedAt:       time.Now(),
+	}))
+
+	// A duplicated wallet forces a data repair before consensus is retried
+	now := time.Now()
+	require.NoError(t, storage.ReplaceStationSubmissions("station-corr", []models.Submission{
+		{ID: "corr-1", WalletAddress: "wallet-1", PollingStationID: "station-corr", Results: map[string]int{"Alice": 10, "Bob": 5}, ProcessedAt: now},
+		{ID: "corr-2", WalletAddress: "wallet-2", PollingStationID: "station-corr", Results: map[string]int{"Alice": 10, "Bob": 5}, ProcessedAt: now},
+		{ID: "corr-3", WalletAddress: "wallet-2", PollingStationID: "station-corr", Results: map[string]int{"Alice": 10, "Bob": 5}, ProcessedAt: now},
+	}))
+	hook.Reset()
+
+	result := recoveryService.RecoverConsensusProcessing("station-corr", "req-123", errors.New("simulated consensus error"))
+
+	assert.Equal(t, "req-123", result.RequestID)
+	assert.Contains(t, result.RecoveryActions, "repaired_data_integrity")
+
+	// Every recovery log entry, including data repair and emergency recovery, carries the request ID
+	recoveryEntries := 0
+	for _, entry := range hook.AllEntries() {
+		if entry.Data["service"] != "consensus_recovery" {
+			continue // Consensus service logs
+		}
+		recoveryEntries++
+		assert.Equal(t, "req-123", entry.Data["request_id"], "log entry %q is missing the request ID", entry.Message)
+	}
+	assert.Greater(t, recoveryEntries, 1)
+}
+
 func TestConsensusRecoveryService_ValidateDataIntegrity(t *testing.T) {
 	storage := NewStorageService()
 	logger := logrus.New()
@@ -227,7 +272,7 @@ func TestConsensusRecoveryService_RepairDataIntegrity(t *testing.T) {
 		},
 	}
 
-	err := recoveryService.repairDataIntegrity(station)
+	err := recoveryService.repairDataIntegrity(station, logrus.NewEntry(logger))
 	assert.NoError(t, err)
 
 	// Check that duplicates were removed (should keep the most recent)
@@ -283,7 +328,7 @@ func TestConsensusRecoveryService_AttemptEmergencyRecovery(t *testing.T) {
 		require.NoError(t, err)
 	}
 
-	result := recoveryService.attemptEmergencyRecovery("station-1")
+	result := recoveryService.attemptEmergencyRecovery("station-1", logrus.NewEntry(logger))
 	assert.NotNil(t, result)
 	assert.Equal(t, "Verified", result.Status)
 	assert.Equal(t, map[string]int{"Alice": 100, "Bob": 80}, result.VerifiedResults)
@@ -301,7 +346,7 @@ func TestConsensusRecoveryService_AttemptEmergencyRecovery(t *testing.T) {
 		},
 	}
 
-	result = recoveryService.attemptEmergencyRecovery("station-2")
+	result = recoveryService.attemptEmergencyRecovery("station-2", logrus.NewEntry(logger))
 	assert.Nil(t, result) // Should fail with only 1 submission
 }
 
@@ -321,4 +366,79 @@ func TestConsensusRecoveryService_SetRetryConfiguration(t *testing.T) {
 	recoveryService.SetRetryConfiguration(0, 0)
 	assert.Equal(t, 5, recoveryService.maxRetries) // Should remain unchanged
 	assert.Equal(t, time.Second*3, recoveryService.retryDelay) // Should remain unchanged
-}
\ No newline at end of file
+}
+
+func TestConsensusRecoveryService_RepairVotingProcess(t *testing.T) {
+	storage := NewStorageService()
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel) // Reduce noise in tests
+	consensusService := NewConsensusService(storage, logger)
+	recoveryService := NewConsensusRecoveryService(storage, consensusService, logger)
+
+	require.NoError(t, storage.StoreVotingProcess(models.VotingProcess{
+		ID:              "repair-process",
+		Title:           "Test Election",
+		Position:        "President",
+		Candidates:      []models.Candidate{{ID: "1", Name: "Alice"}, {ID: "2", Name: "Bob"}},
+		PollingStations: []string{"station-1", "station-2"},
+		Status:          "Active",
+		CreatedAt:       time.Now(),
+	}))
+
+	// Seed corrupted data directly: a wallet submitted twice and some vote counts are negative
+	now := time.Now()
+	require.NoError(t, storage.ReplaceStationSubmissions("station-1", []models.Submission{
+		{ID: "sub-1", WalletAddress: "wallet-1", PollingStationID: "station-1", Results: map[string]int{"Alice": -5, "Bob": 80}, ProcessedAt: now.Add(-time.Minute)},
+		{ID: "sub-2", WalletAddress: "wallet-1", PollingStationID: "station-1", Results: map[string]int{"Alice": 100, "Bob": 80}, ProcessedAt: now},
+		{ID: "sub-3", WalletAddress: "wallet-2", PollingStationID: "station-1", Results: map[string]int{"Alice": 100, "Bob": 80}, ProcessedAt: now},
+		{ID: "sub-4", WalletAddress: "wallet-3", PollingStationID: "station-1", Results: map[string]int{"Alice": 100, "Bob": 80}, ProcessedAt: now},
+	}))
+	require.NoError(t, storage.ReplaceStationSubmissions("station-2", []models.Submission{
+		{ID: "sub-5", WalletAddress: "wallet-4", PollingStationID: "station-2", Results: map[string]int{"Alice": -3, "Bob": 20}, ProcessedAt: now},
+	}))
+
+	report, err := recoveryService.RepairVotingProcess("repair-process")
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, report.StationsChecked)
+	assert.Equal(t, 2, report.StationsRepaired)
+	assert.Equal(t, 3, report.IssuesFound)
+	require.Len(t, report.Stations, 2)
+	assert.Equal(t, []string{
+		"negative vote count for candidate Alice in submission sub-1",
+		"duplicate wallet address found: wallet-1",
+	}, report.Stations[0].IssuesFound)
+	assert.True(t, report.Stations[0].Repaired)
+	assert.Equal(t, "Verified", report.Stations[0].ConsensusStatus)
+	assert.Equal(t, []string{"negative vote count for candidate Alice in submission sub-5"}, report.Stations[1].IssuesFound)
+	assert.Equal(t, "Pending", report.Stations[1].ConsensusStatus)
+
+	// The duplicate wallet keeps only its latest submission
+	submissions := storage.GetSubmissionsByStation("station-1")
+	require.Len(t, submissions, 3)
+	for _, submission := range submissions {
+		assert.NotEqual(t, "sub-1", submission.ID)
+		assert.Equal(t, map[string]int{"Alice": 100, "Bob": 80}, submission.Results)
+	}
+	walletSubmissions := storage.GetSubmissionsByWallet("wallet-1")
+	require.Len(t, walletSubmissions, 1)
+	assert.Equal(t, "sub-2", walletSubmissions[0].ID)
+
+	// Negative vote counts are clamped to zero
+	assert.Equal(t, map[string]int{"Alice": 0, "Bob": 20}, storage.GetSubmissionsByStation("station-2")[0].Results)
+
+	// Consensus was recomputed on the repaired data
+	station, err := storage.GetPollingStation("station-1")
+	require.NoError(t, err)
+	assert.Equal(t, "Verified", station.Status)
+	assert.Equal(t, map[string]int{"Alice": 100, "Bob": 80}, station.VerifiedResults)
+
+	// A second run finds nothing left to repair
+	report, err = recoveryService.RepairVotingProcess("repair-process")
+	require.NoError(t, err)
+	assert.Zero(t, report.IssuesFound)
+	assert.Zero(t, report.StationsRepaired)
+
+	_, err = recoveryService.RepairVotingProcess("non-existent")
+	assert.Error(t, err)
+}
@@ -0,0 +1,114 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"oyah-backend/internal/models"
+)
+
+// provisionalVerification tracks a station that qualified for verification but is still in its stability window
+type provisionalVerification struct {
+	results map[string]int
+	since   time.Time
+}
+
+// SetStabilityWindow sets how long a newly qualifying station stays "VerifiedProvisional" before it is verified.
+// A zero or negative window verifies stations as soon as they qualify.
+func (c *ConsensusService) SetStabilityWindow(window time.Duration) {
+	if window < 0 {
+		window = 0
+	}
+	c.stabilityWindow = window
+}
+
+// applyStabilityWindow holds back a newly qualifying verification until it has survived the stability window.
+// A submission contradicting the provisional result during the window reverts the station to Pending;
+// the station is re-evaluated once the window has passed, so a still-valid majority can qualify again.
+func (c *ConsensusService) applyStabilityWindow(pollingStationID, previousStatus string, result *ConsensusResult, submissions []models.Submission, logger *logrus.Entry) *ConsensusResult {
+	c.provisionalMutex.Lock()
+	defer c.provisionalMutex.Unlock()
+
+	state, provisional := c.provisional[pollingStationID]
+
+	// Already verified stations keep the usual behaviour
+	if c.stabilityWindow <= 0 || previousStatus == models.StationStatusVerified || result.Status != models.StationStatusVerified {
+		delete(c.provisional, pollingStationID)
+		return result
+	}
+
+	now := time.Now()
+
+	if provisional {
+		if contradicting := c.countContradictingSubmissions(submissions, state); contradicting > 0 {
+			delete(c.provisional, pollingStationID)
+			c.scheduleStabilityCheck(pollingStationID)
+			logger.WithField("contradicting_submissions", contradicting).Info("Provisional verification reverted by contradicting submissions")
+			return &ConsensusResult{
+				Status:          models.StationStatusPending,
+				ConfidenceLevel: 0.0,
+				Message:         fmt.Sprintf("Provisional verification reverted - %d contradicting submissions received during the stability window", contradicting),
+			}
+		}
+
+		if c.resultsAgree(state.results, result.VerifiedResults) {
+			if now.Sub(state.since) >= c.stabilityWindow {
+				delete(c.provisional, pollingStationID)
+				logger.Info("Provisional verification finalized after stability window")
+				return result
+			}
+			return c.provisionalResult(result, state.since)
+		}
+	}
+
+	// Newly qualifying: start the stability window
+	state = &provisionalVerification{results: result.VerifiedResults, since: now}
+	c.provisional[pollingStationID] = state
+	c.scheduleStabilityCheck(pollingStationID)
+	logger.WithField("stability_window", c.stabilityWindow.String()).Info("Station provisionally verified")
+
+	return c.provisionalResult(result, state.since)
+}
+
+// provisionalResult reports a verification that is waiting out the stability window
+func (c *ConsensusService) provisionalResult(result *ConsensusResult, since time.Time) *ConsensusResult {
+	return &ConsensusResult{
+		Status:            models.StationStatusVerifiedProvisional,
+		VerifiedResults:   result.VerifiedResults,
+		ConfidenceLevel:   result.ConfidenceLevel,
+		AverageConfidence: result.AverageConfidence,
+		AgreementScore:    result.AgreementScore,
+		Message:           fmt.Sprintf("Provisionally verified - final once stable until %s", since.Add(c.stabilityWindow).Format(time.RFC3339)),
+	}
+}
+
+// countContradictingSubmissions counts submissions received since a station became provisional that disagree with its result
+func (c *ConsensusService) countContradictingSubmissions(submissions []models.Submission, state *provisionalVerification) int {
+	contradicting := 0
+	for _, submission := range submissions {
+		if submission.ProcessedAt.After(state.since) && !c.resultsAgree(submission.Results, state.results) {
+			contradicting++
+		}
+	}
+	return contradicting
+}
+
+// resultsAgree compares two results, honouring tolerance mode
+func (c *ConsensusService) resultsAgree(results1, results2 map[string]int) bool {
+	if c.toleranceEnabled() {
+		return c.areResultsWithinTolerance(results1, results2)
+	}
+	return c.areResultsIdentical(results1, results2)
+}
+
+// scheduleStabilityCheck re-evaluates a station once the stability window has passed,
+// so provisional verifications finalize even when no further submissions arrive
+func (c *ConsensusService) scheduleStabilityCheck(pollingStationID string) {
+	time.AfterFunc(c.stabilityWindow, func() {
+		if _, err := c.ProcessConsensus(pollingStationID); err != nil {
+			c.logger.WithError(err).WithField("polling_station_id", pollingStationID).Error("Stability window re-evaluation failed")
+		}
+	})
+}
@@ -2,6 +2,8 @@ package services
 
 import (
 	"fmt"
+	"math"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -330,6 +332,62 @@ func TestConsensusService_CreateResultKey(t *testing.T) {
 	}
 }
 
+// Test that candidate names containing separator characters never produce colliding keys
+func TestConsensusService_CreateResultKey_SpecialCharacters(t *testing.T) {
+	consensusService, _ := setupConsensusTest()
+
+	tests := []struct {
+		name     string
+		results1 map[string]int
+		results2 map[string]int
+	}{
+		{
+			name:     "colon in candidate name",
+			results1: map[string]int{"A:1,B": 2},
+			results2: map[string]int{"A": 1, "B": 2},
+		},
+		{
+			name:     "comma in candidate name",
+			results1: map[string]int{"Smith, John": 10},
+			results2: map[string]int{" John": 10, "Smith": 0},
+		},
+		{
+			name:     "name mimicking an encoded entry",
+			results1: map[string]int{"1:A=5;": 5},
+			results2: map[string]int{"1": 5, "A": 5},
+		},
+		{
+			name:     "colon and comma swapped",
+			results1: map[string]int{"A,B": 1, "C": 2},
+			results2: map[string]int{"A:B": 1, "C": 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key1 := consensusService.createResultKey(tt.results1)
+			key2 := consensusService.createResultKey(tt.results2)
+			if key1 == key2 {
+				t.Errorf("Expected distinct keys for %v and %v, got %q for both", tt.results1, tt.results2, key1)
+			}
+
+			// The same results must still map to the same key
+			if again := consensusService.createResultKey(tt.results1); again != key1 {
+				t.Errorf("Expected a stable key, got %q and %q", key1, again)
+			}
+		})
+	}
+
+	// Distinct results must form separate groups even when names contain separators
+	submissions := []models.Submission{
+		{WalletAddress: "wallet-1", Results: map[string]int{"A:1,B": 2}},
+		{WalletAddress: "wallet-2", Results: map[string]int{"A": 1, "B": 2}},
+	}
+	if groups := consensusService.groupSubmissionsByResults(submissions); len(groups) != 2 {
+		t.Errorf("Expected 2 result groups, got %d", len(groups))
+	}
+}
+
 // Test results comparison
 func TestConsensusService_AreResultsIdentical(t *testing.T) {
 	consensusService, _ := setupConsensusTest()
@@ -564,6 +622,116 @@ func TestConsensusService_CalculateConfidenceLevel(t *testing.T) {
 	}
 }
 
+func TestConsensusService_CalculateConfidenceLevel_Quantized(t *testing.T) {
+	consensusService, _ := setupConsensusTest()
+
+	// 4 of 7 plus one bonus step is 0.5914285714... before quantization
+	group := &SubmissionGroup{WalletCount: 4}
+	first := consensusService.calculateConfidenceLevel(group, 7)
+	if first != 0.5914 {
+		t.Errorf("Expected confidence quantized to 0.5914, got %v", first)
+	}
+
+	// Repeated computations on the same group are bit-identical
+	for i := 0; i < 100; i++ {
+		confidence := consensusService.calculateConfidenceLevel(group, 7)
+		if math.Float64bits(confidence) != math.Float64bits(first) {
+			t.Fatalf("Recomputation %d drifted: %v != %v", i, confidence, first)
+		}
+	}
+
+	// Precision is configurable, and out-of-range values are ignored
+	consensusService.SetConfidencePrecision(2)
+	if confidence := consensusService.calculateConfidenceLevel(group, 7); confidence != 0.59 {
+		t.Errorf("Expected confidence quantized to 0.59, got %v", confidence)
+	}
+	consensusService.SetConfidencePrecision(-1)
+	consensusService.SetConfidencePrecision(MaxConfidencePrecision + 1)
+	if confidence := consensusService.calculateConfidenceLevel(group, 7); confidence != 0.59 {
+		t.Errorf("Expected precision to remain 2, got confidence %v", confidence)
+	}
+}
+
+func TestConsensusService_ProcessConsensus_AverageConfidence(t *testing.T) {
+	consensusService, storageService := setupConsensusTest()
+	stationID := "station-avg-confidence"
+
+	agreeing := map[string]int{"Candidate A": 100, "Candidate B": 150}
+	dissenting := map[string]int{"Candidate A": 90, "Candidate B": 160}
+	submissions := []struct {
+		results    map[string]int
+		confidence float64
+	}{
+		{agreeing, 0.95},
+		{agreeing, 0.80},
+		{agreeing, 0.71},
+		{dissenting, 0.20}, // Outside the agreeing group, so excluded from the average
+	}
+	for i, s := range submissions {
+		submission := models.Submission{
+			ID:               fmt.Sprintf("sub%d", i),
+			WalletAddress:    fmt.Sprintf("wallet%d", i),
+			PollingStationID: stationID,
+			Timestamp:        time.Now(),
+			Results:          s.results,
+			SubmissionType:   "image_ocr",
+			Confidence:       s.confidence,
+		}
+		if err := storageService.StoreSubmission(submission); err != nil {
+			t.Fatalf("Failed to store submission: %v", err)
+		}
+	}
+
+	result, err := consensusService.ProcessConsensus(stationID)
+	if err != nil {
+		t.Fatalf("ProcessConsensus failed: %v", err)
+	}
+	if result.Status != "Verified" {
+		t.Fatalf("Expected status Verified, got %s", result.Status)
+	}
+
+	expected := (0.95 + 0.80 + 0.71) / 3
+	if math.Abs(result.AverageConfidence-expected) > 1e-4 {
+		t.Errorf("Expected average confidence %.4f, got %v", expected, result.AverageConfidence)
+	}
+
+	station, err := storageService.GetPollingStation(stationID)
+	if err != nil {
+		t.Fatalf("Failed to get polling station: %v", err)
+	}
+	if station.AverageConfidence != result.AverageConfidence {
+		t.Errorf("Expected station average confidence %v, got %v", result.AverageConfidence, station.AverageConfidence)
+	}
+
+	status, err := consensusService.GetConsensusStatus(stationID)
+	if err != nil {
+		t.Fatalf("GetConsensusStatus failed: %v", err)
+	}
+	if status.AverageConfidence != result.AverageConfidence {
+		t.Errorf("Expected consensus status average confidence %v, got %v", result.AverageConfidence, status.AverageConfidence)
+	}
+}
+
+func TestQuantizeConfidence(t *testing.T) {
+	tests := []struct {
+		confidence float64
+		precision  int
+		expected   float64
+	}{
+		{0.8 + 0.02, 4, 0.82},
+		{0.1 + 0.2, 4, 0.3},
+		{2.0 / 3.0, 3, 0.667},
+		{0.5, 0, 1},
+		{1.0, 4, 1.0},
+	}
+
+	for _, test := range tests {
+		if result := QuantizeConfidence(test.confidence, test.precision); result != test.expected {
+			t.Errorf("QuantizeConfidence(%v, %d) = %v, expected %v", test.confidence, test.precision, result, test.expected)
+		}
+	}
+}
+
 // Test edge case: exactly at majority threshold (51%)
 func TestConsensusService_ProcessConsensus_ExactMajority(t *testing.T) {
 	consensusService, storageService := setupConsensusTest()
@@ -666,6 +834,379 @@ func TestConsensusService_ProcessConsensus_BelowThresholdAboveMajority(t *testin
 	}
 }
 
+func TestConsensusService_ProcessConsensus_MinAgreeingWallets(t *testing.T) {
+	resultsA := map[string]int{"Candidate A": 100, "Candidate B": 150}
+	resultsB := map[string]int{"Candidate A": 90, "Candidate B": 160}
+	resultsC := map[string]int{"Candidate A": 80, "Candidate B": 170}
+
+	storeGroups := func(t *testing.T, storageService *StorageService, stationID string, groups ...[]map[string]int) {
+		i := 0
+		for _, group := range groups {
+			for _, results := range group {
+				i++
+				submission := models.Submission{
+					ID:               fmt.Sprintf("%s-sub%d", stationID, i),
+					WalletAddress:    fmt.Sprintf("%s-wallet%d", stationID, i),
+					PollingStationID: stationID,
+					Timestamp:        time.Now(),
+					Results:          results,
+					SubmissionType:   "image_ocr",
+				}
+				if err := storageService.StoreSubmission(submission); err != nil {
+					t.Fatalf("Failed to store submission: %v", err)
+				}
+			}
+		}
+	}
+	repeat := func(results map[string]int, n int) []map[string]int {
+		group := make([]map[string]int, n)
+		for i := range group {
+			group[i] = results
+		}
+		return group
+	}
+
+	tests := []struct {
+		name           string
+		threshold      int
+		minAgreeing    int
+		groups         [][]map[string]int
+		expectedStatus string
+	}{
+		// 3 of 5 is a majority and meets the threshold, but 4 agreeing wallets are required
+		{"MajorityBelowMinAgreeing", 3, 4, [][]map[string]int{repeat(resultsA, 3), repeat(resultsB, 2)}, "Pending"},
+		{"MajorityAtMinAgreeing", 3, 4, [][]map[string]int{repeat(resultsA, 4), repeat(resultsB, 2)}, "Verified"},
+		// A 3-2-2-3 split never verifies: no group reaches 4 and no group has a majority
+		{"SplitTenSubmissions", 3, 4, [][]map[string]int{repeat(resultsA, 3), repeat(resultsB, 2), repeat(resultsC, 2), repeat(map[string]int{"Candidate A": 70, "Candidate B": 180}, 3)}, "Pending"},
+		// The total threshold still applies when fewer agreeing wallets are needed
+		{"BelowTotalThreshold", 5, 2, [][]map[string]int{repeat(resultsA, 4)}, "Pending"},
+		{"AgreeingBelowThresholdAllowed", 5, 2, [][]map[string]int{repeat(resultsA, 3), repeat(resultsB, 2)}, "Verified"},
+		// Zero falls back to requiring threshold agreeing wallets
+		{"DefaultFallsBackToThreshold", 5, 0, [][]map[string]int{repeat(resultsA, 3), repeat(resultsB, 2)}, "Pending"},
+	}
+
+	for _, test := range tests {
+		consensusService, storageService := setupConsensusTest()
+		consensusService.SetConsensusThreshold(test.threshold)
+		consensusService.SetMinAgreeingWallets(test.minAgreeing)
+
+		storeGroups(t, storageService, test.name, test.groups...)
+
+		result, err := consensusService.ProcessConsensus(test.name)
+		if err != nil {
+			t.Fatalf("%s: ProcessConsensus failed: %v", test.name, err)
+		}
+		if result.Status != test.expectedStatus {
+			t.Errorf("%s: expected status %s, got %s (%s)", test.name, test.expectedStatus, result.Status, result.Message)
+		}
+	}
+}
+
+func TestConsensusService_ProcessConsensus_MinLocationClusters(t *testing.T) {
+	results := map[string]int{"Candidate A": 100, "Candidate B": 150}
+	school := models.GPSCoordinates{Latitude: -1.292066, Longitude: 36.821945}
+	nearSchool := models.GPSCoordinates{Latitude: -1.292100, Longitude: 36.822000} // A few meters away
+	market := models.GPSCoordinates{Latitude: -1.283333, Longitude: 36.816667}     // About 1km away
+
+	tests := []struct {
+		name           string
+		minClusters    int
+		locations      []models.GPSCoordinates
+		expectedStatus string
+	}{
+		{"SingleLocationPendingUnderTwoClusters", 2, []models.GPSCoordinates{school, school, school}, "Pending"},
+		{"NearbyLocationsShareACluster", 2, []models.GPSCoordinates{school, nearSchool, school}, "Pending"},
+		{"TwoLocationsVerify", 2, []models.GPSCoordinates{school, school, market}, "Verified"},
+		{"NoRequirement", 0, []models.GPSCoordinates{school, school, school}, "Verified"},
+	}
+
+	for _, test := range tests {
+		consensusService, storageService := setupConsensusTest()
+
+		stationID := "STATION_" + test.name
+		if err := storageService.StoreVotingProcess(models.VotingProcess{
+			ID:                  "vp-" + test.name,
+			PollingStations:     []string{stationID},
+			Status:              "Active",
+			MinLocationClusters: test.minClusters,
+		}); err != nil {
+			t.Fatalf("Failed to store voting process: %v", err)
+		}
+
+		for i, location := range test.locations {
+			submission := models.Submission{
+				ID:               fmt.Sprintf("%s-sub%d", test.name, i),
+				WalletAddress:    fmt.Sprintf("%s-wallet%d", test.name, i),
+				PollingStationID: stationID,
+				GPSCoordinates:   location,
+				Timestamp:        time.Now(),
+				Results:          results,
+				SubmissionType:   "image_ocr",
+			}
+			if err := storageService.StoreSubmission(submission); err != nil {
+				t.Fatalf("Failed to store submission: %v", err)
+			}
+		}
+
+		result, err := consensusService.ProcessConsensus(stationID)
+		if err != nil {
+			t.Fatalf("%s: ProcessConsensus failed: %v", test.name, err)
+		}
+		if result.Status != test.expectedStatus {
+			t.Errorf("%s: expected status %s, got %s (%s)", test.name, test.expectedStatus, result.Status, result.Message)
+		}
+	}
+}
+
+func TestConsensusService_ProcessConsensus_StabilityWindow(t *testing.T) {
+	agreeing := map[string]int{"Candidate A": 100, "Candidate B": 150}
+	contradicting := map[string]int{"Candidate A": 150, "Candidate B": 100}
+
+	storeSubmission := func(t *testing.T, storageService *StorageService, stationID string, i int, results map[string]int) {
+		submission := models.Submission{
+			ID:               fmt.Sprintf("%s-sub%d", stationID, i),
+			WalletAddress:    fmt.Sprintf("%s-wallet%d", stationID, i),
+			PollingStationID: stationID,
+			Timestamp:        time.Now(),
+			Results:          results,
+			SubmissionType:   "image_ocr",
+		}
+		if err := storageService.StoreSubmission(submission); err != nil {
+			t.Fatalf("Failed to store submission: %v", err)
+		}
+	}
+	waitForStatus := func(t *testing.T, storageService *StorageService, stationID, expected string) {
+		deadline := time.Now().Add(2 * time.Second)
+		for {
+			station, err := storageService.GetPollingStation(stationID)
+			if err != nil {
+				t.Fatalf("Failed to get polling station: %v", err)
+			}
+			if station.Status == expected {
+				return
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("Expected station status %s, still %s", expected, station.Status)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	t.Run("StableVerificationFinalizes", func(t *testing.T) {
+		consensusService, storageService := setupConsensusTest()
+		consensusService.SetStabilityWindow(50 * time.Millisecond)
+		stationID := "station-stable"
+
+		for i := 0; i < 3; i++ {
+			storeSubmission(t, storageService, stationID, i, agreeing)
+		}
+
+		result, err := consensusService.ProcessConsensus(stationID)
+		if err != nil {
+			t.Fatalf("ProcessConsensus failed: %v", err)
+		}
+		if result.Status != "VerifiedProvisional" {
+			t.Fatalf("Expected status VerifiedProvisional, got %s (%s)", result.Status, result.Message)
+		}
+
+		// The scheduled re-evaluation finalizes the station without further submissions
+		waitForStatus(t, storageService, stationID, "Verified")
+	})
+
+	t.Run("ContradictedVerificationRevertsToPending", func(t *testing.T) {
+		consensusService, storageService := setupConsensusTest()
+		consensusService.SetStabilityWindow(time.Minute)
+		stationID := "station-contradicted"
+
+		for i := 0; i < 3; i++ {
+			storeSubmission(t, storageService, stationID, i, agreeing)
+		}
+		result, err := consensusService.ProcessConsensus(stationID)
+		if err != nil {
+			t.Fatalf("ProcessConsensus failed: %v", err)
+		}
+		if result.Status != "VerifiedProvisional" {
+			t.Fatalf("Expected status VerifiedProvisional, got %s (%s)", result.Status, result.Message)
+		}
+
+		// 3 of 4 is still a majority, but the contradiction arrived during the stability window
+		storeSubmission(t, storageService, stationID, 3, contradicting)
+		result, err = consensusService.ProcessConsensus(stationID)
+		if err != nil {
+			t.Fatalf("ProcessConsensus failed: %v", err)
+		}
+		if result.Status != "Pending" {
+			t.Errorf("Expected status Pending, got %s (%s)", result.Status, result.Message)
+		}
+		waitForStatus(t, storageService, stationID, "Pending")
+	})
+
+	t.Run("DisabledVerifiesImmediately", func(t *testing.T) {
+		consensusService, storageService := setupConsensusTest()
+		stationID := "station-no-window"
+
+		for i := 0; i < 3; i++ {
+			storeSubmission(t, storageService, stationID, i, agreeing)
+		}
+		result, err := consensusService.ProcessConsensus(stationID)
+		if err != nil {
+			t.Fatalf("ProcessConsensus failed: %v", err)
+		}
+		if result.Status != "Verified" {
+			t.Errorf("Expected status Verified, got %s (%s)", result.Status, result.Message)
+		}
+	})
+}
+
+func TestConsensusService_ProcessConsensus_AutoCompleteVotingProcess(t *testing.T) {
+	results := map[string]int{"Candidate A": 100, "Candidate B": 150}
+
+	verifyStation := func(t *testing.T, consensusService *ConsensusService, storageService *StorageService, stationID string) {
+		for i := 0; i < 3; i++ {
+			submission := models.Submission{
+				ID:               fmt.Sprintf("%s-sub%d", stationID, i),
+				WalletAddress:    fmt.Sprintf("%s-wallet%d", stationID, i),
+				PollingStationID: stationID,
+				Timestamp:        time.Now(),
+				Results:          results,
+				SubmissionType:   "image_ocr",
+			}
+			if err := storageService.StoreSubmission(submission); err != nil {
+				t.Fatalf("Failed to store submission: %v", err)
+			}
+		}
+		result, err := consensusService.ProcessConsensus(stationID)
+		if err != nil {
+			t.Fatalf("ProcessConsensus failed: %v", err)
+		}
+		if result.Status != "Verified" {
+			t.Fatalf("Expected station %s to verify, got %s", stationID, result.Status)
+		}
+	}
+	setupProcess := func(t *testing.T, storageService *StorageService, processID string, fraction float64, stations []string) {
+		process := models.VotingProcess{
+			ID:                   processID,
+			Title:                "Auto Complete Election",
+			Position:             "Mayor",
+			Candidates:           []models.Candidate{{ID: "a", Name: "Candidate A"}, {ID: "b", Name: "Candidate B"}},
+			PollingStations:      stations,
+			Status:               "Setup",
+			AutoCompleteFraction: fraction,
+		}
+		if err := storageService.StoreVotingProcess(process); err != nil {
+			t.Fatalf("Failed to store voting process: %v", err)
+		}
+		if err := storageService.UpdateVotingProcessStatus(processID, "Active"); err != nil {
+			t.Fatalf("Failed to start voting process: %v", err)
+		}
+	}
+	processStatus := func(t *testing.T, storageService *StorageService, processID string) *models.VotingProcess {
+		process, err := storageService.GetVotingProcess(processID)
+		if err != nil {
+			t.Fatalf("Failed to get voting process: %v", err)
+		}
+		return process
+	}
+
+	t.Run("LastRequiredStationCompletesProcess", func(t *testing.T) {
+		consensusService, storageService := setupConsensusTest()
+		setupProcess(t, storageService, "auto-all", 1.0, []string{"auto-all-1", "auto-all-2"})
+
+		verifyStation(t, consensusService, storageService, "auto-all-1")
+		if status := processStatus(t, storageService, "auto-all").Status; status != "Active" {
+			t.Errorf("Expected process to stay Active with 1 of 2 stations verified, got %s", status)
+		}
+
+		verifyStation(t, consensusService, storageService, "auto-all-2")
+		process := processStatus(t, storageService, "auto-all")
+		if process.Status != "Complete" {
+			t.Errorf("Expected process to complete once every station verified, got %s", process.Status)
+		}
+		if process.CompletedAt == nil {
+			t.Error("Expected CompletedAt to be set")
+		}
+	})
+
+	t.Run("BelowFractionStaysActive", func(t *testing.T) {
+		consensusService, storageService := setupConsensusTest()
+		setupProcess(t, storageService, "auto-partial", 0.75, []string{"auto-partial-1", "auto-partial-2", "auto-partial-3", "auto-partial-4"})
+
+		verifyStation(t, consensusService, storageService, "auto-partial-1")
+		verifyStation(t, consensusService, storageService, "auto-partial-2")
+		if status := processStatus(t, storageService, "auto-partial").Status; status != "Active" {
+			t.Errorf("Expected process to stay Active at 50%% verified, got %s", status)
+		}
+
+		verifyStation(t, consensusService, storageService, "auto-partial-3")
+		if status := processStatus(t, storageService, "auto-partial").Status; status != "Complete" {
+			t.Errorf("Expected process to complete at 75%% verified, got %s", status)
+		}
+	})
+
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		consensusService, storageService := setupConsensusTest()
+		setupProcess(t, storageService, "auto-off", 0, []string{"auto-off-1"})
+
+		verifyStation(t, consensusService, storageService, "auto-off-1")
+		if status := processStatus(t, storageService, "auto-off").Status; status != "Active" {
+			t.Errorf("Expected process without auto-completion to stay Active, got %s", status)
+		}
+	})
+}
+
+func TestCountLocationClusters(t *testing.T) {
+	at := func(latitude, longitude float64) models.Submission {
+		return models.Submission{GPSCoordinates: models.GPSCoordinates{Latitude: latitude, Longitude: longitude}}
+	}
+
+	tests := []struct {
+		name        string
+		submissions []models.Submission
+		expected    int
+	}{
+		{"Empty", nil, 0},
+		{"SameSpot", []models.Submission{at(0, 0), at(0, 0), at(0, 0)}, 1},
+		{"FarApart", []models.Submission{at(0, 0), at(0, 0.01), at(0, 0.02)}, 3},
+		// Each neighbour is ~55m from the next, so the chain forms one cluster at a 100m radius
+		{"ChainedNeighbours", []models.Submission{at(0, 0), at(0, 0.0005), at(0, 0.001)}, 1},
+	}
+
+	for _, test := range tests {
+		if clusters := countLocationClusters(test.submissions, DefaultClusterRadiusMeters); clusters != test.expected {
+			t.Errorf("%s: expected %d clusters, got %d", test.name, test.expected, clusters)
+		}
+	}
+}
+
+func TestClusterCoordinates(t *testing.T) {
+	at := func(latitude, longitude float64) models.GPSCoordinates {
+		return models.GPSCoordinates{Latitude: latitude, Longitude: longitude}
+	}
+
+	tests := []struct {
+		name         string
+		coords       []models.GPSCoordinates
+		radiusMeters float64
+		expected     [][]int
+	}{
+		{"Empty", nil, DefaultClusterRadiusMeters, [][]int{}},
+		// All points lie within ~20m of each other
+		{"OneCluster", []models.GPSCoordinates{at(-1.2921, 36.8219), at(-1.2922, 36.8220), at(-1.2920, 36.8218)}, DefaultClusterRadiusMeters, [][]int{{0, 1, 2}}},
+		// Two groups ~1.1km apart, interleaved in the input
+		{"TwoClusters", []models.GPSCoordinates{at(0, 0), at(0, 0.01), at(0, 0.0001), at(0, 0.0101)}, DefaultClusterRadiusMeters, [][]int{{0, 2}, {1, 3}}},
+		{"AllSeparate", []models.GPSCoordinates{at(0, 0), at(0, 0.01), at(0, 0.02)}, DefaultClusterRadiusMeters, [][]int{{0}, {1}, {2}}},
+		// The same ~1.1km spread is one cluster with a wider radius
+		{"ConfigurableRadius", []models.GPSCoordinates{at(0, 0), at(0, 0.01), at(0, 0.02)}, 2000, [][]int{{0, 1, 2}}},
+	}
+
+	for _, test := range tests {
+		clusters := ClusterCoordinates(test.coords, test.radiusMeters)
+		if !reflect.DeepEqual(clusters, test.expected) {
+			t.Errorf("%s: expected clusters %v, got %v", test.name, test.expected, clusters)
+		}
+	}
+}
+
 // Test wallet uniqueness enforcement
 func TestConsensusService_ProcessConsensus_WalletUniqueness(t *testing.T) {
 	consensusService, storageService := setupConsensusTest()
@@ -744,4 +1285,641 @@ func TestConsensusService_ProcessConsensus_WalletUniqueness(t *testing.T) {
 	if len(submissions) != 3 {
 		t.Errorf("Expected 3 submissions after duplicate handling, got %d", len(submissions))
 	}
-}
\ No newline at end of file
+}
+
+func TestConsensusService_OutcomeMetrics(t *testing.T) {
+	consensusService, storageService := setupConsensusTest()
+	consensusService.SetConsensusThreshold(2)
+
+	storeSubmission := func(stationID string, wallet int, results map[string]int) {
+		submission := models.Submission{
+			ID:               fmt.Sprintf("%s-%d", stationID, wallet),
+			WalletAddress:    fmt.Sprintf("metrics-wallet-%d", wallet),
+			PollingStationID: stationID,
+			Timestamp:        time.Now(),
+			Results:          results,
+			SubmissionType:   "image_ocr",
+		}
+		if err := storageService.StoreSubmission(submission); err != nil {
+			t.Fatalf("StoreSubmission() error = %v", err)
+		}
+	}
+
+	resultsA := map[string]int{"Candidate A": 100, "Candidate B": 50}
+	resultsB := map[string]int{"Candidate A": 90, "Candidate B": 60}
+	resultsC := map[string]int{"Candidate A": 80, "Candidate B": 70}
+
+	// Verified: two agreeing wallets out of two
+	storeSubmission("METRICS_VERIFIED", 1, resultsA)
+	storeSubmission("METRICS_VERIFIED", 2, resultsA)
+
+	// Pending: three wallets, all disagreeing
+	storeSubmission("METRICS_PENDING", 1, resultsA)
+	storeSubmission("METRICS_PENDING", 2, resultsB)
+	storeSubmission("METRICS_PENDING", 3, resultsC)
+
+	// Tie: two groups of two wallets each
+	storeSubmission("METRICS_TIE", 1, resultsA)
+	storeSubmission("METRICS_TIE", 2, resultsA)
+	storeSubmission("METRICS_TIE", 3, resultsB)
+	storeSubmission("METRICS_TIE", 4, resultsB)
+
+	counterValues := func() map[string]int64 {
+		values := make(map[string]int64)
+		for _, counter := range consensusService.Metrics() {
+			values[counter.Name()] = counter.Value()
+		}
+		return values
+	}
+
+	result, err := consensusService.ProcessConsensus("METRICS_VERIFIED")
+	if err != nil || result.Status != "Verified" {
+		t.Fatalf("Expected verified result, got %v (err: %v)", result, err)
+	}
+	if values := counterValues(); values["oyah_consensus_verified_total"] != 1 {
+		t.Errorf("Expected verified counter 1, got %d", values["oyah_consensus_verified_total"])
+	}
+
+	result, err = consensusService.ProcessConsensus("METRICS_PENDING")
+	if err != nil || result.Status != "Pending" {
+		t.Fatalf("Expected pending result, got %v (err: %v)", result, err)
+	}
+	if values := counterValues(); values["oyah_consensus_pending_total"] != 1 {
+		t.Errorf("Expected pending counter 1, got %d", values["oyah_consensus_pending_total"])
+	}
+
+	result, err = consensusService.ProcessConsensus("METRICS_TIE")
+	if err != nil || result.Status != "Pending" {
+		t.Fatalf("Expected pending tie result, got %v (err: %v)", result, err)
+	}
+	if !strings.Contains(result.Message, "tied") {
+		t.Errorf("Expected tie message, got %s", result.Message)
+	}
+
+	values := counterValues()
+	if values["oyah_consensus_tie_total"] != 1 {
+		t.Errorf("Expected tie counter 1, got %d", values["oyah_consensus_tie_total"])
+	}
+	if values["oyah_consensus_verified_total"] != 1 || values["oyah_consensus_pending_total"] != 1 {
+		t.Errorf("Expected other counters unchanged by tie, got %v", values)
+	}
+}
+
+// Test tolerance mode grouping of near-identical results
+func TestConsensusService_ProcessConsensus_ConfidenceTieBreak(t *testing.T) {
+	resultsA := map[string]int{"Candidate A": 100, "Candidate B": 50}
+	resultsB := map[string]int{"Candidate A": 90, "Candidate B": 60}
+
+	tests := []struct {
+		name           string
+		tieBreak       bool
+		confidenceB    float64
+		expectedStatus string
+		expectVerified map[string]int
+	}{
+		{"policy enabled verifies the more confident group", true, 0.95, "Verified", resultsB},
+		{"policy disabled stays pending", false, 0.95, "Pending", nil},
+		{"policy enabled with equal confidence stays pending", true, 0.60, "Pending", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			consensusService, storageService := setupConsensusTest()
+			consensusService.SetConsensusThreshold(2)
+			consensusService.SetConfidenceTieBreak(tt.tieBreak)
+
+			// A 2-2 tie: group A reported with low confidence, group B with confidenceB
+			submissions := []struct {
+				results    map[string]int
+				confidence float64
+			}{
+				{resultsA, 0.60},
+				{resultsA, 0.60},
+				{resultsB, tt.confidenceB},
+				{resultsB, tt.confidenceB},
+			}
+			for i, sub := range submissions {
+				submission := models.Submission{
+					ID:               fmt.Sprintf("tie-%d", i),
+					WalletAddress:    fmt.Sprintf("tie-wallet-%d", i),
+					PollingStationID: "TIE_STATION",
+					Timestamp:        time.Now(),
+					Results:          sub.results,
+					SubmissionType:   "image_ocr",
+					Confidence:       sub.confidence,
+				}
+				if err := storageService.StoreSubmission(submission); err != nil {
+					t.Fatalf("StoreSubmission() error = %v", err)
+				}
+			}
+
+			result, err := consensusService.ProcessConsensus("TIE_STATION")
+			if err != nil {
+				t.Fatalf("ProcessConsensus() error = %v", err)
+			}
+
+			if result.Status != tt.expectedStatus {
+				t.Fatalf("Expected status %s, got %s (%s)", tt.expectedStatus, result.Status, result.Message)
+			}
+			if !reflect.DeepEqual(result.VerifiedResults, tt.expectVerified) {
+				t.Errorf("Expected verified results %v, got %v", tt.expectVerified, result.VerifiedResults)
+			}
+			if !strings.Contains(result.Message, "tied") {
+				t.Errorf("Expected tie message, got %s", result.Message)
+			}
+
+			station, err := storageService.GetPollingStation("TIE_STATION")
+			if err != nil {
+				t.Fatalf("GetPollingStation() error = %v", err)
+			}
+			if station.Status != tt.expectedStatus {
+				t.Errorf("Expected station status %s, got %s", tt.expectedStatus, station.Status)
+			}
+		})
+	}
+}
+
+func TestConsensusService_ScaledMinAgreeingWallets(t *testing.T) {
+	tests := []struct {
+		name             string
+		scaling          float64
+		totalSubmissions int
+		expected         int
+	}{
+		{"scaling disabled keeps the fixed threshold", 0, 50, 3},
+		{"low volume keeps the fixed threshold", 0.2, 10, 3},
+		{"threshold scales at high volume", 0.2, 50, 10},
+		{"partial wallets round up", 0.2, 31, 7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			consensusService, _ := setupConsensusTest()
+			consensusService.SetThresholdScaling(tt.scaling)
+
+			if got := consensusService.scaledMinAgreeingWallets(tt.totalSubmissions); got != tt.expected {
+				t.Errorf("scaledMinAgreeingWallets(%d) = %d, want %d", tt.totalSubmissions, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestConsensusService_ProcessConsensus_ThresholdScaling(t *testing.T) {
+	tests := []struct {
+		name           string
+		scaling        float64
+		tieBreak       bool
+		groupSizes     []int // Wallets reporting each distinct result; the first group is the most confident
+		expectedStatus string
+	}{
+		{"low volume verifies with the fixed threshold", 0.2, false, []int{3, 1}, "Verified"},
+		{"high volume majority verifies without scaling", 0, false, []int{6, 2, 2}, "Verified"},
+		{"high volume majority below the scaled threshold stays pending", 0.7, false, []int{6, 2, 2}, "Pending"},
+		{"high volume tie-break verifies without scaling", 0, true, []int{5, 5, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}, "Verified"},
+		{"high volume tie-break below the scaled threshold stays pending", 0.2, true, []int{5, 5, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}, "Pending"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			consensusService, storageService := setupConsensusTest()
+			consensusService.SetThresholdScaling(tt.scaling)
+			consensusService.SetConfidenceTieBreak(tt.tieBreak)
+
+			wallet := 0
+			for group, size := range tt.groupSizes {
+				confidence := 0.6
+				if group == 0 {
+					confidence = 0.9
+				}
+				for i := 0; i < size; i++ {
+					submission := models.Submission{
+						ID:               fmt.Sprintf("scale-%d", wallet),
+						WalletAddress:    fmt.Sprintf("scale-wallet-%d", wallet),
+						PollingStationID: "SCALE_STATION",
+						Timestamp:        time.Now(),
+						Results:          map[string]int{"Candidate A": 100 + group, "Candidate B": 50},
+						SubmissionType:   "image_ocr",
+						Confidence:       confidence,
+					}
+					if err := storageService.StoreSubmission(submission); err != nil {
+						t.Fatalf("StoreSubmission() error = %v", err)
+					}
+					wallet++
+				}
+			}
+
+			result, err := consensusService.ProcessConsensus("SCALE_STATION")
+			if err != nil {
+				t.Fatalf("ProcessConsensus() error = %v", err)
+			}
+			if result.Status != tt.expectedStatus {
+				t.Errorf("Expected status %s, got %s (%s)", tt.expectedStatus, result.Status, result.Message)
+			}
+		})
+	}
+}
+
+func TestConsensusService_EffectiveThreshold(t *testing.T) {
+	consensusService, storageService := setupConsensusTest()
+	consensusService.SetThresholdScaling(0.2)
+
+	err := storageService.StoreVotingProcess(models.VotingProcess{
+		ID:              "vp-effective",
+		PollingStations: []string{"QUIET_STATION", "BUSY_STATION"},
+		Status:          "Active",
+		CreatedAt:       time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("StoreVotingProcess() error = %v", err)
+	}
+
+	// Every witness agrees, so only the submission volume differs between the two stations
+	submissionCounts := map[string]int{"QUIET_STATION": 4, "BUSY_STATION": 40}
+	for stationID, count := range submissionCounts {
+		for i := 0; i < count; i++ {
+			submission := models.Submission{
+				ID:               fmt.Sprintf("%s-sub-%d", stationID, i),
+				WalletAddress:    fmt.Sprintf("%s-wallet-%d", stationID, i),
+				PollingStationID: stationID,
+				Timestamp:        time.Now(),
+				Results:          map[string]int{"Candidate A": 100, "Candidate B": 50},
+				SubmissionType:   "image_ocr",
+				Confidence:       0.9,
+			}
+			if err := storageService.StoreSubmission(submission); err != nil {
+				t.Fatalf("StoreSubmission() error = %v", err)
+			}
+		}
+	}
+
+	// The quiet station keeps the fixed minimum of 3; the busy one needs ceil(0.2 * 40) agreeing wallets
+	expected := map[string]int{"QUIET_STATION": 3, "BUSY_STATION": 8}
+	for stationID, threshold := range expected {
+		result, err := consensusService.ProcessConsensus(stationID)
+		if err != nil {
+			t.Fatalf("ProcessConsensus(%s) error = %v", stationID, err)
+		}
+		if result.EffectiveThreshold != threshold {
+			t.Errorf("ProcessConsensus(%s) effective threshold = %d, want %d", stationID, result.EffectiveThreshold, threshold)
+		}
+
+		status, err := consensusService.GetConsensusStatus(stationID)
+		if err != nil {
+			t.Fatalf("GetConsensusStatus(%s) error = %v", stationID, err)
+		}
+		if status.EffectiveThreshold != threshold {
+			t.Errorf("GetConsensusStatus(%s) effective threshold = %d, want %d", stationID, status.EffectiveThreshold, threshold)
+		}
+	}
+
+	busy, _ := consensusService.GetConsensusStatus("BUSY_STATION")
+	quiet, _ := consensusService.GetConsensusStatus("QUIET_STATION")
+	if busy.EffectiveThreshold <= quiet.EffectiveThreshold {
+		t.Errorf("Expected the busy station's threshold %d to exceed the quiet station's %d", busy.EffectiveThreshold, quiet.EffectiveThreshold)
+	}
+}
+
+func TestConsensusService_ProcessConsensus_Explanation(t *testing.T) {
+	resultsA := map[string]int{"Candidate A": 100, "Candidate B": 50}
+	resultsB := map[string]int{"Candidate A": 90, "Candidate B": 60}
+	resultsC := map[string]int{"Candidate A": 80, "Candidate B": 70}
+
+	tests := []struct {
+		name           string
+		results        []map[string]int
+		expectedStatus string
+		expectedParts  []string
+	}{
+		{
+			name:           "verified",
+			results:        []map[string]int{resultsA, resultsA, resultsA, resultsB},
+			expectedStatus: "Verified",
+			expectedParts: []string{
+				"Received 4 submissions from 4 unique wallets, forming 2 distinct result groups of 3, 1 wallets",
+				"a threshold of 3 submissions",
+				"at least 3 agreeing wallets",
+				"more than 50% of submissions (more than 2.0 of 4)",
+				"Decision: Verified - Consensus reached with 3 wallets",
+			},
+		},
+		{
+			name:           "no majority",
+			results:        []map[string]int{resultsA, resultsB, resultsC},
+			expectedStatus: "Pending",
+			expectedParts: []string{
+				"Received 3 submissions from 3 unique wallets, forming 3 distinct result groups of 1, 1, 1 wallets",
+				"a threshold of 3 submissions",
+				"more than 50% of submissions (more than 1.5 of 3)",
+				"Decision: Pending - ",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			consensusService, storageService := setupConsensusTest()
+
+			for i, results := range tt.results {
+				submission := models.Submission{
+					ID:               fmt.Sprintf("explain-%d", i),
+					WalletAddress:    fmt.Sprintf("explain-wallet-%d", i),
+					PollingStationID: "EXPLAIN_STATION",
+					Timestamp:        time.Now(),
+					Results:          results,
+					SubmissionType:   "image_ocr",
+				}
+				if err := storageService.StoreSubmission(submission); err != nil {
+					t.Fatalf("StoreSubmission() error = %v", err)
+				}
+			}
+
+			result, err := consensusService.ProcessConsensus("EXPLAIN_STATION")
+			if err != nil {
+				t.Fatalf("ProcessConsensus() error = %v", err)
+			}
+			if result.Status != tt.expectedStatus {
+				t.Fatalf("Expected status %s, got %s", tt.expectedStatus, result.Status)
+			}
+
+			for _, part := range tt.expectedParts {
+				if !strings.Contains(result.Explanation, part) {
+					t.Errorf("Expected explanation to contain %q, got %q", part, result.Explanation)
+				}
+			}
+
+			// The explanation is kept on the station and reported with its status
+			station, err := storageService.GetPollingStation("EXPLAIN_STATION")
+			if err != nil {
+				t.Fatalf("GetPollingStation() error = %v", err)
+			}
+			if station.ConsensusExplanation != result.Explanation {
+				t.Errorf("Expected station explanation %q, got %q", result.Explanation, station.ConsensusExplanation)
+			}
+			status, err := consensusService.GetConsensusStatus("EXPLAIN_STATION")
+			if err != nil {
+				t.Fatalf("GetConsensusStatus() error = %v", err)
+			}
+			if status.Explanation != result.Explanation {
+				t.Errorf("Expected status explanation %q, got %q", result.Explanation, status.Explanation)
+			}
+		})
+	}
+}
+
+func TestConsensusService_GroupSubmissionsWithinTolerance(t *testing.T) {
+	submissions := []models.Submission{
+		{ID: "sub1", WalletAddress: "wallet1", Results: map[string]int{"Candidate A": 100, "Candidate B": 150}},
+		{ID: "sub2", WalletAddress: "wallet2", Results: map[string]int{"Candidate A": 102, "Candidate B": 149}},
+		{ID: "sub3", WalletAddress: "wallet3", Results: map[string]int{"Candidate A": 98, "Candidate B": 152}},
+		{ID: "sub4", WalletAddress: "wallet4", Results: map[string]int{"Candidate A": 130, "Candidate B": 120}},
+	}
+
+	t.Run("ExactModeKeepsGroupsSeparate", func(t *testing.T) {
+		consensusService, _ := setupConsensusTest()
+
+		groups := consensusService.groupSubmissionsByResults(submissions)
+		if len(groups) != 4 {
+			t.Errorf("Expected 4 groups in exact mode, got %d", len(groups))
+		}
+	})
+
+	t.Run("AbsoluteToleranceGroupsNearIdentical", func(t *testing.T) {
+		consensusService, _ := setupConsensusTest()
+		consensusService.SetTolerance(2, 0)
+
+		groups := consensusService.groupSubmissionsByResults(submissions)
+		if len(groups) != 2 {
+			t.Fatalf("Expected 2 groups under tolerance, got %d", len(groups))
+		}
+
+		var largest *SubmissionGroup
+		for _, group := range groups {
+			if largest == nil || group.WalletCount > largest.WalletCount {
+				largest = group
+			}
+		}
+		if largest.WalletCount != 3 {
+			t.Errorf("Expected 3 wallets in the tolerant group, got %d", largest.WalletCount)
+		}
+
+		// Verified results are the per-candidate median
+		if largest.Results["Candidate A"] != 100 || largest.Results["Candidate B"] != 150 {
+			t.Errorf("Expected median results A:100 B:150, got %v", largest.Results)
+		}
+	})
+
+	t.Run("PercentageTolerance", func(t *testing.T) {
+		consensusService, _ := setupConsensusTest()
+		consensusService.SetTolerance(0, 2) // 2% of 150 allows a 3 vote difference
+
+		groups := consensusService.groupSubmissionsByResults(submissions)
+		if len(groups) != 2 {
+			t.Errorf("Expected 2 groups under percentage tolerance, got %d", len(groups))
+		}
+	})
+
+	t.Run("DifferentCandidatesNeverGrouped", func(t *testing.T) {
+		consensusService, _ := setupConsensusTest()
+		consensusService.SetTolerance(5, 0)
+
+		groups := consensusService.groupSubmissionsByResults([]models.Submission{
+			{ID: "sub1", WalletAddress: "wallet1", Results: map[string]int{"Candidate A": 100}},
+			{ID: "sub2", WalletAddress: "wallet2", Results: map[string]int{"Candidate A": 100, "spoilt": 0}},
+		})
+		if len(groups) != 2 {
+			t.Errorf("Expected 2 groups for different candidate sets, got %d", len(groups))
+		}
+	})
+}
+
+// Test that tolerance mode lets near-identical witnesses reach consensus
+func TestConsensusService_ProcessConsensus_WithTolerance(t *testing.T) {
+	consensusService, storageService := setupConsensusTest()
+	consensusService.SetTolerance(2, 0)
+
+	results := []map[string]int{
+		{"Candidate A": 100, "Candidate B": 150},
+		{"Candidate A": 101, "Candidate B": 148},
+		{"Candidate A": 99, "Candidate B": 150},
+	}
+	for i, r := range results {
+		storageService.StoreSubmission(models.Submission{
+			ID:               fmt.Sprintf("tol-sub-%d", i),
+			WalletAddress:    fmt.Sprintf("tol-wallet-%d", i),
+			PollingStationID: "TOL001",
+			Results:          r,
+			SubmissionType:   "image_ocr",
+		})
+	}
+
+	result, err := consensusService.ProcessConsensus("TOL001")
+	if err != nil {
+		t.Fatalf("ProcessConsensus() error = %v", err)
+	}
+	if result.Status != "Verified" {
+		t.Fatalf("Expected Verified status under tolerance, got %s (%s)", result.Status, result.Message)
+	}
+	if result.VerifiedResults["Candidate A"] != 100 || result.VerifiedResults["Candidate B"] != 150 {
+		t.Errorf("Expected median results A:100 B:150, got %v", result.VerifiedResults)
+	}
+}
+
+func TestConsensusService_AgreementScore(t *testing.T) {
+	majority := map[string]int{"Candidate A": 120, "Candidate B": 80}
+
+	tests := []struct {
+		name          string
+		results       []map[string]int
+		expectedScore float64
+	}{
+		{
+			name: "three of five witnesses agree",
+			results: []map[string]int{
+				majority, majority, majority,
+				{"Candidate A": 80, "Candidate B": 120},
+				{"Candidate A": 100, "Candidate B": 100},
+			},
+			expectedScore: 0.6,
+		},
+		{
+			name:          "unanimous witnesses",
+			results:       []map[string]int{majority, majority, majority},
+			expectedScore: 1.0,
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			consensusService, storageService := setupConsensusTest()
+			stationID := fmt.Sprintf("AGREEMENT_STATION_%d", i)
+
+			for j, results := range tt.results {
+				submission := models.Submission{
+					ID:               fmt.Sprintf("agreement-%d-%d", i, j),
+					WalletAddress:    fmt.Sprintf("agreement-wallet-%d", j),
+					PollingStationID: stationID,
+					Timestamp:        time.Now(),
+					Results:          results,
+					SubmissionType:   "image_ocr",
+					Confidence:       0.9,
+				}
+				if err := storageService.StoreSubmission(submission); err != nil {
+					t.Fatalf("StoreSubmission() error = %v", err)
+				}
+			}
+
+			result, err := consensusService.ProcessConsensus(stationID)
+			if err != nil {
+				t.Fatalf("ProcessConsensus() error = %v", err)
+			}
+			if result.Status != "Verified" {
+				t.Fatalf("Expected Verified, got %s", result.Status)
+			}
+			if result.AgreementScore != tt.expectedScore {
+				t.Errorf("Expected agreement score %v, got %v", tt.expectedScore, result.AgreementScore)
+			}
+
+			status, err := consensusService.GetConsensusStatus(stationID)
+			if err != nil {
+				t.Fatalf("GetConsensusStatus() error = %v", err)
+			}
+			if status.AgreementScore != tt.expectedScore {
+				t.Errorf("Expected stored agreement score %v, got %v", tt.expectedScore, status.AgreementScore)
+			}
+		})
+	}
+}
+
+func TestConsensusService_PostVerificationPolicy(t *testing.T) {
+	verified := map[string]int{"Candidate A": 120, "Candidate B": 80}
+	disputed := map[string]int{"Candidate A": 80, "Candidate B": 120}
+
+	tests := []struct {
+		policy           string
+		lateResults      map[string]int
+		expectedStatus   string
+		confidenceChange int // -1 lowered, 0 unchanged, 1 raised
+	}{
+		{PostVerificationRecompute, verified, "Verified", 1},
+		{PostVerificationRecompute, disputed, "Verified", -1},
+		{PostVerificationLock, verified, "Verified", 0},
+		{PostVerificationLock, disputed, "Verified", 0},
+		{PostVerificationUpdateConfidence, verified, "Verified", 1},
+		{PostVerificationUpdateConfidence, disputed, "Verified", 0},
+		{PostVerificationReopen, verified, "Verified", 1},
+		{PostVerificationReopen, disputed, "Pending", -1},
+	}
+
+	for i, tt := range tests {
+		agreement := "agreeing"
+		if reflect.DeepEqual(tt.lateResults, disputed) {
+			agreement = "disagreeing"
+		}
+
+		t.Run(tt.policy+" "+agreement, func(t *testing.T) {
+			consensusService, storageService := setupConsensusTest()
+			if err := consensusService.SetPostVerificationPolicy(tt.policy); err != nil {
+				t.Fatalf("SetPostVerificationPolicy() error = %v", err)
+			}
+			stationID := fmt.Sprintf("POST_VERIFICATION_STATION_%d", i)
+
+			store := func(index int, results map[string]int) {
+				submission := models.Submission{
+					ID:               fmt.Sprintf("post-verification-%d-%d", i, index),
+					WalletAddress:    fmt.Sprintf("post-verification-wallet-%d", index),
+					PollingStationID: stationID,
+					Timestamp:        time.Now(),
+					Results:          results,
+					SubmissionType:   "image_ocr",
+					Confidence:       0.9,
+				}
+				if err := storageService.StoreSubmission(submission); err != nil {
+					t.Fatalf("StoreSubmission() error = %v", err)
+				}
+			}
+
+			// Three of four wallets agree, verifying the station
+			for j, results := range []map[string]int{verified, verified, verified, disputed} {
+				store(j, results)
+			}
+			initial, err := consensusService.ProcessConsensus(stationID)
+			if err != nil {
+				t.Fatalf("ProcessConsensus() error = %v", err)
+			}
+			if initial.Status != "Verified" {
+				t.Fatalf("Expected the station to verify before the late submission, got %s", initial.Status)
+			}
+
+			store(4, tt.lateResults)
+			result, err := consensusService.ProcessConsensus(stationID)
+			if err != nil {
+				t.Fatalf("ProcessConsensus() error = %v", err)
+			}
+
+			if result.Status != tt.expectedStatus {
+				t.Errorf("Expected status %s, got %s", tt.expectedStatus, result.Status)
+			}
+
+			change := 0
+			if result.ConfidenceLevel > initial.ConfidenceLevel {
+				change = 1
+			} else if result.ConfidenceLevel < initial.ConfidenceLevel {
+				change = -1
+			}
+			if change != tt.confidenceChange {
+				t.Errorf("Expected confidence change %d, got %d (%.2f -> %.2f)", tt.confidenceChange, change, initial.ConfidenceLevel, result.ConfidenceLevel)
+			}
+		})
+	}
+}
+
+func TestConsensusService_SetPostVerificationPolicyRejectsUnknown(t *testing.T) {
+	consensusService, _ := setupConsensusTest()
+
+	if err := consensusService.SetPostVerificationPolicy("freeze"); err == nil {
+		t.Error("Expected an error for an unknown post-verification policy")
+	}
+	if err := consensusService.SetPostVerificationPolicy(""); err != nil {
+		t.Errorf("Expected the empty policy to restore the default, got %v", err)
+	}
+}
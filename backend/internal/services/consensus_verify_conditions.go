@@ -0,0 +1,100 @@
+package services
+
+import (
+	"fmt"
+
+	"oyah-backend/internal/models"
+)
+
+// VerifyConditions describes the consensus parameters under which a station's current largest result group would verify.
+// The group verifies once the threshold is at most MaxConsensusThreshold, the minimum agreeing wallets at most
+// MaxMinAgreeingWallets, and the majority percentage strictly below MajorityPercentageBelow.
+type VerifyConditions struct {
+	PollingStationID        string               `json:"pollingStationId"`
+	TotalSubmissions        int                  `json:"totalSubmissions"` // Unflagged submissions counted towards consensus
+	LargestGroupWallets     int                  `json:"largestGroupWallets"`
+	LargestGroupResults     map[string]int       `json:"largestGroupResults,omitempty"`
+	TiedGroups              int                  `json:"tiedGroups"`              // Result groups sharing the largest wallet count
+	MaxConsensusThreshold   int                  `json:"maxConsensusThreshold"`   // Highest submission threshold already met
+	MaxMinAgreeingWallets   int                  `json:"maxMinAgreeingWallets"`   // Highest agreeing-wallet minimum the group meets
+	MajorityPercentageBelow float64              `json:"majorityPercentageBelow"` // The group's share of submissions, in percent
+	CurrentCriteria         VerificationCriteria `json:"currentCriteria"`
+	Blockers                []string             `json:"blockers"` // Current rules the group fails; empty if it would verify
+}
+
+// GetVerifyConditions reports the least strict consensus parameters under which a polling station's largest result group
+// would verify, alongside the rules currently in force and which of them hold it back.
+func (c *ConsensusService) GetVerifyConditions(pollingStationID string) (*VerifyConditions, error) {
+	station, err := c.storageService.GetPollingStation(pollingStationID)
+	if err != nil {
+		return nil, err
+	}
+
+	var process *models.VotingProcess
+	if station.VotingProcessID != "" {
+		if found, err := c.storageService.GetVotingProcess(station.VotingProcessID); err == nil {
+			process = found
+		}
+	}
+
+	submissions := unflaggedSubmissions(c.storageService.GetSubmissionsByStation(pollingStationID))
+	resultGroups := c.groupSubmissionsByResults(submissions)
+
+	conditions := &VerifyConditions{
+		PollingStationID:      pollingStationID,
+		TotalSubmissions:      len(submissions),
+		MaxConsensusThreshold: len(submissions),
+		CurrentCriteria:       c.VerificationCriteria(process),
+		Blockers:              []string{},
+	}
+	if len(submissions) == 0 {
+		conditions.Blockers = append(conditions.Blockers, "no submissions received yet")
+		return conditions, nil
+	}
+
+	// Break ties on the result key, as GetLeadingGroup does, so the reported group is stable between calls
+	var largestGroup *SubmissionGroup
+	largestKey := ""
+	for key, group := range resultGroups {
+		switch {
+		case largestGroup == nil || group.WalletCount > largestGroup.WalletCount:
+			largestGroup, largestKey = group, key
+			conditions.TiedGroups = 1
+		case group.WalletCount == largestGroup.WalletCount:
+			conditions.TiedGroups++
+			if key < largestKey {
+				largestGroup, largestKey = group, key
+			}
+		}
+	}
+
+	conditions.LargestGroupWallets = largestGroup.WalletCount
+	conditions.LargestGroupResults = largestGroup.Results
+	conditions.MaxMinAgreeingWallets = largestGroup.WalletCount
+	conditions.MajorityPercentageBelow = float64(largestGroup.WalletCount) / float64(len(submissions)) * 100
+
+	if len(submissions) < c.threshold {
+		conditions.Blockers = append(conditions.Blockers,
+			fmt.Sprintf("threshold of %d submissions not reached (%d received)", c.threshold, len(submissions)))
+	}
+	if minAgreeing := c.scaledMinAgreeingWallets(len(submissions)); largestGroup.WalletCount < minAgreeing {
+		conditions.Blockers = append(conditions.Blockers,
+			fmt.Sprintf("largest group has %d agreeing wallets (minimum agreeing: %d)", largestGroup.WalletCount, minAgreeing))
+	}
+	if conditions.MajorityPercentageBelow <= majorityPercentage {
+		conditions.Blockers = append(conditions.Blockers,
+			fmt.Sprintf("largest group holds %.1f%% of submissions (majority requires more than %.0f%%)", conditions.MajorityPercentageBelow, majorityPercentage))
+	}
+	if conditions.TiedGroups > 1 && !c.confidenceTieBreak {
+		conditions.Blockers = append(conditions.Blockers,
+			fmt.Sprintf("%d result groups tied with %d wallets each", conditions.TiedGroups, largestGroup.WalletCount))
+	}
+	if minClusters := c.minLocationClusters(pollingStationID); minClusters > 1 {
+		if clusters := countLocationClusters(largestGroup.Submissions, c.clusterRadiusMeters); clusters < minClusters {
+			conditions.Blockers = append(conditions.Blockers,
+				fmt.Sprintf("agreeing witnesses come from %d locations (minimum: %d)", clusters, minClusters))
+		}
+	}
+
+	return conditions, nil
+}
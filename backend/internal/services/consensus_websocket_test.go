@@ -1,9 +1,15 @@
 package services
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -250,4 +256,284 @@ func copyResults(original map[string]int) map[string]int {
 		copy[k] = v
 	}
 	return copy
-}
\ No newline at end of file
+}
+
+func TestConsensusService_FlaggedSubmissionsRevertVerifiedStation(t *testing.T) {
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel) // Suppress logs during testing
+
+	storageService := NewStorageService()
+	consensusService := NewConsensusService(storageService, logger)
+	tallyService := NewTallyService(storageService, logger)
+	webSocketService := NewWebSocketService(tallyService, logger)
+	consensusService.SetWebSocketService(webSocketService)
+
+	require.NoError(t, storageService.StoreVotingProcess(models.VotingProcess{
+		ID:       "flag-process",
+		Title:    "Flag Election",
+		Position: "President",
+		Candidates: []models.Candidate{
+			{ID: "1", Name: "Candidate 1"},
+			{ID: "2", Name: "Candidate 2"},
+		},
+		PollingStations: []string{"flag-station"},
+		Status:          "Active",
+		CreatedAt:       time.Now(),
+	}))
+
+	// Three agreeing wallets verify the station over one dissenting wallet
+	agreed := map[string]int{"Candidate 1": 100, "Candidate 2": 150}
+	dissent := map[string]int{"Candidate 1": 120, "Candidate 2": 130}
+	for i, results := range []map[string]int{agreed, agreed, agreed, dissent} {
+		require.NoError(t, storageService.StoreSubmission(models.Submission{
+			ID:               fmt.Sprintf("flag-sub-%d", i),
+			WalletAddress:    generateWalletAddress(i),
+			PollingStationID: "flag-station",
+			Timestamp:        time.Now(),
+			Results:          copyResults(results),
+			SubmissionType:   "image_ocr",
+			Confidence:       0.95,
+		}))
+	}
+	result, err := consensusService.ProcessConsensus("flag-station")
+	require.NoError(t, err)
+	require.Equal(t, "Verified", result.Status)
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/ws", webSocketService.HandleConnection)
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/ws", nil)
+	if err != nil {
+		t.Skipf("WebSocket connection failed (expected in test environment): %v", err)
+		return
+	}
+	defer conn.Close()
+	require.Eventually(t, func() bool { return webSocketService.GetConnectedClientCount() == 1 }, 2*time.Second, 10*time.Millisecond)
+
+	// Flagging two of the agreeing submissions breaks the majority
+	for _, id := range []string{"flag-sub-0", "flag-sub-1"} {
+		_, err := storageService.FlagSubmission(id, "coordinated fraud")
+		require.NoError(t, err)
+	}
+	result, err = consensusService.RecomputeConsensus("flag-station")
+	require.NoError(t, err)
+	assert.Equal(t, "Pending", result.Status)
+
+	station, err := storageService.GetPollingStation("flag-station")
+	require.NoError(t, err)
+	assert.Equal(t, "Pending", station.Status)
+
+	tally, err := tallyService.GetTallyData("flag-process")
+	require.NoError(t, err)
+	assert.Zero(t, tally.AggregatedTally["Candidate 1"])
+
+	// The regression is broadcast alongside the tally update
+	var change struct {
+		Type string `json:"type"`
+		Data struct {
+			VotingProcessID  string `json:"votingProcessId"`
+			PollingStationID string `json:"pollingStationId"`
+			PreviousStatus   string `json:"previousStatus"`
+			Status           string `json:"status"`
+		} `json:"data"`
+	}
+	for change.Type != "station_status_changed" {
+		conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+		_, frame, err := conn.ReadMessage()
+		require.NoError(t, err)
+
+		// Queued messages may share a frame, one per line
+		for _, message := range strings.Split(string(frame), "\n") {
+			require.NoError(t, json.Unmarshal([]byte(message), &change))
+			if change.Type == "station_status_changed" {
+				break
+			}
+		}
+	}
+	assert.Equal(t, "flag-process", change.Data.VotingProcessID)
+	assert.Equal(t, "flag-station", change.Data.PollingStationID)
+	assert.Equal(t, "Verified", change.Data.PreviousStatus)
+	assert.Equal(t, "Pending", change.Data.Status)
+}
+
+func TestConsensusService_RecomputeConsensusWithoutSubmissions(t *testing.T) {
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel) // Suppress logs during testing
+
+	storageService := NewStorageService()
+	consensusService := NewConsensusService(storageService, logger)
+
+	require.NoError(t, storageService.StoreSubmission(models.Submission{
+		ID:               "only-sub",
+		WalletAddress:    generateWalletAddress(0),
+		PollingStationID: "lonely-station",
+		Timestamp:        time.Now(),
+		Results:          map[string]int{"Candidate 1": 10},
+		SubmissionType:   "image_ocr",
+	}))
+	require.NoError(t, storageService.UpdatePollingStationStatus("lonely-station", "Verified", map[string]int{"Candidate 1": 10}, 0.9))
+
+	_, err := storageService.RemoveSubmission("only-sub")
+	require.NoError(t, err)
+
+	result, err := consensusService.RecomputeConsensus("lonely-station")
+	require.NoError(t, err)
+	assert.Equal(t, "Pending", result.Status)
+
+	station, err := storageService.GetPollingStation("lonely-station")
+	require.NoError(t, err)
+	assert.Equal(t, "Pending", station.Status)
+	assert.Empty(t, station.Submissions)
+}
+
+func TestConsensusService_LeaderboardBroadcastOnVerification(t *testing.T) {
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel) // Suppress logs during testing
+
+	storageService := NewStorageService()
+	consensusService := NewConsensusService(storageService, logger)
+	tallyService := NewTallyService(storageService, logger)
+	webSocketService := NewWebSocketService(tallyService, logger)
+	consensusService.SetWebSocketService(webSocketService)
+
+	require.NoError(t, storageService.StoreVotingProcess(models.VotingProcess{
+		ID:       "leaderboard-process",
+		Title:    "Leaderboard Election",
+		Position: "President",
+		Candidates: []models.Candidate{
+			{ID: "1", Name: "Candidate 1"},
+			{ID: "2", Name: "Candidate 2"},
+			{ID: "3", Name: "Candidate 3"},
+		},
+		PollingStations: []string{"leaderboard-station"},
+		Status:          "Active",
+		CreatedAt:       time.Now(),
+	}))
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/ws", webSocketService.HandleConnection)
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/ws", nil)
+	if err != nil {
+		t.Skipf("WebSocket connection failed (expected in test environment): %v", err)
+		return
+	}
+	defer conn.Close()
+	require.Eventually(t, func() bool { return webSocketService.GetConnectedClientCount() == 1 }, 2*time.Second, 10*time.Millisecond)
+
+	results := map[string]int{"Candidate 1": 50, "Candidate 2": 150, "Candidate 3": 50, "spoilt": 5}
+	for i := 0; i < 3; i++ {
+		require.NoError(t, storageService.StoreSubmission(models.Submission{
+			ID:               fmt.Sprintf("leaderboard-sub-%d", i),
+			WalletAddress:    generateWalletAddress(i),
+			PollingStationID: "leaderboard-station",
+			Timestamp:        time.Now(),
+			Results:          copyResults(results),
+			SubmissionType:   "image_ocr",
+			Confidence:       0.95,
+		}))
+	}
+	result, err := consensusService.ProcessConsensus("leaderboard-station")
+	require.NoError(t, err)
+	require.Equal(t, "Verified", result.Status)
+
+	var update struct {
+		Type string      `json:"type"`
+		Data Leaderboard `json:"data"`
+	}
+	for update.Type != "leaderboard_update" || update.Data.TotalVotes == 0 {
+		conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+		_, frame, err := conn.ReadMessage()
+		require.NoError(t, err)
+
+		// Queued messages may share a frame, one per line
+		for _, message := range strings.Split(string(frame), "\n") {
+			update.Type = ""
+			require.NoError(t, json.Unmarshal([]byte(message), &update))
+			if update.Type == "leaderboard_update" && update.Data.TotalVotes > 0 {
+				break
+			}
+		}
+	}
+
+	assert.Equal(t, "leaderboard-process", update.Data.VotingProcessID)
+	assert.Equal(t, 250, update.Data.TotalVotes)
+	assert.Equal(t, []LeaderboardEntry{
+		{Rank: 1, CandidateID: "2", Name: "Candidate 2", Votes: 150, Percentage: 60},
+		{Rank: 2, CandidateID: "1", Name: "Candidate 1", Votes: 50, Percentage: 20},
+		{Rank: 2, CandidateID: "3", Name: "Candidate 3", Votes: 50, Percentage: 20},
+	}, update.Data.Candidates)
+}
+
+func TestConsensusService_SkipsRedundantBroadcasts(t *testing.T) {
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel) // Suppress logs during testing
+
+	storageService := NewStorageService()
+	consensusService := NewConsensusService(storageService, logger)
+	tallyService := NewTallyService(storageService, logger)
+	webSocketService := NewWebSocketService(tallyService, logger)
+	consensusService.SetWebSocketService(webSocketService)
+
+	require.NoError(t, storageService.StoreVotingProcess(models.VotingProcess{
+		ID:       "redundant-process",
+		Title:    "Redundant Broadcast Election",
+		Position: "President",
+		Candidates: []models.Candidate{
+			{ID: "1", Name: "Candidate 1"},
+			{ID: "2", Name: "Candidate 2"},
+		},
+		PollingStations: []string{"redundant-station"},
+		Status:          "Active",
+		CreatedAt:       time.Now(),
+	}))
+
+	submit := func(i int, results map[string]int) *ConsensusResult {
+		require.NoError(t, storageService.StoreSubmission(models.Submission{
+			ID:               fmt.Sprintf("redundant-sub-%d", i),
+			WalletAddress:    generateWalletAddress(i),
+			PollingStationID: "redundant-station",
+			Timestamp:        time.Now(),
+			Results:          copyResults(results),
+			SubmissionType:   "image_ocr",
+			Confidence:       0.95,
+		}))
+		result, err := consensusService.ProcessConsensus("redundant-station")
+		require.NoError(t, err)
+		return result
+	}
+
+	results := map[string]int{"Candidate 1": 120, "Candidate 2": 80}
+	for i := 0; i < 2; i++ {
+		assert.Equal(t, "Pending", submit(i, results).Status)
+	}
+	// Pending runs below the threshold leave the station in its initial state
+	assert.Equal(t, int64(0), consensusService.broadcastCounter.Value())
+	assert.Equal(t, int64(2), consensusService.skippedBroadcastCounter.Value())
+
+	result := submit(2, results)
+	require.Equal(t, "Verified", result.Status)
+	assert.Equal(t, 1.0, result.ConfidenceLevel)
+	assert.Equal(t, int64(1), consensusService.broadcastCounter.Value())
+
+	// Agreeing submissions keep a unanimous station's result and confidence, so nothing is re-broadcast
+	for i := 3; i < 5; i++ {
+		result := submit(i, results)
+		assert.Equal(t, "Verified", result.Status)
+		assert.Equal(t, 1.0, result.ConfidenceLevel)
+	}
+	assert.Equal(t, int64(1), consensusService.broadcastCounter.Value())
+	assert.Equal(t, int64(4), consensusService.skippedBroadcastCounter.Value())
+
+	// A disagreeing submission lowers the confidence, which clients do need to see
+	result = submit(5, map[string]int{"Candidate 1": 100, "Candidate 2": 100})
+	require.Equal(t, "Verified", result.Status)
+	assert.Less(t, result.ConfidenceLevel, 1.0)
+	assert.Equal(t, int64(2), consensusService.broadcastCounter.Value())
+}
@@ -0,0 +1,73 @@
+package services
+
+import (
+	"os"
+)
+
+// RedactedValue stands in for a secret setting in the effective configuration
+const RedactedValue = "[REDACTED]"
+
+// SecretSettings are the environment variables whose values must never be reported:
+// signing keys, salts, access tokens and the locations of TLS key material
+var SecretSettings = []string{"JWT_SECRET", "WEBHOOK_SECRET", "WALLET_HASH_SALT", "ADMIN_API_TOKEN", "ADMIN_OPERATOR_TOKENS", "OYAH_TLS_CERT", "OYAH_TLS_KEY"}
+
+// EffectiveConfig is the configuration a running server actually loaded, so operators can confirm it without reading logs.
+// It never carries secret values.
+type EffectiveConfig struct {
+	StorageBackend string            `json:"storageBackend"`
+	Server         ServerConfig      `json:"server"`
+	CORS           CORSConfig        `json:"cors"`
+	Consensus      ConsensusConfig   `json:"consensus"`
+	Limits         LimitsConfig      `json:"limits"`
+	Features       map[string]bool   `json:"features"`
+	Secrets        map[string]string `json:"secrets"` // Secret settings by environment variable: RedactedValue when set, empty otherwise
+}
+
+// ServerConfig describes the HTTP listener
+type ServerConfig struct {
+	Port              string `json:"port"`
+	ReadTimeout       string `json:"readTimeout"`
+	WriteTimeout      string `json:"writeTimeout"`
+	IdleTimeout       string `json:"idleTimeout"`
+	ReadHeaderTimeout string `json:"readHeaderTimeout"`
+	TLSEnabled        bool   `json:"tlsEnabled"`
+	TLSRedirectPort   string `json:"tlsRedirectPort,omitempty"`
+}
+
+// CORSConfig describes the cross-origin rules applied to every response
+type CORSConfig struct {
+	AllowedOrigins []string `json:"allowedOrigins"`
+	AllowedMethods []string `json:"allowedMethods"`
+	AllowedHeaders []string `json:"allowedHeaders"`
+}
+
+// ConsensusConfig describes the default verification rules and how consensus is processed
+type ConsensusConfig struct {
+	Defaults  VerificationCriteria `json:"defaults"` // Rules before per-process settings such as minLocationClusters apply
+	Workers   int                  `json:"workers"`  // 0 processes consensus on the request goroutine
+	QueueSize int                  `json:"queueSize,omitempty"`
+	AuditLog  string               `json:"auditLog,omitempty"` // Where consensus decisions are appended, if anywhere
+}
+
+// LimitsConfig describes the limits applied to incoming data
+type LimitsConfig struct {
+	MinCandidates             int    `json:"minCandidates"`
+	WalletMaxStations         int    `json:"walletMaxStations"`
+	WalletMinStationInterval  string `json:"walletMinStationInterval"` // 0s disables the check
+	SubmissionIntakeCapacity  int    `json:"submissionIntakeCapacity"` // 0 means unbounded
+	SubmissionDuplicateWindow string `json:"submissionDuplicateWindow"`
+	SubmissionHistoryLimit    int    `json:"submissionHistoryLimit"` // Replaced submissions retained per wallet and station
+	EvidenceMaxBytes          int    `json:"evidenceMaxBytes,omitempty"`
+}
+
+// RedactedSecrets reports which of the given secret environment variables are set, without their values
+func RedactedSecrets(names ...string) map[string]string {
+	secrets := make(map[string]string, len(names))
+	for _, name := range names {
+		secrets[name] = ""
+		if os.Getenv(name) != "" {
+			secrets[name] = RedactedValue
+		}
+	}
+	return secrets
+}
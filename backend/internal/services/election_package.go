@@ -0,0 +1,269 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"oyah-backend/internal/models"
+)
+
+// ElectionPackageFormatVersion identifies the layout of election packages, for archival tools
+const ElectionPackageFormatVersion = 1
+
+// ErrProcessNotComplete is returned when an election package is requested before its voting process is Complete
+var ErrProcessNotComplete = errors.New("voting process is not complete")
+
+// ElectionPackage bundles a completed voting process's verified results, audit trail and redacted submission
+// metadata for archival and independent verification. Hash is the hex SHA-256 of the package's compact JSON
+// encoding with Hash set to "", so anyone can blank the hash in a downloaded package and recompute it.
+type ElectionPackage struct {
+	FormatVersion        int                     `json:"formatVersion"`
+	GeneratedAt          time.Time               `json:"generatedAt"`
+	VotingProcess        PackageVotingProcess    `json:"votingProcess"`
+	VerificationCriteria *VerificationCriteria   `json:"verificationCriteria,omitempty"`
+	AggregatedTally      map[string]int          `json:"aggregatedTally"`
+	WriteIns             map[string]int          `json:"writeIns,omitempty"`
+	Winners              []string                `json:"winners,omitempty"`
+	SeatTie              bool                    `json:"seatTie,omitempty"`
+	IntegrityWarnings    []IntegrityWarning      `json:"integrityWarnings,omitempty"`
+	PollingStations      []PackagePollingStation `json:"pollingStations"` // Ordered by ID
+	AuditTrail           []models.AuditEntry     `json:"auditTrail"`      // Administrative actions on the process, its stations and submissions
+	HashAlgorithm        string                  `json:"hashAlgorithm"`
+	Hash                 string                  `json:"hash"`
+}
+
+// PackageVotingProcess describes the voting process in an election package, without webhook or wallet allow-list settings
+type PackageVotingProcess struct {
+	ID             string             `json:"id"`
+	Title          string             `json:"title"`
+	Position       string             `json:"position"`
+	Candidates     []models.Candidate `json:"candidates"`
+	SeatsAvailable int                `json:"seatsAvailable"`
+	Status         string             `json:"status"`
+	CreatedAt      time.Time          `json:"createdAt"`
+	StartedAt      *time.Time         `json:"startedAt,omitempty"`
+	CompletedAt    *time.Time         `json:"completedAt,omitempty"`
+}
+
+// PackagePollingStation is one polling station's verified result and the submissions behind it
+type PackagePollingStation struct {
+	ID                   string                    `json:"id"`
+	Status               string                    `json:"status"`
+	VerifiedResults      map[string]int            `json:"verifiedResults,omitempty"`
+	ConfidenceLevel      float64                   `json:"confidenceLevel"`
+	AgreementScore       float64                   `json:"agreementScore,omitempty"`
+	ConsensusReached     *time.Time                `json:"consensusReached,omitempty"`
+	ConsensusExplanation string                    `json:"consensusExplanation,omitempty"`
+	VerifiedEmpty        bool                      `json:"verifiedEmpty,omitempty"`
+	Challenge            *models.StationChallenge  `json:"challenge,omitempty"`
+	SubmissionSummary    *models.SubmissionSummary `json:"submissionSummary,omitempty"` // Set instead of Submissions once compacted
+	Submissions          []PackageSubmission       `json:"submissions"`                 // Ordered by processing time
+}
+
+// PackageSubmission is a submission's metadata with the witness's wallet address and location redacted
+type PackageSubmission struct {
+	ID             string                `json:"id"`
+	WalletAddress  string                `json:"walletAddress"`  // First six and last four characters only
+	GPSCoordinates models.GPSCoordinates `json:"gpsCoordinates"` // Rounded to roughly 1km
+	Timestamp      time.Time             `json:"timestamp"`
+	ProcessedAt    time.Time             `json:"processedAt"`
+	SubmissionType string                `json:"submissionType"`
+	Confidence     float64               `json:"confidence"`
+	Results        map[string]int        `json:"results"`
+	EvidenceHash   string                `json:"evidenceHash,omitempty"`
+	Tags           map[string]string     `json:"tags,omitempty"`
+	Flagged        bool                  `json:"flagged,omitempty"`
+	FlagReason     string                `json:"flagReason,omitempty"`
+}
+
+// ElectionPackageService assembles election packages for completed voting processes
+type ElectionPackageService struct {
+	storageService *StorageService
+	tallyService   *TallyService
+	auditService   *AuditService // Optional; packages have an empty audit trail without it
+	logger         *logrus.Logger
+}
+
+// NewElectionPackageService creates a new election package service instance
+func NewElectionPackageService(storage *StorageService, tallyService *TallyService, logger *logrus.Logger) *ElectionPackageService {
+	return &ElectionPackageService{
+		storageService: storage,
+		tallyService:   tallyService,
+		logger:         logger,
+	}
+}
+
+// SetAuditService sets the audit log administrative actions are taken from
+func (s *ElectionPackageService) SetAuditService(auditService *AuditService) {
+	s.auditService = auditService
+}
+
+// BuildPackage assembles and hashes the election package of a Complete voting process
+func (s *ElectionPackageService) BuildPackage(votingProcessID string) (*ElectionPackage, error) {
+	logger := s.logger.WithFields(logrus.Fields{
+		"voting_process_id": votingProcessID,
+		"service":           "election_package",
+	})
+
+	process, err := s.storageService.GetVotingProcess(votingProcessID)
+	if err != nil {
+		return nil, fmt.Errorf("voting process not found: %w", err)
+	}
+	if process.Status != models.ProcessStatusComplete {
+		return nil, fmt.Errorf("%w: %s is %s", ErrProcessNotComplete, votingProcessID, process.Status)
+	}
+
+	tally, err := s.tallyService.GetTallyData(votingProcessID)
+	if err != nil {
+		return nil, err
+	}
+	stations, err := s.storageService.GetPollingStationsByVotingProcess(votingProcessID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get polling stations: %w", err)
+	}
+	sort.Slice(stations, func(i, j int) bool { return stations[i].ID < stations[j].ID })
+
+	pkg := &ElectionPackage{
+		FormatVersion: ElectionPackageFormatVersion,
+		GeneratedAt:   time.Now().UTC(),
+		VotingProcess: PackageVotingProcess{
+			ID:             process.ID,
+			Title:          process.Title,
+			Position:       process.Position,
+			Candidates:     process.Candidates,
+			SeatsAvailable: tally.VotingProcess.SeatsAvailable,
+			Status:         process.Status,
+			CreatedAt:      process.CreatedAt,
+			StartedAt:      process.StartedAt,
+			CompletedAt:    process.CompletedAt,
+		},
+		VerificationCriteria: tally.VotingProcess.VerificationCriteria,
+		AggregatedTally:      tally.AggregatedTally,
+		WriteIns:             tally.WriteIns,
+		Winners:              tally.Winners,
+		SeatTie:              tally.SeatTie,
+		IntegrityWarnings:    tally.IntegrityWarnings,
+		PollingStations:      make([]PackagePollingStation, 0, len(stations)),
+		AuditTrail:           s.auditTrail(process, stations),
+		HashAlgorithm:        "sha256",
+	}
+
+	submissionCount := 0
+	for _, station := range stations {
+		packaged := PackagePollingStation{
+			ID:                   station.ID,
+			Status:               station.Status,
+			VerifiedResults:      station.VerifiedResults,
+			ConfidenceLevel:      station.ConfidenceLevel,
+			AgreementScore:       station.AgreementScore,
+			ConsensusReached:     station.ConsensusReached,
+			ConsensusExplanation: station.ConsensusExplanation,
+			VerifiedEmpty:        station.VerifiedEmpty,
+			Challenge:            station.Challenge,
+			SubmissionSummary:    station.SubmissionSummary,
+			Submissions:          []PackageSubmission{},
+		}
+
+		submissions := s.storageService.GetSubmissionsByStation(station.ID)
+		sort.Slice(submissions, func(i, j int) bool {
+			if !submissions[i].ProcessedAt.Equal(submissions[j].ProcessedAt) {
+				return submissions[i].ProcessedAt.Before(submissions[j].ProcessedAt)
+			}
+			return submissions[i].ID < submissions[j].ID
+		})
+		for _, submission := range submissions {
+			packaged.Submissions = append(packaged.Submissions, redactSubmission(submission))
+		}
+		submissionCount += len(submissions)
+
+		pkg.PollingStations = append(pkg.PollingStations, packaged)
+	}
+
+	hash, err := pkg.ComputeHash()
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash election package: %w", err)
+	}
+	pkg.Hash = hash
+
+	logger.WithFields(logrus.Fields{
+		"polling_stations": len(pkg.PollingStations),
+		"submissions":      submissionCount,
+		"audit_entries":    len(pkg.AuditTrail),
+		"hash":             pkg.Hash,
+	}).Info("Election package generated")
+
+	return pkg, nil
+}
+
+// auditTrail returns the administrative actions taken on the process, its stations or their submissions
+func (s *ElectionPackageService) auditTrail(process *models.VotingProcess, stations []*models.PollingStation) []models.AuditEntry {
+	trail := []models.AuditEntry{}
+	if s.auditService == nil {
+		return trail
+	}
+
+	targets := map[string]bool{process.ID: true}
+	for _, station := range stations {
+		targets[station.ID] = true
+		for _, submission := range s.storageService.GetSubmissionsByStation(station.ID) {
+			targets[submission.ID] = true
+		}
+	}
+
+	for _, entry := range s.auditService.Query(AuditFilter{}) {
+		if targets[entry.TargetID] {
+			trail = append(trail, entry)
+		}
+	}
+	return trail
+}
+
+// redactSubmission keeps a submission's metadata while shortening the wallet address and coarsening the location
+func redactSubmission(submission models.Submission) PackageSubmission {
+	return PackageSubmission{
+		ID:            submission.ID,
+		WalletAddress: RedactWalletAddress(submission.WalletAddress),
+		GPSCoordinates: models.GPSCoordinates{
+			Latitude:  roundCoordinate(submission.GPSCoordinates.Latitude),
+			Longitude: roundCoordinate(submission.GPSCoordinates.Longitude),
+		},
+		Timestamp:      submission.Timestamp,
+		ProcessedAt:    submission.ProcessedAt,
+		SubmissionType: submission.SubmissionType,
+		Confidence:     submission.Confidence,
+		Results:        submission.Results,
+		EvidenceHash:   submission.EvidenceHash,
+		Tags:           submission.Tags,
+		Flagged:        submission.Flagged,
+		FlagReason:     submission.FlagReason,
+	}
+}
+
+// ComputeHash returns the hex SHA-256 of the package's compact JSON encoding with the hash field left empty
+func (p *ElectionPackage) ComputeHash() (string, error) {
+	unhashed := *p
+	unhashed.Hash = ""
+
+	body, err := json.Marshal(unhashed)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// VerifyHash reports whether the package's embedded hash matches its contents
+func (p *ElectionPackage) VerifyHash() (bool, error) {
+	hash, err := p.ComputeHash()
+	if err != nil {
+		return false, err
+	}
+	return hash == p.Hash, nil
+}
@@ -0,0 +1,131 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oyah-backend/internal/models"
+)
+
+func TestElectionPackageService_BuildPackage(t *testing.T) {
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel)
+
+	storage := NewStorageService()
+	consensusService := NewConsensusService(storage, logger)
+	tallyService := NewTallyService(storage, logger)
+	tallyService.SetConsensusService(consensusService)
+	audit := NewAuditService(logger)
+	packageService := NewElectionPackageService(storage, tallyService, logger)
+	packageService.SetAuditService(audit)
+
+	require.NoError(t, storage.StoreVotingProcess(models.VotingProcess{
+		ID:       "package-process",
+		Title:    "Presidential Election",
+		Position: "President",
+		Candidates: []models.Candidate{
+			{ID: "c1", Name: "Alice"},
+			{ID: "c2", Name: "Bob"},
+		},
+		PollingStations: []string{"package-station-2", "package-station-1"},
+		Status:          "Setup",
+		CreatedAt:       time.Now(),
+	}))
+	require.NoError(t, storage.UpdateVotingProcessStatus("package-process", "Active"))
+	audit.Record("admin", AuditActionStartVotingProcess, "voting_process", "package-process", nil)
+	audit.Record("admin", AuditActionStartVotingProcess, "voting_process", "other-process", nil)
+
+	results := map[string]int{"Alice": 120, "Bob": 80, "spoilt": 3}
+	wallets := []string{generateWalletAddress(0), generateWalletAddress(1), generateWalletAddress(2)}
+	for i, wallet := range wallets {
+		require.NoError(t, storage.StoreSubmission(models.Submission{
+			ID:               fmt.Sprintf("package-sub-%d", i),
+			WalletAddress:    wallet,
+			PollingStationID: "package-station-1",
+			GPSCoordinates:   models.GPSCoordinates{Latitude: -1.292066, Longitude: 36.821946},
+			Timestamp:        time.Now(),
+			Results:          copyResults(results),
+			SubmissionType:   "image_ocr",
+			Confidence:       0.9,
+			Tags:             map[string]string{"org": "redcross"},
+		}))
+	}
+	result, err := consensusService.ProcessConsensus("package-station-1")
+	require.NoError(t, err)
+	require.Equal(t, "Verified", result.Status)
+
+	_, err = packageService.BuildPackage("package-process")
+	assert.True(t, errors.Is(err, ErrProcessNotComplete), "an Active process has no package yet")
+
+	require.NoError(t, storage.UpdateVotingProcessStatus("package-process", "Complete"))
+
+	pkg, err := packageService.BuildPackage("package-process")
+	require.NoError(t, err)
+
+	assert.Equal(t, ElectionPackageFormatVersion, pkg.FormatVersion)
+	assert.Equal(t, "Complete", pkg.VotingProcess.Status)
+	assert.NotNil(t, pkg.VotingProcess.CompletedAt)
+	assert.Equal(t, map[string]int{"Alice": 120, "Bob": 80, "spoilt": 3}, pkg.AggregatedTally)
+	assert.Equal(t, []string{"Alice"}, pkg.Winners)
+	require.NotNil(t, pkg.VerificationCriteria)
+
+	// Only the process's own administrative actions are included
+	require.Len(t, pkg.AuditTrail, 1)
+	assert.Equal(t, "package-process", pkg.AuditTrail[0].TargetID)
+
+	require.Len(t, pkg.PollingStations, 2)
+	assert.Equal(t, "package-station-1", pkg.PollingStations[0].ID)
+	assert.Equal(t, "package-station-2", pkg.PollingStations[1].ID)
+	verified := pkg.PollingStations[0]
+	assert.Equal(t, "Verified", verified.Status)
+	assert.NotEmpty(t, verified.ConsensusExplanation)
+	require.Len(t, verified.Submissions, 3)
+	assert.Empty(t, pkg.PollingStations[1].Submissions)
+
+	// Wallets and locations are redacted, other metadata is kept
+	var submission PackageSubmission
+	for _, packaged := range verified.Submissions {
+		if packaged.ID == "package-sub-0" {
+			submission = packaged
+		}
+	}
+	assert.Equal(t, RedactWalletAddress(wallets[0]), submission.WalletAddress)
+	assert.NotEqual(t, wallets[0], submission.WalletAddress)
+	assert.Equal(t, -1.29, submission.GPSCoordinates.Latitude)
+	assert.Equal(t, 36.82, submission.GPSCoordinates.Longitude)
+	assert.Equal(t, "redcross", submission.Tags["org"])
+
+	// The embedded hash survives a round trip through JSON, as a downloaded package would
+	valid, err := pkg.VerifyHash()
+	require.NoError(t, err)
+	assert.True(t, valid)
+
+	body, err := json.Marshal(pkg)
+	require.NoError(t, err)
+	var downloaded ElectionPackage
+	require.NoError(t, json.Unmarshal(body, &downloaded))
+	valid, err = downloaded.VerifyHash()
+	require.NoError(t, err)
+	assert.True(t, valid)
+
+	// Blanking the hash in the raw file and hashing it again gives the embedded hash
+	blanked := strings.Replace(string(body), `"hash":"`+pkg.Hash+`"`, `"hash":""`, 1)
+	sum := sha256.Sum256([]byte(blanked))
+	assert.Equal(t, pkg.Hash, hex.EncodeToString(sum[:]))
+
+	// Any change to the contents breaks the hash
+	downloaded.AggregatedTally["Bob"]++
+	valid, err = downloaded.VerifyHash()
+	require.NoError(t, err)
+	assert.False(t, valid)
+}
@@ -33,6 +33,53 @@ const (
 	ErrorTypeUnauthorized  ErrorType = "UNAUTHORIZED"
 	ErrorTypeBadRequest    ErrorType = "BAD_REQUEST"
 	ErrorTypeServiceError  ErrorType = "SERVICE_ERROR"
+	ErrorTypeEmptyTally    ErrorType = "EMPTY_TALLY"
+
+	// ErrorTypeInvalidResultFormat reports a results map whose vote counts are not whole numbers
+	ErrorTypeInvalidResultFormat ErrorType = "INVALID_RESULT_FORMAT"
+
+	// ErrorTypeAmbiguousCandidate reports results keys that differ only in case or spacing, e.g. "Alice" and "alice "
+	ErrorTypeAmbiguousCandidate ErrorType = "AMBIGUOUS_CANDIDATE"
+
+	// ErrorTypeIntakeFull tells clients the server is shedding submissions and they should retry later
+	ErrorTypeIntakeFull ErrorType = "INTAKE_FULL"
+
+	// ErrorTypeMaintenanceMode tells clients submissions are closed while an administrator has the server in maintenance
+	ErrorTypeMaintenanceMode ErrorType = "MAINTENANCE_MODE"
+
+	// Evidence verification errors: the content does not match its hash, or could not be fetched to check it
+	ErrorTypeEvidenceMismatch    ErrorType = "EVIDENCE_MISMATCH"
+	ErrorTypeEvidenceUnavailable ErrorType = "EVIDENCE_UNAVAILABLE"
+
+	// Submission target errors, so witnesses learn why a station is not accepting results
+	ErrorTypeProcessNotStarted ErrorType = "PROCESS_NOT_STARTED"
+	ErrorTypeProcessCompleted  ErrorType = "PROCESS_COMPLETED"
+	ErrorTypeUnknownStation    ErrorType = "UNKNOWN_STATION"
+
+	// Compact QR code submission errors: the encoding cannot be decoded, or its signature is not the wallet's
+	ErrorTypeInvalidCompactEncoding ErrorType = "INVALID_COMPACT_ENCODING"
+	ErrorTypeInvalidSignature       ErrorType = "INVALID_SIGNATURE"
+
+	// ErrorTypeWalletNotAuthorized rejects a wallet missing from a closed voting process's allowlist
+	ErrorTypeWalletNotAuthorized ErrorType = "WALLET_NOT_AUTHORIZED"
+
+	// ErrorTypeUnknownOption rejects a referendum result naming something other than the referendum's options or spoilt
+	ErrorTypeUnknownOption ErrorType = "UNKNOWN_OPTION"
+
+	// ErrorTypeWriteInNotAllowed rejects results naming an undeclared candidate when the voting process refuses write-ins
+	ErrorTypeWriteInNotAllowed ErrorType = "WRITE_IN_NOT_ALLOWED"
+
+	// ErrorTypeIncompleteResults rejects results omitting a declared candidate when the voting process requires every one
+	ErrorTypeIncompleteResults ErrorType = "INCOMPLETE_RESULTS"
+
+	// ErrorTypeTooManyVotes rejects submissions reporting more votes than the voting process's limit
+	ErrorTypeTooManyVotes ErrorType = "TOO_MANY_VOTES"
+
+	// ErrorTypeSubmissionTypeNotAllowed rejects submissions captured through a channel the voting process does not accept
+	ErrorTypeSubmissionTypeNotAllowed ErrorType = "SUBMISSION_TYPE_NOT_ALLOWED"
+
+	// ErrorTypeUnknownField rejects a payload field the endpoint does not define, e.g. a misspelled "walletAddres"
+	ErrorTypeUnknownField ErrorType = "UNKNOWN_FIELD"
 )
 
 // APIError represents a structured API error
@@ -0,0 +1,180 @@
+package services
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// LocalizedError is the translated human-readable text of an error response.
+// An empty Details keeps the original English details, which often carry identifiers the client needs.
+type LocalizedError struct {
+	Error   string
+	Details string
+}
+
+// errorCatalog holds translated error text by locale and error code.
+// Codes stay machine-readable and are never translated.
+var errorCatalog = map[string]map[string]LocalizedError{
+	"fr": {
+		string(ErrorTypeValidation):               {Error: "La validation a échoué"},
+		string(ErrorTypeNotFound):                 {Error: "Ressource introuvable"},
+		string(ErrorTypeConflict):                 {Error: "Conflit avec l'état actuel de la ressource"},
+		string(ErrorTypeInternal):                 {Error: "Erreur interne du serveur", Details: "Une erreur inattendue s'est produite lors du traitement de votre requête"},
+		string(ErrorTypeUnauthorized):             {Error: "Accès non autorisé"},
+		string(ErrorTypeBadRequest):               {Error: "Requête invalide"},
+		string(ErrorTypeServiceError):             {Error: "Erreur de service", Details: "Un service interne n'a pas pu traiter votre requête"},
+		string(ErrorTypeEmptyTally):               {Error: "Décompte vide", Details: "La soumission ne contient aucun vote"},
+		string(ErrorTypeInvalidResultFormat):      {Error: "Format de résultat invalide"},
+		string(ErrorTypeAmbiguousCandidate):       {Error: "Candidat ambigu"},
+		string(ErrorTypeIntakeFull):               {Error: "Le serveur est occupé", Details: "Trop de soumissions sont en cours de traitement ; réessayez après le délai indiqué par l'en-tête Retry-After"},
+		string(ErrorTypeMaintenanceMode):          {Error: "Le serveur est en maintenance", Details: "Les soumissions sont temporairement fermées ; les résultats restent consultables"},
+		string(ErrorTypeEvidenceMismatch):         {Error: "La preuve ne correspond pas à son empreinte", Details: "Le contenu à l'adresse evidenceUri ne correspond pas à evidenceHash"},
+		string(ErrorTypeEvidenceUnavailable):      {Error: "La preuve n'a pas pu être vérifiée"},
+		string(ErrorTypeProcessNotStarted):        {Error: "Le scrutin n'a pas encore commencé", Details: "Ce bureau de vote n'accepte pas encore de soumissions"},
+		string(ErrorTypeProcessCompleted):         {Error: "Le scrutin est terminé", Details: "Ce bureau de vote n'accepte plus de soumissions"},
+		string(ErrorTypeUnknownStation):           {Error: "Bureau de vote inconnu", Details: "Ce bureau de vote n'appartient à aucun scrutin"},
+		string(ErrorTypeWalletNotAuthorized):      {Error: "Portefeuille non autorisé", Details: "Ce portefeuille n'est pas inscrit pour soumettre des résultats à ce scrutin"},
+		string(ErrorTypeUnknownOption):            {Error: "Option de référendum inconnue"},
+		string(ErrorTypeWriteInNotAllowed):        {Error: "Candidat non déclaré refusé", Details: "Ce scrutin n'accepte pas de candidats non déclarés"},
+		string(ErrorTypeIncompleteResults):        {Error: "Résultats incomplets", Details: "Ce scrutin exige un nombre de voix pour chaque candidat déclaré"},
+		string(ErrorTypeTooManyVotes):             {Error: "Trop de voix", Details: "Le total des voix dépasse la limite fixée pour ce scrutin"},
+		string(ErrorTypeSubmissionTypeNotAllowed): {Error: "Type de soumission non autorisé", Details: "Ce scrutin n'accepte pas ce mode de saisie"},
+		string(ErrorTypeInvalidCompactEncoding):   {Error: "Soumission compacte invalide"},
+		string(ErrorTypeInvalidSignature):         {Error: "Signature de la soumission invalide"},
+		string(ErrorTypeUnknownField):             {Error: "Champ inconnu dans la requête"},
+		"INVALID_JSON":                            {Error: "Contenu JSON invalide"},
+		"INVALID_CHARACTERS":                      {Error: "La validation a échoué"},
+		"INSUFFICIENT_CANDIDATES":                 {Error: "La validation a échoué"},
+		"INVALID_CANDIDATE_ID":                    {Error: "La validation a échoué"},
+		"INVALID_CONFIDENCE_RANGE":                {Error: "Plage de confiance invalide"},
+		"INVALID_STATUS":                          {Error: "Opération impossible dans l'état actuel du scrutin"},
+		"DUPLICATE_STATION":                       {Error: "Bureau de vote en double"},
+		"MISSING_PROCESS_ID":                      {Error: "Identifiant du scrutin manquant"},
+		"PROCESS_NOT_FOUND":                       {Error: "Scrutin introuvable"},
+		"STORAGE_ERROR":                           {Error: "Erreur d'enregistrement"},
+		"UPDATE_ERROR":                            {Error: "Échec de la mise à jour"},
+	},
+	"sw": {
+		string(ErrorTypeValidation):               {Error: "Uthibitishaji umeshindwa"},
+		string(ErrorTypeNotFound):                 {Error: "Rasilimali haikupatikana"},
+		string(ErrorTypeConflict):                 {Error: "Mgongano na hali ya sasa ya rasilimali"},
+		string(ErrorTypeInternal):                 {Error: "Hitilafu ya ndani ya seva", Details: "Hitilafu isiyotarajiwa imetokea wakati wa kushughulikia ombi lako"},
+		string(ErrorTypeUnauthorized):             {Error: "Ufikiaji hauruhusiwi"},
+		string(ErrorTypeBadRequest):               {Error: "Ombi si sahihi"},
+		string(ErrorTypeServiceError):             {Error: "Hitilafu ya huduma", Details: "Huduma ya ndani imeshindwa kushughulikia ombi lako"},
+		string(ErrorTypeEmptyTally):               {Error: "Hesabu tupu", Details: "Matokeo yaliyowasilishwa hayana kura yoyote"},
+		string(ErrorTypeInvalidResultFormat):      {Error: "Muundo wa matokeo si sahihi"},
+		string(ErrorTypeAmbiguousCandidate):       {Error: "Mgombea hajulikani waziwazi"},
+		string(ErrorTypeIntakeFull):               {Error: "Seva ina shughuli nyingi", Details: "Mawasilisho mengi yanashughulikiwa; jaribu tena baada ya muda ulioonyeshwa na kichwa cha Retry-After"},
+		string(ErrorTypeMaintenanceMode):          {Error: "Seva iko kwenye matengenezo", Details: "Mawasilisho yamefungwa kwa muda; matokeo bado yanaweza kutazamwa"},
+		string(ErrorTypeEvidenceMismatch):         {Error: "Ushahidi haulingani na alama yake", Details: "Maudhui katika evidenceUri hayalingani na evidenceHash"},
+		string(ErrorTypeEvidenceUnavailable):      {Error: "Ushahidi haukuweza kuthibitishwa"},
+		string(ErrorTypeProcessNotStarted):        {Error: "Uchaguzi bado haujaanza", Details: "Kituo hiki cha kupigia kura bado hakipokei mawasilisho"},
+		string(ErrorTypeProcessCompleted):         {Error: "Uchaguzi umekamilika", Details: "Kituo hiki cha kupigia kura hakipokei tena mawasilisho"},
+		string(ErrorTypeUnknownStation):           {Error: "Kituo cha kupigia kura hakijulikani", Details: "Kituo hiki cha kupigia kura si sehemu ya uchaguzi wowote"},
+		string(ErrorTypeWalletNotAuthorized):      {Error: "Pochi haijaidhinishwa", Details: "Pochi hii haijasajiliwa kuwasilisha matokeo ya uchaguzi huu"},
+		string(ErrorTypeUnknownOption):            {Error: "Chaguo la kura ya maoni halijulikani"},
+		string(ErrorTypeWriteInNotAllowed):        {Error: "Mgombea asiyetangazwa hakubaliwi", Details: "Uchaguzi huu haukubali wagombea ambao hawajatangazwa"},
+		string(ErrorTypeIncompleteResults):        {Error: "Matokeo hayajakamilika", Details: "Uchaguzi huu unahitaji idadi ya kura kwa kila mgombea aliyetangazwa"},
+		string(ErrorTypeTooManyVotes):             {Error: "Kura nyingi mno", Details: "Jumla ya kura inazidi kikomo kilichowekwa kwa uchaguzi huu"},
+		string(ErrorTypeSubmissionTypeNotAllowed): {Error: "Aina ya uwasilishaji hairuhusiwi", Details: "Uchaguzi huu haukubali njia hii ya kunasa matokeo"},
+		string(ErrorTypeInvalidCompactEncoding):   {Error: "Wasilisho fupi si sahihi"},
+		string(ErrorTypeInvalidSignature):         {Error: "Sahihi ya wasilisho si sahihi"},
+		string(ErrorTypeUnknownField):             {Error: "Sehemu isiyojulikana katika ombi"},
+		"INVALID_JSON":                            {Error: "Maudhui ya JSON si sahihi"},
+		"INVALID_CHARACTERS":                      {Error: "Uthibitishaji umeshindwa"},
+		"INSUFFICIENT_CANDIDATES":                 {Error: "Uthibitishaji umeshindwa"},
+		"INVALID_CANDIDATE_ID":                    {Error: "Uthibitishaji umeshindwa"},
+		"INVALID_CONFIDENCE_RANGE":                {Error: "Kiwango cha uhakika si sahihi"},
+		"INVALID_STATUS":                          {Error: "Operesheni hairuhusiwi katika hali ya sasa ya uchaguzi"},
+		"DUPLICATE_STATION":                       {Error: "Kituo cha kupigia kura kimerudiwa"},
+		"MISSING_PROCESS_ID":                      {Error: "Kitambulisho cha uchaguzi hakipo"},
+		"PROCESS_NOT_FOUND":                       {Error: "Uchaguzi haukupatikana"},
+		"STORAGE_ERROR":                           {Error: "Hitilafu ya kuhifadhi"},
+		"UPDATE_ERROR":                            {Error: "Usasishaji umeshindwa"},
+	},
+}
+
+// ErrorTranslator translates error response text into the locales enabled for the deployment, falling back to English
+type ErrorTranslator struct {
+	locales map[string]bool
+}
+
+// NewErrorTranslator creates a translator for the given locales; unsupported locales are ignored
+func NewErrorTranslator(locales []string) *ErrorTranslator {
+	enabled := make(map[string]bool, len(locales))
+	for _, locale := range locales {
+		locale = strings.ToLower(strings.TrimSpace(locale))
+		if _, supported := errorCatalog[locale]; supported {
+			enabled[locale] = true
+		}
+	}
+	return &ErrorTranslator{locales: enabled}
+}
+
+// Locales returns the enabled locales in alphabetical order
+func (t *ErrorTranslator) Locales() []string {
+	locales := make([]string, 0, len(t.locales))
+	for locale := range t.locales {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+// MatchLocale picks the enabled locale preferred by an Accept-Language header, e.g. "fr-CA,fr;q=0.9,en;q=0.8".
+// Returns "" when English, or no enabled locale, is preferred.
+func (t *ErrorTranslator) MatchLocale(acceptLanguage string) string {
+	type preference struct {
+		language string
+		quality  float64
+	}
+
+	var preferences []preference
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if tag == "" {
+			continue
+		}
+
+		quality := 1.0
+		if value, found := strings.CutPrefix(strings.TrimSpace(params), "q="); found {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			quality = parsed
+		}
+		if quality <= 0 {
+			continue
+		}
+
+		// Only the primary language subtag matters, so "fr-CA" matches "fr"
+		language, _, _ := strings.Cut(strings.ToLower(tag), "-")
+		preferences = append(preferences, preference{language: language, quality: quality})
+	}
+
+	sort.SliceStable(preferences, func(i, j int) bool {
+		return preferences[i].quality > preferences[j].quality
+	})
+
+	for _, pref := range preferences {
+		if pref.language == "en" {
+			return ""
+		}
+		if t.locales[pref.language] {
+			return pref.language
+		}
+	}
+	return ""
+}
+
+// Translate returns the text of an error code in the given locale, if it has a translation
+func (t *ErrorTranslator) Translate(code, locale string) (LocalizedError, bool) {
+	if !t.locales[locale] {
+		return LocalizedError{}, false
+	}
+	message, ok := errorCatalog[locale][code]
+	return message, ok
+}
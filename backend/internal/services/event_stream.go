@@ -0,0 +1,139 @@
+package services
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// TallyStream is a server-sent event subscriber to one voting process's tally updates,
+// for clients that cannot use WebSockets. Send carries the same JSON messages WebSocket clients receive.
+type TallyStream struct {
+	ID              string
+	VotingProcessID string
+	Send            chan []byte
+}
+
+// SubscribeTallyStream registers a stream for a voting process's tally updates
+func (h *WebSocketHub) SubscribeTallyStream(votingProcessID string) *TallyStream {
+	stream := &TallyStream{
+		ID:              generateClientID(),
+		VotingProcessID: votingProcessID,
+		Send:            make(chan []byte, 256),
+	}
+
+	h.mutex.Lock()
+	h.streams[stream] = true
+	total := len(h.streams)
+	h.mutex.Unlock()
+
+	h.logger.WithFields(logrus.Fields{
+		"stream_id":         stream.ID,
+		"voting_process_id": votingProcessID,
+		"total_streams":     total,
+	}).Info("Event stream registered")
+	return stream
+}
+
+// UnsubscribeTallyStream removes a stream and closes its channel; removing it twice is harmless
+func (h *WebSocketHub) UnsubscribeTallyStream(stream *TallyStream) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	if _, ok := h.streams[stream]; ok {
+		delete(h.streams, stream)
+		close(stream.Send)
+		h.logger.WithFields(logrus.Fields{
+			"stream_id":     stream.ID,
+			"total_streams": len(h.streams),
+		}).Info("Event stream unregistered")
+	}
+}
+
+// GetStreamCount returns the current number of connected event streams
+func (h *WebSocketHub) GetStreamCount() int {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+	return len(h.streams)
+}
+
+// publishToStream delivers a message to a single stream, dropping the stream if its buffer is full
+func (h *WebSocketHub) publishToStream(stream *TallyStream, message []byte) {
+	h.mutex.RLock()
+	delivered := true
+	if h.streams[stream] {
+		select {
+		case stream.Send <- message:
+		default:
+			delivered = false
+		}
+	}
+	h.mutex.RUnlock()
+
+	if !delivered {
+		h.logger.WithField("stream_id", stream.ID).Warn("Event stream send buffer full, dropping stream")
+		h.UnsubscribeTallyStream(stream)
+	}
+}
+
+// publishToStreams delivers a tally update to the streams following its voting process.
+// A stream that cannot keep up is dropped, as a WebSocket client with a full send buffer is.
+func (h *WebSocketHub) publishToStreams(votingProcessID string, message []byte) {
+	h.mutex.RLock()
+	var lagging []*TallyStream
+	for stream := range h.streams {
+		if stream.VotingProcessID != votingProcessID {
+			continue
+		}
+		select {
+		case stream.Send <- message:
+		default:
+			lagging = append(lagging, stream)
+		}
+	}
+	h.mutex.RUnlock()
+
+	for _, stream := range lagging {
+		h.logger.WithField("stream_id", stream.ID).Warn("Event stream send buffer full, dropping stream")
+		h.UnsubscribeTallyStream(stream)
+	}
+}
+
+// SubscribeTallyEvents opens an event stream for a voting process, starting with its current tally as a resync update.
+// The stream is registered before the tally is read, so no update between the two is missed.
+// It fails if the voting process's tally cannot be read, e.g. because the process does not exist.
+func (ws *WebSocketService) SubscribeTallyEvents(votingProcessID string) (*TallyStream, error) {
+	stream := ws.hub.SubscribeTallyStream(votingProcessID)
+
+	tallyData, err := ws.tallyService.GetTallyData(votingProcessID)
+	if err != nil {
+		ws.hub.UnsubscribeTallyStream(stream)
+		return nil, err
+	}
+
+	snapshot, err := json.Marshal(TallyUpdate{
+		Type:            "tally_update",
+		VotingProcessID: votingProcessID,
+		Data:            tallyData,
+		Timestamp:       time.Now(),
+		Resync:          true,
+	})
+	if err != nil {
+		ws.hub.UnsubscribeTallyStream(stream)
+		return nil, err
+	}
+
+	ws.hub.publishToStream(stream, snapshot)
+	return stream, nil
+}
+
+// UnsubscribeTallyEvents closes an event stream opened by SubscribeTallyEvents
+func (ws *WebSocketService) UnsubscribeTallyEvents(stream *TallyStream) {
+	ws.hub.UnsubscribeTallyStream(stream)
+}
+
+// GetEventStreamCount returns the number of connected event streams
+func (ws *WebSocketService) GetEventStreamCount() int {
+	return ws.hub.GetStreamCount()
+}
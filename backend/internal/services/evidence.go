@@ -0,0 +1,102 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DefaultEvidenceMaxBytes caps how much evidence content is downloaded for verification
+const DefaultEvidenceMaxBytes = 25 << 20
+
+// ErrEvidenceMismatch is returned when fetched evidence does not hash to the submission's EvidenceHash
+var ErrEvidenceMismatch = errors.New("evidence content does not match the submitted hash")
+
+// EvidenceFetcher retrieves the content behind a submission's evidence URI
+type EvidenceFetcher interface {
+	Fetch(uri string) ([]byte, error)
+}
+
+// EvidenceVerifier checks at ingest that a submission's evidence hash matches the content at its evidence URI
+type EvidenceVerifier struct {
+	fetcher EvidenceFetcher
+}
+
+// NewEvidenceVerifier creates a verifier that retrieves evidence with the given fetcher
+func NewEvidenceVerifier(fetcher EvidenceFetcher) *EvidenceVerifier {
+	return &EvidenceVerifier{fetcher: fetcher}
+}
+
+// Verify fetches the evidence at uri and compares its SHA-256 digest with expectedHash, given as hex with an
+// optional "sha256:" prefix. Submissions without both a hash and a URI have nothing to verify and pass.
+func (v *EvidenceVerifier) Verify(uri, expectedHash string) error {
+	if uri == "" || expectedHash == "" {
+		return nil
+	}
+
+	content, err := v.fetcher.Fetch(uri)
+	if err != nil {
+		return fmt.Errorf("failed to fetch evidence: %w", err)
+	}
+
+	sum := sha256.Sum256(content)
+	expected := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(expectedHash), "sha256:"))
+	if hex.EncodeToString(sum[:]) != expected {
+		return ErrEvidenceMismatch
+	}
+	return nil
+}
+
+// HTTPEvidenceFetcher downloads evidence over http(s), reading at most maxBytes
+type HTTPEvidenceFetcher struct {
+	client   *http.Client
+	maxBytes int64
+}
+
+// NewHTTPEvidenceFetcher creates a fetcher with the given request timeout and size limit
+func NewHTTPEvidenceFetcher(timeout time.Duration, maxBytes int64) *HTTPEvidenceFetcher {
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	if maxBytes <= 0 {
+		maxBytes = DefaultEvidenceMaxBytes
+	}
+	return &HTTPEvidenceFetcher{
+		client:   &http.Client{Timeout: timeout},
+		maxBytes: maxBytes,
+	}
+}
+
+// Fetch downloads the evidence at uri
+func (f *HTTPEvidenceFetcher) Fetch(uri string) ([]byte, error) {
+	parsed, err := url.Parse(uri)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return nil, fmt.Errorf("evidence URI must be an http(s) URL")
+	}
+
+	resp, err := f.client.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("evidence server responded with status %d", resp.StatusCode)
+	}
+
+	// Read one byte past the limit to tell a complete file from a truncated one
+	content, err := io.ReadAll(io.LimitReader(resp.Body, f.maxBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(content)) > f.maxBytes {
+		return nil, fmt.Errorf("evidence exceeds %d bytes", f.maxBytes)
+	}
+	return content, nil
+}
@@ -0,0 +1,143 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"oyah-backend/internal/models"
+)
+
+// DefaultGeolocationMaxDistanceKm is how far a submission's GPS fix may be from its client IP's location before warning
+const DefaultGeolocationMaxDistanceKm = 500.0
+
+// IPLocation is the approximate location of a client IP address
+type IPLocation struct {
+	Country     string                `json:"country"`
+	Region      string                `json:"region"`
+	Coordinates models.GPSCoordinates `json:"coordinates"`
+}
+
+// IPGeolocationProvider resolves a client IP address to an approximate location
+type IPGeolocationProvider interface {
+	Locate(ip string) (*IPLocation, error)
+}
+
+// GeolocationWarning flags a submission whose GPS coordinates are far from where its client IP resolves.
+// It is a soft fraud signal (e.g. a proxy or spoofed GPS) and never blocks a submission.
+type GeolocationWarning struct {
+	ClientIP   string  `json:"clientIp"`
+	IPCountry  string  `json:"ipCountry"`
+	IPRegion   string  `json:"ipRegion"`
+	DistanceKm float64 `json:"distanceKm"`
+	Message    string  `json:"message"`
+}
+
+// IPGeolocationChecker cross-checks submission GPS coordinates against the client IP's location
+type IPGeolocationChecker struct {
+	provider      IPGeolocationProvider
+	maxDistanceKm float64
+}
+
+// NewIPGeolocationChecker creates a checker that warns when a submission is more than maxDistanceKm from its IP's location
+func NewIPGeolocationChecker(provider IPGeolocationProvider, maxDistanceKm float64) *IPGeolocationChecker {
+	if maxDistanceKm <= 0 {
+		maxDistanceKm = DefaultGeolocationMaxDistanceKm
+	}
+	return &IPGeolocationChecker{
+		provider:      provider,
+		maxDistanceKm: maxDistanceKm,
+	}
+}
+
+// Check returns a warning when the client IP resolves far from the submission's coordinates.
+// Private, loopback and unparseable addresses are skipped; lookup failures are returned so callers can log them.
+func (g *IPGeolocationChecker) Check(clientIP string, coords models.GPSCoordinates) (*GeolocationWarning, error) {
+	ip := net.ParseIP(clientIP)
+	if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() {
+		return nil, nil
+	}
+
+	location, err := g.provider.Locate(clientIP)
+	if err != nil {
+		return nil, fmt.Errorf("failed to geolocate client IP: %w", err)
+	}
+	if location == nil {
+		return nil, nil
+	}
+
+	distanceKm := distanceMeters(location.Coordinates, coords) / 1000
+	if distanceKm <= g.maxDistanceKm {
+		return nil, nil
+	}
+
+	return &GeolocationWarning{
+		ClientIP:   clientIP,
+		IPCountry:  location.Country,
+		IPRegion:   location.Region,
+		DistanceKm: math.Round(distanceKm*10) / 10,
+		Message:    fmt.Sprintf("Client IP resolves %.0f km from the submission's GPS coordinates, which may indicate a proxy or spoofed location", distanceKm),
+	}, nil
+}
+
+// HTTPGeolocationProvider looks up IP locations from an HTTP JSON API.
+// The URL template's "{ip}" placeholder is replaced with the client IP, e.g. "http://ip-api.com/json/{ip}".
+// Responses must carry "country", "regionName" (or "region"), "lat" and "lon" fields.
+type HTTPGeolocationProvider struct {
+	client      *http.Client
+	urlTemplate string
+}
+
+// NewHTTPGeolocationProvider creates a provider that queries the given URL template
+func NewHTTPGeolocationProvider(urlTemplate string, timeout time.Duration) *HTTPGeolocationProvider {
+	if timeout <= 0 {
+		timeout = 2 * time.Second
+	}
+	return &HTTPGeolocationProvider{
+		client:      &http.Client{Timeout: timeout},
+		urlTemplate: urlTemplate,
+	}
+}
+
+// Locate queries the geolocation API for the given IP address
+func (p *HTTPGeolocationProvider) Locate(ip string) (*IPLocation, error) {
+	resp, err := p.client.Get(strings.ReplaceAll(p.urlTemplate, "{ip}", url.PathEscape(ip)))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("geolocation API responded with status %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Country    string   `json:"country"`
+		RegionName string   `json:"regionName"`
+		Region     string   `json:"region"`
+		Lat        *float64 `json:"lat"`
+		Lon        *float64 `json:"lon"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("failed to decode geolocation response: %w", err)
+	}
+	if body.Lat == nil || body.Lon == nil {
+		return nil, fmt.Errorf("geolocation response has no coordinates")
+	}
+
+	region := body.RegionName
+	if region == "" {
+		region = body.Region
+	}
+
+	return &IPLocation{
+		Country:     body.Country,
+		Region:      region,
+		Coordinates: models.GPSCoordinates{Latitude: *body.Lat, Longitude: *body.Lon},
+	}, nil
+}
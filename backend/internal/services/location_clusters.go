@@ -0,0 +1,78 @@
+package services
+
+import (
+	"math"
+
+	"oyah-backend/internal/models"
+)
+
+// earthRadiusMeters is the mean Earth radius used for great-circle distances
+const earthRadiusMeters = 6371000.0
+
+// DefaultClusterRadiusMeters is how close two witnesses must be to count as the same location
+const DefaultClusterRadiusMeters = 100.0
+
+// distanceMeters returns the great-circle (haversine) distance between two coordinates
+func distanceMeters(a, b models.GPSCoordinates) float64 {
+	lat1 := a.Latitude * math.Pi / 180
+	lat2 := b.Latitude * math.Pi / 180
+	deltaLat := (b.Latitude - a.Latitude) * math.Pi / 180
+	deltaLon := (b.Longitude - a.Longitude) * math.Pi / 180
+
+	h := math.Sin(deltaLat/2)*math.Sin(deltaLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(deltaLon/2)*math.Sin(deltaLon/2)
+	return 2 * earthRadiusMeters * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// ClusterCoordinates groups coordinates into clusters of nearby points and returns the indices of each cluster.
+// Points within radiusMeters of each other, directly or through a chain of neighbours, share a cluster.
+// Clusters are ordered by their first point and list indices in ascending order.
+func ClusterCoordinates(coords []models.GPSCoordinates, radiusMeters float64) [][]int {
+	parent := make([]int, len(coords))
+	for i := range parent {
+		parent[i] = i
+	}
+
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	for i := range coords {
+		for j := i + 1; j < len(coords); j++ {
+			if distanceMeters(coords[i], coords[j]) > radiusMeters {
+				continue
+			}
+			if rootI, rootJ := find(i), find(j); rootI != rootJ {
+				parent[rootI] = rootJ
+			}
+		}
+	}
+
+	clusters := [][]int{}
+	clusterOf := make(map[int]int) // root -> position in clusters
+	for i := range coords {
+		root := find(i)
+		position, exists := clusterOf[root]
+		if !exists {
+			position = len(clusters)
+			clusterOf[root] = position
+			clusters = append(clusters, nil)
+		}
+		clusters[position] = append(clusters[position], i)
+	}
+
+	return clusters
+}
+
+// countLocationClusters counts the distinct locations submissions were made from
+func countLocationClusters(submissions []models.Submission, radiusMeters float64) int {
+	coords := make([]models.GPSCoordinates, len(submissions))
+	for i, submission := range submissions {
+		coords[i] = submission.GPSCoordinates
+	}
+	return len(ClusterCoordinates(coords, radiusMeters))
+}
@@ -0,0 +1,67 @@
+package services
+
+import (
+	"math"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"oyah-backend/internal/models"
+)
+
+// redactedGPSPrecision is the number of decimal places GPS coordinates are rounded to in logs (roughly 1km)
+const redactedGPSPrecision = 2
+
+// PIIRedactionHook is a logrus hook that truncates wallet addresses and rounds GPS coordinates in log fields.
+// It only changes log output; stored data keeps full addresses and coordinates.
+type PIIRedactionHook struct{}
+
+// NewPIIRedactionHook creates a new PII redaction hook
+func NewPIIRedactionHook() *PIIRedactionHook {
+	return &PIIRedactionHook{}
+}
+
+// Levels returns the log levels the hook applies to
+func (h *PIIRedactionHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+// Fire redacts sensitive fields of a log entry before it is formatted.
+// logrus fires hooks on a copy of the entry's fields, so callers' field maps are left untouched.
+func (h *PIIRedactionHook) Fire(entry *logrus.Entry) error {
+	for key, value := range entry.Data {
+		switch {
+		case strings.Contains(key, "wallet"):
+			if address, ok := value.(string); ok {
+				entry.Data[key] = RedactWalletAddress(address)
+			}
+		case key == "latitude" || key == "longitude":
+			if coordinate, ok := value.(float64); ok {
+				entry.Data[key] = roundCoordinate(coordinate)
+			}
+		default:
+			if coordinates, ok := value.(models.GPSCoordinates); ok {
+				entry.Data[key] = models.GPSCoordinates{
+					Latitude:  roundCoordinate(coordinates.Latitude),
+					Longitude: roundCoordinate(coordinates.Longitude),
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// RedactWalletAddress keeps the first six and last four characters of a wallet address,
+// enough to correlate log lines without exposing the full address
+func RedactWalletAddress(address string) string {
+	if len(address) <= 12 {
+		return "[redacted]"
+	}
+	return address[:6] + "..." + address[len(address)-4:]
+}
+
+// roundCoordinate rounds a GPS coordinate to redactedGPSPrecision decimal places
+func roundCoordinate(coordinate float64) float64 {
+	scale := math.Pow10(redactedGPSPrecision)
+	return math.Round(coordinate*scale) / scale
+}
@@ -0,0 +1,45 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oyah-backend/internal/models"
+)
+
+func TestPIIRedactionHook(t *testing.T) {
+	var logs bytes.Buffer
+	logger := logrus.New()
+	logger.SetFormatter(&logrus.JSONFormatter{})
+	logger.SetOutput(&logs)
+	logger.AddHook(NewPIIRedactionHook())
+
+	fields := logrus.Fields{
+		"wallet_address":     "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY",
+		"gps_coordinates":    models.GPSCoordinates{Latitude: -1.292066, Longitude: 36.821945},
+		"latitude":           -1.292066,
+		"polling_station_id": "PS001",
+	}
+	logger.WithFields(fields).Info("Submission received")
+
+	var entry map[string]interface{}
+	require.NoError(t, json.Unmarshal(logs.Bytes(), &entry))
+
+	assert.Equal(t, "5Grwva...utQY", entry["wallet_address"])
+	assert.Equal(t, map[string]interface{}{"latitude": -1.29, "longitude": 36.82}, entry["gps_coordinates"])
+	assert.Equal(t, -1.29, entry["latitude"])
+	assert.Equal(t, "PS001", entry["polling_station_id"])
+
+	// The caller's fields are not modified
+	assert.Equal(t, "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY", fields["wallet_address"])
+}
+
+func TestRedactWalletAddress(t *testing.T) {
+	assert.Equal(t, "5Grwva...utQY", RedactWalletAddress("5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY"))
+	assert.Equal(t, "[redacted]", RedactWalletAddress("short"))
+}
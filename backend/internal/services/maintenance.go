@@ -0,0 +1,46 @@
+package services
+
+import (
+	"sync"
+	"time"
+)
+
+// MaintenanceMode is a server-wide switch that closes submissions during an emergency freeze.
+// Reads keep working and no data is touched; submissions are turned away until it is switched off.
+type MaintenanceMode struct {
+	enabled   bool
+	changedAt time.Time
+	mutex     sync.RWMutex
+}
+
+// NewMaintenanceMode creates a maintenance switch that starts off
+func NewMaintenanceMode() *MaintenanceMode {
+	return &MaintenanceMode{}
+}
+
+// Enabled reports whether submissions are currently closed
+func (m *MaintenanceMode) Enabled() bool {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return m.enabled
+}
+
+// SetEnabled turns maintenance mode on or off and reports whether that changed it
+func (m *MaintenanceMode) SetEnabled(enabled bool) bool {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.enabled == enabled {
+		return false
+	}
+	m.enabled = enabled
+	m.changedAt = time.Now()
+	return true
+}
+
+// ChangedAt returns when maintenance mode was last switched, zero if it never was
+func (m *MaintenanceMode) ChangedAt() time.Time {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return m.changedAt
+}
@@ -0,0 +1,180 @@
+package services
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// Counter is a monotonically increasing metric
+type Counter struct {
+	name  string
+	help  string
+	value atomic.Int64
+}
+
+// NewCounter creates a new counter with the given metric name and help text
+func NewCounter(name, help string) *Counter {
+	return &Counter{name: name, help: help}
+}
+
+// Inc increments the counter by one
+func (c *Counter) Inc() {
+	c.value.Add(1)
+}
+
+// Add increments the counter by the given non-negative amount
+func (c *Counter) Add(delta int64) {
+	if delta > 0 {
+		c.value.Add(delta)
+	}
+}
+
+// Value returns the current counter value
+func (c *Counter) Value() int64 {
+	return c.value.Load()
+}
+
+// Name returns the metric name of the counter
+func (c *Counter) Name() string {
+	return c.name
+}
+
+// CounterVec is a family of counters sharing a metric name, told apart by the value of a single label
+type CounterVec struct {
+	name     string
+	help     string
+	label    string
+	counters map[string]*Counter
+	mutex    sync.RWMutex
+}
+
+// NewCounterVec creates a new labeled counter family with the given metric name, help text and label name
+func NewCounterVec(name, help, label string) *CounterVec {
+	return &CounterVec{
+		name:     name,
+		help:     help,
+		label:    label,
+		counters: make(map[string]*Counter),
+	}
+}
+
+// WithLabelValue returns the counter for the given label value, creating it on first use
+func (v *CounterVec) WithLabelValue(value string) *Counter {
+	v.mutex.RLock()
+	counter, ok := v.counters[value]
+	v.mutex.RUnlock()
+	if ok {
+		return counter
+	}
+
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+	if counter, ok = v.counters[value]; !ok {
+		counter = NewCounter(v.name, v.help)
+		v.counters[value] = counter
+	}
+	return counter
+}
+
+// Value returns the current value of the counter for the given label value, zero if it was never incremented
+func (v *CounterVec) Value(value string) int64 {
+	v.mutex.RLock()
+	defer v.mutex.RUnlock()
+
+	if counter, ok := v.counters[value]; ok {
+		return counter.Value()
+	}
+	return 0
+}
+
+// Name returns the metric name of the counter family
+func (v *CounterVec) Name() string {
+	return v.name
+}
+
+// writePrometheus writes the family's counters ordered by label value
+func (v *CounterVec) writePrometheus(w io.Writer) error {
+	v.mutex.RLock()
+	defer v.mutex.RUnlock()
+
+	if _, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s counter\n", v.name, v.help, v.name); err != nil {
+		return err
+	}
+
+	values := make([]string, 0, len(v.counters))
+	for value := range v.counters {
+		values = append(values, value)
+	}
+	sort.Strings(values)
+	for _, value := range values {
+		if _, err := fmt.Fprintf(w, "%s{%s=%q} %d\n", v.name, v.label, value, v.counters[value].Value()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MetricsRegistry collects counters and renders them in the Prometheus text exposition format
+type MetricsRegistry struct {
+	counters    []*Counter
+	counterVecs []*CounterVec
+	names       map[string]bool
+	mutex       sync.RWMutex
+}
+
+// NewMetricsRegistry creates a new, empty metrics registry
+func NewMetricsRegistry() *MetricsRegistry {
+	return &MetricsRegistry{
+		counters: []*Counter{},
+		names:    make(map[string]bool),
+	}
+}
+
+// Register adds counters to the registry, ignoring names that are already registered
+func (r *MetricsRegistry) Register(counters ...*Counter) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, counter := range counters {
+		if counter == nil || r.names[counter.name] {
+			continue
+		}
+		r.names[counter.name] = true
+		r.counters = append(r.counters, counter)
+	}
+}
+
+// RegisterVec adds labeled counter families to the registry, ignoring names that are already registered
+func (r *MetricsRegistry) RegisterVec(vecs ...*CounterVec) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, vec := range vecs {
+		if vec == nil || r.names[vec.name] {
+			continue
+		}
+		r.names[vec.name] = true
+		r.counterVecs = append(r.counterVecs, vec)
+	}
+}
+
+// WritePrometheus writes all registered counters in the Prometheus text exposition format
+func (r *MetricsRegistry) WritePrometheus(w io.Writer) error {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	for _, counter := range r.counters {
+		if _, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s counter\n%s %d\n", counter.name, counter.help, counter.name, counter.name, counter.Value()); err != nil {
+			return err
+		}
+	}
+	for _, vec := range r.counterVecs {
+		if err := vec.writePrometheus(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package services
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"oyah-backend/internal/models"
+)
+
+// RetentionService compacts raw submissions of completed voting processes after a retention delay
+type RetentionService struct {
+	storageService *StorageService
+	logger         *logrus.Logger
+	retentionDelay time.Duration
+	stop           chan struct{}
+	mutex          sync.Mutex
+}
+
+// NewRetentionService creates a new retention service instance
+func NewRetentionService(storage *StorageService, logger *logrus.Logger) *RetentionService {
+	return &RetentionService{
+		storageService: storage,
+		logger:         logger,
+		retentionDelay: 24 * time.Hour, // Keep raw submissions for a day after completion
+	}
+}
+
+// SetRetentionDelay sets how long raw submissions are kept after a voting process completes
+func (r *RetentionService) SetRetentionDelay(delay time.Duration) {
+	if delay >= 0 {
+		r.retentionDelay = delay
+		r.logger.WithField("retention_delay", delay.String()).Info("Submission retention delay updated")
+	}
+}
+
+// CompactVotingProcess compacts a completed voting process immediately, ignoring the retention delay
+func (r *RetentionService) CompactVotingProcess(processID string) (int, error) {
+	logger := r.logger.WithFields(logrus.Fields{
+		"voting_process_id": processID,
+		"service":           "retention",
+	})
+
+	compactedStations, err := r.storageService.CompactVotingProcessSubmissions(processID)
+	if err != nil {
+		logger.WithError(err).Error("Failed to compact voting process submissions")
+		return 0, fmt.Errorf("failed to compact voting process: %w", err)
+	}
+
+	logger.WithField("compacted_stations", compactedStations).Info("Voting process submissions compacted")
+	return compactedStations, nil
+}
+
+// CompactExpiredProcesses compacts every completed voting process whose retention delay has elapsed
+func (r *RetentionService) CompactExpiredProcesses() []string {
+	now := time.Now()
+	compacted := []string{}
+
+	for processID, process := range r.storageService.GetAllVotingProcesses() {
+		if process.Status != models.ProcessStatusComplete || process.CompletedAt == nil || process.CompactedAt != nil {
+			continue
+		}
+		if now.Sub(*process.CompletedAt) < r.retentionDelay {
+			continue
+		}
+
+		if _, err := r.CompactVotingProcess(processID); err != nil {
+			continue
+		}
+		compacted = append(compacted, processID)
+	}
+
+	return compacted
+}
+
+// Start runs CompactExpiredProcesses in the background at the given interval
+func (r *RetentionService) Start(interval time.Duration) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.stop != nil || interval <= 0 {
+		return
+	}
+
+	stop := make(chan struct{})
+	r.stop = stop
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				r.CompactExpiredProcesses()
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	r.logger.WithFields(logrus.Fields{
+		"sweep_interval":  interval.String(),
+		"retention_delay": r.retentionDelay.String(),
+	}).Info("Submission retention job started")
+}
+
+// Stop stops the background retention job
+func (r *RetentionService) Stop() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.stop != nil {
+		close(r.stop)
+		r.stop = nil
+	}
+}
@@ -0,0 +1,115 @@
+package services
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oyah-backend/internal/models"
+)
+
+func setupRetentionTest(t *testing.T) (*RetentionService, *StorageService, *TallyService) {
+	storage := NewStorageService()
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+
+	votingProcess := models.VotingProcess{
+		ID:       "vp-retention",
+		Title:    "Retention Test Election",
+		Position: "Mayor",
+		Candidates: []models.Candidate{
+			{ID: "c1", Name: "Alice"},
+			{ID: "c2", Name: "Bob"},
+		},
+		PollingStations: []string{"RET001", "RET002"},
+		Status:          "Active",
+		CreatedAt:       time.Now(),
+	}
+	require.NoError(t, storage.StoreVotingProcess(votingProcess))
+
+	results := map[string]int{"Alice": 120, "Bob": 80, "spoilt": 3}
+	for i := 0; i < 3; i++ {
+		submission := models.Submission{
+			ID:               fmt.Sprintf("ret-sub-%d", i),
+			WalletAddress:    generateWalletAddress(i),
+			PollingStationID: "RET001",
+			Timestamp:        time.Now(),
+			Results:          copyResults(results),
+			SubmissionType:   "image_ocr",
+			Confidence:       0.9,
+		}
+		require.NoError(t, storage.StoreSubmission(submission))
+	}
+	require.NoError(t, storage.UpdatePollingStationStatus("RET001", "Verified", results, 0.95))
+
+	return NewRetentionService(storage, logger), storage, NewTallyService(storage, logger)
+}
+
+func TestRetentionService_CompactVotingProcess(t *testing.T) {
+	retention, storage, tallyService := setupRetentionTest(t)
+
+	t.Run("RejectsIncompleteProcess", func(t *testing.T) {
+		_, err := retention.CompactVotingProcess("vp-retention")
+		assert.Error(t, err)
+		assert.Len(t, storage.GetSubmissionsByStation("RET001"), 3)
+	})
+
+	t.Run("CompactsCompletedProcess", func(t *testing.T) {
+		tallyBefore, err := tallyService.GetTallyData("vp-retention")
+		require.NoError(t, err)
+
+		require.NoError(t, storage.UpdateVotingProcessStatus("vp-retention", "Complete"))
+
+		compactedStations, err := retention.CompactVotingProcess("vp-retention")
+		require.NoError(t, err)
+		assert.Equal(t, 2, compactedStations)
+
+		// Verified tally is intact
+		tallyAfter, err := tallyService.GetTallyData("vp-retention")
+		require.NoError(t, err)
+		assert.Equal(t, tallyBefore.AggregatedTally, tallyAfter.AggregatedTally)
+
+		// Raw submissions are gone and the station reports it was compacted
+		assert.Empty(t, storage.GetSubmissionsByStation("RET001"))
+
+		station, err := storage.GetPollingStation("RET001")
+		require.NoError(t, err)
+		assert.True(t, station.Compacted)
+		assert.Equal(t, "Verified", station.Status)
+		require.NotNil(t, station.SubmissionSummary)
+		assert.Equal(t, 3, station.SubmissionSummary.TotalSubmissions)
+		assert.Equal(t, 3, station.SubmissionSummary.UniqueWallets)
+		assert.Equal(t, 3, station.SubmissionSummary.SubmissionTypes["image_ocr"])
+
+		process, err := storage.GetVotingProcess("vp-retention")
+		require.NoError(t, err)
+		assert.NotNil(t, process.CompactedAt)
+	})
+
+	t.Run("NonExistentProcess", func(t *testing.T) {
+		_, err := retention.CompactVotingProcess("missing")
+		assert.Error(t, err)
+	})
+}
+
+func TestRetentionService_CompactExpiredProcesses(t *testing.T) {
+	retention, storage, _ := setupRetentionTest(t)
+	require.NoError(t, storage.UpdateVotingProcessStatus("vp-retention", "Complete"))
+
+	// Retention delay not yet elapsed
+	retention.SetRetentionDelay(time.Hour)
+	assert.Empty(t, retention.CompactExpiredProcesses())
+	assert.Len(t, storage.GetSubmissionsByStation("RET001"), 3)
+
+	// Retention delay elapsed
+	retention.SetRetentionDelay(0)
+	assert.Equal(t, []string{"vp-retention"}, retention.CompactExpiredProcesses())
+	assert.Empty(t, storage.GetSubmissionsByStation("RET001"))
+
+	// Already compacted processes are skipped
+	assert.Empty(t, retention.CompactExpiredProcesses())
+}
@@ -0,0 +1,156 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Defaults for retrying outbound notifications
+const (
+	DefaultNotifyMaxAttempts    = 4
+	DefaultNotifyInitialBackoff = time.Second
+	DefaultNotifyMaxBackoff     = 30 * time.Second
+	DefaultNotifyJitter         = 0.2
+)
+
+// RetryPolicy describes how a failed outbound notification is retried.
+// The delay before retry n (counting from 1) is InitialBackoff doubled n-1 times, capped at MaxBackoff, then
+// spread by up to ±Jitter of itself so many notifiers failing together do not retry in lockstep.
+type RetryPolicy struct {
+	MaxAttempts    int           // Attempts in total, the first included; at least 1
+	InitialBackoff time.Duration // Delay before the first retry
+	MaxBackoff     time.Duration // Upper bound on a single delay; 0 leaves delays uncapped
+	Jitter         float64       // Fraction of each delay randomly added or removed, between 0 and 1
+}
+
+// DefaultRetryPolicy returns the retry policy used when none is configured
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    DefaultNotifyMaxAttempts,
+		InitialBackoff: DefaultNotifyInitialBackoff,
+		MaxBackoff:     DefaultNotifyMaxBackoff,
+		Jitter:         DefaultNotifyJitter,
+	}
+}
+
+// PermanentError marks a notification failure that retrying cannot fix, such as a webhook endpoint that does not exist
+type PermanentError struct {
+	Err error
+}
+
+func (e *PermanentError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *PermanentError) Unwrap() error {
+	return e.Err
+}
+
+// Permanent wraps err so Notify stops retrying it
+func Permanent(err error) error {
+	return &PermanentError{Err: err}
+}
+
+// RetryableNotifier runs outbound notifications, such as webhook deliveries, retrying transient failures
+// with capped exponential backoff and jitter
+type RetryableNotifier struct {
+	policy RetryPolicy
+	logger *logrus.Logger
+	sleep  func(time.Duration) // Replaced in tests to record delays without waiting
+	random func() float64      // Returns a value in [0, 1) for jitter
+	mutex  sync.RWMutex
+}
+
+// NewRetryableNotifier creates a notifier retrying failed notifications according to the given policy
+func NewRetryableNotifier(policy RetryPolicy, logger *logrus.Logger) *RetryableNotifier {
+	n := &RetryableNotifier{
+		logger: logger,
+		sleep:  time.Sleep,
+		random: rand.Float64,
+	}
+	n.SetPolicy(policy)
+	return n
+}
+
+// SetPolicy replaces the retry policy, clamping out-of-range values
+func (n *RetryableNotifier) SetPolicy(policy RetryPolicy) {
+	if policy.MaxAttempts < 1 {
+		policy.MaxAttempts = 1
+	}
+	if policy.InitialBackoff < 0 {
+		policy.InitialBackoff = 0
+	}
+	if policy.MaxBackoff < 0 {
+		policy.MaxBackoff = 0
+	}
+	policy.Jitter = min(max(policy.Jitter, 0), 1)
+
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.policy = policy
+}
+
+// Policy returns the current retry policy
+func (n *RetryableNotifier) Policy() RetryPolicy {
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+	return n.policy
+}
+
+// Notify calls send until it succeeds or the policy's attempts run out, sleeping between attempts. A failure wrapped
+// with Permanent ends the retries at once. It returns the number of attempts made and, when the notification failed,
+// an error wrapping the last failure.
+func (n *RetryableNotifier) Notify(name string, fields logrus.Fields, send func() error) (int, error) {
+	policy := n.Policy()
+	logger := n.logger.WithFields(fields)
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		if err = send(); err == nil {
+			logger.WithField("attempt", attempt).Infof("%s succeeded", name)
+			return attempt, nil
+		}
+
+		var permanent *PermanentError
+		if errors.As(err, &permanent) {
+			logger.WithError(err).WithField("attempt", attempt).Warningf("%s failed permanently, not retrying", name)
+			return attempt, fmt.Errorf("%s failed permanently after %d attempts: %w", name, attempt, err)
+		}
+		if attempt >= policy.MaxAttempts {
+			return attempt, fmt.Errorf("%s failed after %d attempts: %w", name, attempt, err)
+		}
+
+		delay := n.backoff(policy, attempt)
+		logger.WithError(err).WithFields(logrus.Fields{
+			"attempt": attempt,
+			"delay":   delay.String(),
+		}).Warningf("%s attempt failed, retrying", name)
+		n.sleep(delay)
+	}
+}
+
+// backoff returns the delay before the retry following the given failed attempt
+func (n *RetryableNotifier) backoff(policy RetryPolicy, attempt int) time.Duration {
+	delay := policy.InitialBackoff
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if policy.MaxBackoff > 0 && delay >= policy.MaxBackoff {
+			break
+		}
+	}
+	if policy.MaxBackoff > 0 && delay > policy.MaxBackoff {
+		delay = policy.MaxBackoff
+	}
+
+	if policy.Jitter > 0 && delay > 0 {
+		// Spread the delay uniformly over [delay*(1-jitter), delay*(1+jitter))
+		spread := float64(delay) * policy.Jitter
+		delay += time.Duration(spread * (2*n.random() - 1))
+	}
+	return delay
+}
@@ -0,0 +1,101 @@
+package services
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRetryableNotifier(policy RetryPolicy) (*RetryableNotifier, *[]time.Duration) {
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+
+	notifier := NewRetryableNotifier(policy, logger)
+	delays := []time.Duration{}
+	notifier.sleep = func(delay time.Duration) { delays = append(delays, delay) }
+	return notifier, &delays
+}
+
+func TestRetryableNotifier_Notify(t *testing.T) {
+	errTransient := errors.New("connection reset")
+
+	t.Run("FlakyNotifierSucceedsOnThirdAttempt", func(t *testing.T) {
+		notifier, delays := newTestRetryableNotifier(RetryPolicy{MaxAttempts: 5, InitialBackoff: 100 * time.Millisecond})
+
+		calls := 0
+		attempts, err := notifier.Notify("Test notification", nil, func() error {
+			calls++
+			if calls < 3 {
+				return errTransient
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 3, attempts)
+		assert.Equal(t, 3, calls)
+		assert.Equal(t, []time.Duration{100 * time.Millisecond, 200 * time.Millisecond}, *delays, "the delay doubles after each failure")
+	})
+
+	t.Run("ExhaustsRetries", func(t *testing.T) {
+		notifier, delays := newTestRetryableNotifier(RetryPolicy{MaxAttempts: 4, InitialBackoff: 100 * time.Millisecond, MaxBackoff: 250 * time.Millisecond})
+
+		calls := 0
+		attempts, err := notifier.Notify("Test notification", nil, func() error {
+			calls++
+			return errTransient
+		})
+		require.Error(t, err)
+		assert.ErrorIs(t, err, errTransient)
+		assert.Contains(t, err.Error(), "failed after 4 attempts")
+		assert.Equal(t, 4, attempts)
+		assert.Equal(t, 4, calls)
+		assert.Equal(t, []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 250 * time.Millisecond}, *delays, "delays are capped at the maximum backoff")
+	})
+
+	t.Run("PermanentFailureStopsRetrying", func(t *testing.T) {
+		notifier, delays := newTestRetryableNotifier(RetryPolicy{MaxAttempts: 5, InitialBackoff: 100 * time.Millisecond})
+
+		calls := 0
+		attempts, err := notifier.Notify("Test notification", nil, func() error {
+			calls++
+			return Permanent(errTransient)
+		})
+		require.Error(t, err)
+		assert.ErrorIs(t, err, errTransient)
+		assert.Equal(t, 1, attempts)
+		assert.Equal(t, 1, calls)
+		assert.Empty(t, *delays)
+	})
+
+	t.Run("SingleAttemptNeverSleeps", func(t *testing.T) {
+		notifier, delays := newTestRetryableNotifier(RetryPolicy{MaxAttempts: 0, InitialBackoff: time.Second})
+
+		attempts, err := notifier.Notify("Test notification", nil, func() error { return errTransient })
+		require.Error(t, err)
+		assert.Equal(t, 1, attempts, "a policy below one attempt still makes one")
+		assert.Empty(t, *delays)
+	})
+
+	t.Run("JitterSpreadsDelays", func(t *testing.T) {
+		notifier, delays := newTestRetryableNotifier(RetryPolicy{MaxAttempts: 4, InitialBackoff: 100 * time.Millisecond, Jitter: 0.5})
+		randoms := []float64{0, 0.5, 0.999}
+		notifier.random = func() float64 {
+			value := randoms[0]
+			randoms = randoms[1:]
+			return value
+		}
+
+		_, err := notifier.Notify("Test notification", nil, func() error { return errTransient })
+		require.Error(t, err)
+		require.Len(t, *delays, 3)
+
+		// The lowest draw removes half the delay, the middle one leaves it unchanged, the highest adds almost half
+		assert.Equal(t, 50*time.Millisecond, (*delays)[0])
+		assert.Equal(t, 200*time.Millisecond, (*delays)[1])
+		assert.InDelta(t, float64(600*time.Millisecond), float64((*delays)[2]), float64(time.Millisecond))
+	})
+}
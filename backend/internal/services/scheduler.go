@@ -0,0 +1,78 @@
+package services
+
+import (
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// SchedulerService starts voting processes automatically once their scheduled start time has passed
+type SchedulerService struct {
+	storageService *StorageService
+	logger         *logrus.Logger
+	stop           chan struct{}
+	mutex          sync.Mutex
+}
+
+// NewSchedulerService creates a new scheduler service instance
+func NewSchedulerService(storage *StorageService, logger *logrus.Logger) *SchedulerService {
+	return &SchedulerService{
+		storageService: storage,
+		logger:         logger,
+	}
+}
+
+// StartDueProcesses activates every Setup voting process whose scheduled start has passed
+func (s *SchedulerService) StartDueProcesses() []string {
+	activated := s.storageService.ActivateScheduledVotingProcesses(time.Now())
+
+	for _, processID := range activated {
+		s.logger.WithFields(logrus.Fields{
+			"voting_process_id": processID,
+			"service":           "scheduler",
+		}).Info("Scheduled voting process started")
+	}
+
+	return activated
+}
+
+// Start runs StartDueProcesses in the background at the given interval
+func (s *SchedulerService) Start(interval time.Duration) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.stop != nil || interval <= 0 {
+		return
+	}
+
+	stop := make(chan struct{})
+	s.stop = stop
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				s.StartDueProcesses()
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	s.logger.WithField("check_interval", interval.String()).Info("Voting process scheduler started")
+}
+
+// Stop stops the background scheduler
+func (s *SchedulerService) Stop() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.stop != nil {
+		close(s.stop)
+		s.stop = nil
+	}
+}
@@ -0,0 +1,96 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oyah-backend/internal/models"
+)
+
+func TestSchedulerService_AutoStartsScheduledProcess(t *testing.T) {
+	storage := NewStorageService()
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+
+	scheduledStart := time.Now().Add(100 * time.Millisecond)
+	require.NoError(t, storage.StoreVotingProcess(models.VotingProcess{
+		ID:       "vp-scheduled",
+		Title:    "Scheduled Election",
+		Position: "Mayor",
+		Candidates: []models.Candidate{
+			{ID: "c1", Name: "Alice"},
+			{ID: "c2", Name: "Bob"},
+		},
+		PollingStations: []string{"SCH001"},
+		Status:          "Setup",
+		CreatedAt:       time.Now(),
+		ScheduledStart:  &scheduledStart,
+	}))
+
+	validation := NewValidationService(storage)
+	submission := models.SubmissionRequest{
+		WalletAddress:    "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY",
+		PollingStationID: "SCH001",
+		GPSCoordinates:   models.GPSCoordinates{Latitude: -1.2921, Longitude: 36.8219},
+		Timestamp:        time.Now(),
+		Results:          map[string]int{"Alice": 10, "Bob": 5},
+		SubmissionType:   "image_ocr",
+		Confidence:       0.9,
+	}
+
+	scheduler := NewSchedulerService(storage, logger)
+
+	// Nothing is due yet, so submissions are rejected
+	assert.Empty(t, scheduler.StartDueProcesses())
+	assert.Error(t, validation.ValidateSubmission(submission))
+
+	scheduler.Start(10 * time.Millisecond)
+	defer scheduler.Stop()
+
+	require.Eventually(t, func() bool {
+		process, err := storage.GetVotingProcess("vp-scheduled")
+		return err == nil && process.Status == "Active"
+	}, 2*time.Second, 10*time.Millisecond)
+
+	process, err := storage.GetVotingProcess("vp-scheduled")
+	require.NoError(t, err)
+	require.NotNil(t, process.StartedAt)
+	assert.False(t, process.StartedAt.Before(scheduledStart))
+
+	submission.Timestamp = time.Now()
+	assert.NoError(t, validation.ValidateSubmission(submission))
+}
+
+func TestSchedulerService_IgnoresUnscheduledAndStartedProcesses(t *testing.T) {
+	storage := NewStorageService()
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+
+	past := time.Now().Add(-time.Minute)
+	processes := []models.VotingProcess{
+		{ID: "vp-manual", Status: "Setup"},
+		{ID: "vp-complete", Status: "Complete", ScheduledStart: &past},
+		{ID: "vp-due", Status: "Setup", ScheduledStart: &past},
+	}
+	for _, process := range processes {
+		require.NoError(t, storage.StoreVotingProcess(process))
+	}
+
+	scheduler := NewSchedulerService(storage, logger)
+	assert.Equal(t, []string{"vp-due"}, scheduler.StartDueProcesses())
+
+	manual, err := storage.GetVotingProcess("vp-manual")
+	require.NoError(t, err)
+	assert.Equal(t, "Setup", manual.Status)
+
+	complete, err := storage.GetVotingProcess("vp-complete")
+	require.NoError(t, err)
+	assert.Equal(t, "Complete", complete.Status)
+
+	// A process is only started once
+	assert.Empty(t, scheduler.StartDueProcesses())
+}
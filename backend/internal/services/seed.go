@@ -0,0 +1,199 @@
+package services
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"oyah-backend/internal/models"
+)
+
+// Upper bounds on a single seed request, so a typo cannot exhaust the in-memory store
+const (
+	MaxSeedProcesses             = 100
+	MaxSeedStationsPerProcess    = 1000
+	MaxSeedSubmissionsPerStation = 20
+	MaxSeedCandidates            = 20
+
+	defaultSeedCandidates    = 3
+	defaultSeedAgreementRate = 0.8
+)
+
+// SeedReport summarizes the synthetic data created by a seed run
+type SeedReport struct {
+	RunID              int       `json:"runId"`
+	RandomSeed         int64     `json:"randomSeed"`
+	VotingProcessIDs   []string  `json:"votingProcessIds"`
+	ProcessesCreated   int       `json:"processesCreated"`
+	StationsCreated    int       `json:"stationsCreated"`
+	SubmissionsCreated int       `json:"submissionsCreated"`
+	VerifiedStations   int       `json:"verifiedStations"` // Only counted when consensus was processed
+	DurationMs         int64     `json:"durationMs"`
+	GeneratedAt        time.Time `json:"generatedAt"`
+}
+
+// SeedService generates synthetic voting processes, stations and submissions for load testing.
+// It must only be exposed in test or development deployments.
+type SeedService struct {
+	storageService   *StorageService
+	consensusService *ConsensusService
+	logger           *logrus.Logger
+	runs             int
+	mutex            sync.Mutex
+}
+
+// NewSeedService creates a new seed service instance
+func NewSeedService(storage *StorageService, consensus *ConsensusService, logger *logrus.Logger) *SeedService {
+	return &SeedService{
+		storageService:   storage,
+		consensusService: consensus,
+		logger:           logger,
+	}
+}
+
+// Seed creates the requested synthetic dataset. The same random seed always produces the same vote counts,
+// and every run gets its own ID prefix so repeated runs never collide.
+func (s *SeedService) Seed(req models.SeedRequest) (*SeedReport, error) {
+	start := time.Now()
+
+	if req.Processes < 1 || req.Processes > MaxSeedProcesses ||
+		req.StationsPerProcess < 1 || req.StationsPerProcess > MaxSeedStationsPerProcess ||
+		req.SubmissionsPerStation < 0 || req.SubmissionsPerStation > MaxSeedSubmissionsPerStation ||
+		req.CandidatesPerProcess < 0 || req.CandidatesPerProcess > MaxSeedCandidates {
+		return nil, fmt.Errorf("seed request exceeds limits (processes 1-%d, stations per process 1-%d, submissions per station 0-%d, candidates 0-%d)",
+			MaxSeedProcesses, MaxSeedStationsPerProcess, MaxSeedSubmissionsPerStation, MaxSeedCandidates)
+	}
+
+	s.mutex.Lock()
+	s.runs++
+	runID := s.runs
+	s.mutex.Unlock()
+
+	candidatesPerProcess := req.CandidatesPerProcess
+	if candidatesPerProcess == 0 {
+		candidatesPerProcess = defaultSeedCandidates
+	}
+	agreementRate := req.AgreementRate
+	if agreementRate == 0 {
+		agreementRate = defaultSeedAgreementRate
+	}
+	randomSeed := req.RandomSeed
+	if randomSeed == 0 {
+		randomSeed = time.Now().UnixNano()
+	}
+	rng := rand.New(rand.NewSource(randomSeed))
+
+	report := &SeedReport{
+		RunID:            runID,
+		RandomSeed:       randomSeed,
+		VotingProcessIDs: make([]string, 0, req.Processes),
+	}
+
+	for p := 1; p <= req.Processes; p++ {
+		candidates := make([]models.Candidate, candidatesPerProcess)
+		for c := range candidates {
+			candidates[c] = models.Candidate{
+				ID:   fmt.Sprintf("seed-candidate-%d", c+1),
+				Name: fmt.Sprintf("Candidate %d", c+1),
+			}
+		}
+
+		stations := make([]string, req.StationsPerProcess)
+		for i := range stations {
+			stations[i] = fmt.Sprintf("SEED_%d_%d_%d", runID, p, i+1)
+		}
+
+		now := time.Now()
+		process := models.VotingProcess{
+			ID:              fmt.Sprintf("seed-%d-process-%d", runID, p),
+			Title:           fmt.Sprintf("Seeded Election %d", p),
+			Position:        "Load Test",
+			Candidates:      candidates,
+			PollingStations: stations,
+			Status:          models.ProcessStatusActive,
+			CreatedAt:       now,
+			StartedAt:       &now,
+			SeatsAvailable:  1,
+		}
+		if err := s.storageService.StoreVotingProcess(process); err != nil {
+			return nil, fmt.Errorf("failed to store seeded voting process: %w", err)
+		}
+		report.VotingProcessIDs = append(report.VotingProcessIDs, process.ID)
+		report.ProcessesCreated++
+		report.StationsCreated += len(stations)
+
+		for _, stationID := range stations {
+			created, err := s.seedStation(rng, runID, stationID, candidates, req.SubmissionsPerStation, agreementRate)
+			report.SubmissionsCreated += created
+			if err != nil {
+				return nil, err
+			}
+
+			if req.ProcessConsensus && created > 0 {
+				if result, err := s.consensusService.ProcessConsensus(stationID); err == nil && result.Status == models.StationStatusVerified {
+					report.VerifiedStations++
+				}
+			}
+		}
+	}
+
+	report.GeneratedAt = time.Now()
+	report.DurationMs = time.Since(start).Milliseconds()
+
+	s.logger.WithFields(logrus.Fields{
+		"run_id":              runID,
+		"random_seed":         randomSeed,
+		"processes_created":   report.ProcessesCreated,
+		"stations_created":    report.StationsCreated,
+		"submissions_created": report.SubmissionsCreated,
+		"duration_ms":         report.DurationMs,
+	}).Info("Synthetic load test data seeded")
+
+	return report, nil
+}
+
+// seedStation stores synthetic submissions for one station. Most witnesses report the station's true result;
+// the rest report a slightly different count, so consensus has realistic disagreement to resolve.
+func (s *SeedService) seedStation(rng *rand.Rand, runID int, stationID string, candidates []models.Candidate, submissions int, agreementRate float64) (int, error) {
+	trueResults := make(map[string]int, len(candidates)+1)
+	for _, candidate := range candidates {
+		trueResults[candidate.Name] = rng.Intn(500)
+	}
+	trueResults[models.SpoiltVotesKey] = rng.Intn(20)
+
+	location := models.GPSCoordinates{
+		Latitude:  -4.0 + rng.Float64()*8.0,
+		Longitude: 34.0 + rng.Float64()*8.0,
+	}
+
+	for i := 1; i <= submissions; i++ {
+		results := make(map[string]int, len(trueResults))
+		for name, votes := range trueResults {
+			results[name] = votes
+		}
+		if rng.Float64() >= agreementRate {
+			results[candidates[rng.Intn(len(candidates))].Name] += 1 + rng.Intn(10)
+		}
+
+		submission := models.Submission{
+			ID:               fmt.Sprintf("%s-submission-%d", stationID, i),
+			WalletAddress:    fmt.Sprintf("seed-wallet-%d-%s-%d", runID, stationID, i),
+			PollingStationID: stationID,
+			GPSCoordinates: models.GPSCoordinates{
+				Latitude:  location.Latitude + (rng.Float64()-0.5)*0.001,
+				Longitude: location.Longitude + (rng.Float64()-0.5)*0.001,
+			},
+			Timestamp:      time.Now(),
+			Results:        results,
+			SubmissionType: "image_ocr",
+			Confidence:     0.7 + rng.Float64()*0.3,
+		}
+		if err := s.storageService.StoreSubmission(submission); err != nil {
+			return i - 1, fmt.Errorf("failed to store seeded submission: %w", err)
+		}
+	}
+
+	return submissions, nil
+}
@@ -1,22 +1,49 @@
 package services
 
 import (
+	"errors"
 	"fmt"
+	"maps"
+	"sort"
 	"sync"
 	"time"
 
 	"oyah-backend/internal/models"
 )
 
+// DefaultDuplicateWindow is how long an identical resubmission from the same wallet to the same station is coalesced
+const DefaultDuplicateWindow = 2 * time.Second
+
+// DefaultSubmissionHistoryLimit is how many replaced submissions are retained per wallet and station
+const DefaultSubmissionHistoryLimit = 5
+
+// ErrSubmissionCoalesced is returned by StoreSubmission when a submission repeats, within the duplicate window,
+// the vote counts and capture time the same wallet just submitted for the same station. Nothing is stored.
+var ErrSubmissionCoalesced = errors.New("duplicate submission coalesced with a recent identical submission")
+
 // StorageService provides in-memory storage for submissions, polling stations, and voting processes
 type StorageService struct {
 	submissions       map[string][]models.Submission // key: pollingStationId
 	pollingStations   map[string]*models.PollingStation // key: pollingStationId
 	walletSubmissions map[string]map[string]*models.Submission // key: walletAddress -> pollingStationId -> submission
 	votingProcesses   map[string]*models.VotingProcess // key: votingProcessId
+	tallyTrends       map[string][]models.TallySnapshot // key: votingProcessId
+	duplicateWindow   time.Duration                     // Identical resubmissions within this window are coalesced; 0 disables
+	submissionHistory map[string]map[string][]models.Submission // key: walletAddress -> pollingStationId -> replaced submissions, oldest first
+	historyLimit      int                               // Replaced submissions retained per wallet and station; 0 keeps none
+	verifiedTallies   map[string]*VerifiedTally         // key: votingProcessId; kept up to date as stations verify and revert
 	mutex             sync.RWMutex
 }
 
+// VerifiedTally is the running sum of a voting process's verified station results, updated incrementally as each
+// station verifies, changes its verified result or reverts, so reading it never re-sums every station
+type VerifiedTally struct {
+	Votes            map[string]int // Every results key reported by a verified station, write-ins included
+	VerifiedStations int
+
+	reporters map[string]int // Verified stations reporting each key, so a key is dropped once none does
+}
+
 // NewStorageService creates a new storage service instance
 func NewStorageService() *StorageService {
 	return &StorageService{
@@ -24,7 +51,101 @@ func NewStorageService() *StorageService {
 		pollingStations:   make(map[string]*models.PollingStation),
 		walletSubmissions: make(map[string]map[string]*models.Submission),
 		votingProcesses:   make(map[string]*models.VotingProcess),
+		tallyTrends:       make(map[string][]models.TallySnapshot),
+		duplicateWindow:   DefaultDuplicateWindow,
+		submissionHistory: make(map[string]map[string][]models.Submission),
+		historyLimit:      DefaultSubmissionHistoryLimit,
+		verifiedTallies:   make(map[string]*VerifiedTally),
+	}
+}
+
+// StorageBackendMemory identifies the in-memory storage backend
+const StorageBackendMemory = "memory"
+
+// Backend reports which storage backend holds the data
+func (s *StorageService) Backend() string {
+	return StorageBackendMemory
+}
+
+// DuplicateWindow returns how long identical resubmissions are coalesced
+func (s *StorageService) DuplicateWindow() time.Duration {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.duplicateWindow
+}
+
+// SetDuplicateWindow sets how long an identical resubmission from the same wallet to the same station is
+// coalesced instead of stored, e.g. when a witness double-taps submit. Zero disables coalescing.
+func (s *StorageService) SetDuplicateWindow(window time.Duration) {
+	if window < 0 {
+		return
 	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.duplicateWindow = window
+}
+
+// SubmissionHistoryLimit returns how many replaced submissions are retained per wallet and station
+func (s *StorageService) SubmissionHistoryLimit() int {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.historyLimit
+}
+
+// SetSubmissionHistoryLimit sets how many replaced submissions are retained per wallet and station, evicting the
+// oldest beyond the limit. Zero keeps no history; negative limits are ignored.
+func (s *StorageService) SetSubmissionHistoryLimit(limit int) {
+	if limit < 0 {
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.historyLimit = limit
+
+	for walletAddress, stations := range s.submissionHistory {
+		for stationID, history := range stations {
+			s.submissionHistory[walletAddress][stationID] = s.trimSubmissionHistory(history)
+		}
+	}
+}
+
+// GetSubmissionHistory returns the submissions a wallet's later submissions to a station replaced, oldest first
+func (s *StorageService) GetSubmissionHistory(walletAddress, stationID string) []models.Submission {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	history := s.submissionHistory[walletAddress][stationID]
+	result := make([]models.Submission, len(history))
+	copy(result, history)
+	return result
+}
+
+// recordReplacedSubmission keeps a submission replaced under latest-wins in its wallet's station history.
+// Must be called with the write lock held.
+func (s *StorageService) recordReplacedSubmission(submission models.Submission) {
+	if s.historyLimit == 0 {
+		return
+	}
+
+	if _, exists := s.submissionHistory[submission.WalletAddress]; !exists {
+		s.submissionHistory[submission.WalletAddress] = make(map[string][]models.Submission)
+	}
+	history := append(s.submissionHistory[submission.WalletAddress][submission.PollingStationID], submission)
+	s.submissionHistory[submission.WalletAddress][submission.PollingStationID] = s.trimSubmissionHistory(history)
+}
+
+// trimSubmissionHistory drops the oldest entries of a history beyond the history limit
+func (s *StorageService) trimSubmissionHistory(history []models.Submission) []models.Submission {
+	if len(history) <= s.historyLimit {
+		return history
+	}
+
+	// Copy so the evicted submissions can be released
+	trimmed := make([]models.Submission, s.historyLimit)
+	copy(trimmed, history[len(history)-s.historyLimit:])
+	return trimmed
 }
 
 // StoreSubmission stores a submission and handles duplicate prevention
@@ -35,7 +156,13 @@ func (s *StorageService) StoreSubmission(submission models.Submission) error {
 	// Check for duplicate submission from same wallet for same station
 	if walletStations, exists := s.walletSubmissions[submission.WalletAddress]; exists {
 		if existingSubmission, stationExists := walletStations[submission.PollingStationID]; stationExists {
-			// Update existing submission (latest wins)
+			// Coalesce an accidental double submit rather than storing it and re-running consensus
+			if s.isAccidentalResubmission(existingSubmission, submission) {
+				return ErrSubmissionCoalesced
+			}
+
+			// Update existing submission (latest wins), keeping the replaced one in the bounded history
+			s.recordReplacedSubmission(*existingSubmission)
 			s.removeSubmissionFromStation(existingSubmission.ID, submission.PollingStationID)
 		}
 	} else {
@@ -52,19 +179,80 @@ func (s *StorageService) StoreSubmission(submission models.Submission) error {
 	s.walletSubmissions[submission.WalletAddress][submission.PollingStationID] = &submission
 
 	// Initialize or update polling station
-	if station, exists := s.pollingStations[submission.PollingStationID]; exists {
+	station, exists := s.pollingStations[submission.PollingStationID]
+	if exists {
 		station.Submissions = s.submissions[submission.PollingStationID]
 	} else {
-		s.pollingStations[submission.PollingStationID] = &models.PollingStation{
+		station = &models.PollingStation{
 			ID:          submission.PollingStationID,
-			Status:      "Pending",
+			Status:      models.StationStatusPending,
 			Submissions: s.submissions[submission.PollingStationID],
 		}
+		s.pollingStations[submission.PollingStationID] = station
+	}
+
+	// Record arrival times on the station itself so they survive compaction and removed submissions
+	arrivedAt := submission.ProcessedAt
+	if station.FirstSubmissionAt == nil {
+		station.FirstSubmissionAt = &arrivedAt
 	}
+	station.LastSubmissionAt = &arrivedAt
 
 	return nil
 }
 
+// ReplaceStationSubmissions replaces every submission of a polling station, e.g. after a data integrity repair.
+// Submissions are stored as given; when a wallet appears more than once its last entry is tracked as its submission.
+func (s *StorageService) ReplaceStationSubmissions(stationID string, submissions []models.Submission) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	station, exists := s.pollingStations[stationID]
+	if !exists {
+		return fmt.Errorf("polling station not found: %s", stationID)
+	}
+
+	// Drop the wallet tracking entries of the submissions being replaced
+	for _, submission := range s.submissions[stationID] {
+		if walletStations, exists := s.walletSubmissions[submission.WalletAddress]; exists {
+			delete(walletStations, stationID)
+			if len(walletStations) == 0 {
+				delete(s.walletSubmissions, submission.WalletAddress)
+			}
+		}
+	}
+
+	replaced := make([]models.Submission, len(submissions))
+	copy(replaced, submissions)
+	for i := range replaced {
+		if _, exists := s.walletSubmissions[replaced[i].WalletAddress]; !exists {
+			s.walletSubmissions[replaced[i].WalletAddress] = make(map[string]*models.Submission)
+		}
+		s.walletSubmissions[replaced[i].WalletAddress][stationID] = &replaced[i]
+	}
+
+	s.submissions[stationID] = replaced
+	station.Submissions = replaced
+
+	return nil
+}
+
+// isAccidentalResubmission reports whether a submission repeats one that arrived within the duplicate window,
+// with identical vote counts and a capture time within the window. A deliberate resubmission carries a new
+// capture time and still replaces the earlier submission.
+func (s *StorageService) isAccidentalResubmission(existing *models.Submission, submission models.Submission) bool {
+	if s.duplicateWindow <= 0 || time.Since(existing.ProcessedAt) >= s.duplicateWindow {
+		return false
+	}
+
+	captureGap := submission.Timestamp.Sub(existing.Timestamp)
+	if captureGap < 0 {
+		captureGap = -captureGap
+	}
+
+	return captureGap < s.duplicateWindow && maps.Equal(existing.Results, submission.Results)
+}
+
 // GetSubmissionsByStation returns all submissions for a polling station
 func (s *StorageService) GetSubmissionsByStation(stationID string) []models.Submission {
 	s.mutex.RLock()
@@ -79,6 +267,122 @@ func (s *StorageService) GetSubmissionsByStation(stationID string) []models.Subm
 	return []models.Submission{}
 }
 
+// IsDurable reports whether stored data survives a restart; in-memory storage never does
+func (s *StorageService) IsDurable() bool {
+	return false
+}
+
+// GetSubmissionAck returns the storage acknowledgment for a submission
+func (s *StorageService) GetSubmissionAck(submissionID string) (*models.SubmissionAck, error) {
+	submission, err := s.GetSubmissionByID(submissionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.SubmissionAck{
+		SubmissionID:     submission.ID,
+		PollingStationID: submission.PollingStationID,
+		Stored:           true,
+		Durable:          s.IsDurable(),
+		StoredAt:         submission.ProcessedAt,
+	}, nil
+}
+
+// GetSubmissionByID returns a submission by ID
+func (s *StorageService) GetSubmissionByID(submissionID string) (*models.Submission, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	for _, submissions := range s.submissions {
+		for _, submission := range submissions {
+			if submission.ID == submissionID {
+				// Return a copy to avoid race conditions
+				submissionCopy := submission
+				return &submissionCopy, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("submission not found: %s", submissionID)
+}
+
+// FlagSubmission marks a submission as fraudulent so consensus ignores it. The submission is kept for review.
+// Returns the flagged submission.
+func (s *StorageService) FlagSubmission(submissionID, reason string) (*models.Submission, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for stationID, submissions := range s.submissions {
+		for i := range submissions {
+			if submissions[i].ID != submissionID {
+				continue
+			}
+			submissions[i].Flagged = true
+			submissions[i].FlagReason = reason
+
+			// Keep the wallet's tracked submission in step
+			if tracked, exists := s.walletSubmissions[submissions[i].WalletAddress][stationID]; exists && tracked.ID == submissionID {
+				tracked.Flagged = true
+				tracked.FlagReason = reason
+			}
+
+			submissionCopy := submissions[i]
+			return &submissionCopy, nil
+		}
+	}
+	return nil, fmt.Errorf("submission not found: %s", submissionID)
+}
+
+// RemoveSubmission deletes a submission from its polling station and returns it
+func (s *StorageService) RemoveSubmission(submissionID string) (*models.Submission, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for stationID, submissions := range s.submissions {
+		for _, submission := range submissions {
+			if submission.ID != submissionID {
+				continue
+			}
+			s.removeSubmissionFromStation(submissionID, stationID)
+			if station, exists := s.pollingStations[stationID]; exists {
+				station.Submissions = s.submissions[stationID]
+			}
+
+			// The wallet may submit to this station again
+			if walletStations, exists := s.walletSubmissions[submission.WalletAddress]; exists {
+				if tracked, exists := walletStations[stationID]; exists && tracked.ID == submissionID {
+					delete(walletStations, stationID)
+					if len(walletStations) == 0 {
+						delete(s.walletSubmissions, submission.WalletAddress)
+					}
+				}
+			}
+
+			return &submission, nil
+		}
+	}
+	return nil, fmt.Errorf("submission not found: %s", submissionID)
+}
+
+// GetSubmissionsByWallet returns the latest submission a wallet made to each polling station, ordered by station ID
+func (s *StorageService) GetSubmissionsByWallet(walletAddress string) []models.Submission {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	walletStations, exists := s.walletSubmissions[walletAddress]
+	if !exists {
+		return []models.Submission{}
+	}
+
+	result := make([]models.Submission, 0, len(walletStations))
+	for _, submission := range walletStations {
+		result = append(result, *submission)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].PollingStationID < result[j].PollingStationID
+	})
+	return result
+}
+
 // GetPollingStation returns a polling station by ID
 func (s *StorageService) GetPollingStation(stationID string) (*models.PollingStation, error) {
 	s.mutex.RLock()
@@ -86,12 +390,27 @@ func (s *StorageService) GetPollingStation(stationID string) (*models.PollingSta
 
 	if station, exists := s.pollingStations[stationID]; exists {
 		// Return a copy to avoid race conditions
-		stationCopy := *station
-		return &stationCopy, nil
+		return copyPollingStation(station), nil
 	}
 	return nil, fmt.Errorf("polling station not found: %s", stationID)
 }
 
+// copyPollingStation returns a copy of a stored polling station that shares no maps or slices with it, so callers
+// such as tally computation can read or modify it outside the lock while consensus keeps updating the original
+func copyPollingStation(station *models.PollingStation) *models.PollingStation {
+	stationCopy := *station
+	stationCopy.VerifiedResults = maps.Clone(station.VerifiedResults)
+	if station.Submissions != nil {
+		stationCopy.Submissions = make([]models.Submission, len(station.Submissions))
+		for i, submission := range station.Submissions {
+			submission.Results = maps.Clone(submission.Results)
+			submission.Tags = maps.Clone(submission.Tags)
+			stationCopy.Submissions[i] = submission
+		}
+	}
+	return &stationCopy
+}
+
 // UpdatePollingStationStatus updates the status and verified results of a polling station
 func (s *StorageService) UpdatePollingStationStatus(stationID, status string, verifiedResults map[string]int, confidenceLevel float64) error {
 	s.mutex.Lock()
@@ -101,9 +420,30 @@ func (s *StorageService) UpdatePollingStationStatus(stationID, status string, ve
 	if !exists {
 		return fmt.Errorf("polling station not found: %s", stationID)
 	}
+	if err := models.ValidateStationTransition(station.Status, status); err != nil {
+		return err
+	}
+
+	// The aggregate only changes when a station newly verifies or its verified results change
+	aggregateChanged := status == models.StationStatusVerified && verifiedResults != nil &&
+		(station.Status != models.StationStatusVerified || !maps.Equal(station.VerifiedResults, verifiedResults))
+
+	// Take the station's old result out of its process's running tally; the new one is added back below
+	removed := s.applyToVerifiedTally(station, -1)
+
+	if status == models.StationStatusVerified && station.Status != models.StationStatusVerified {
+		now := time.Now()
+		station.VerifiedAt = &now
+	}
 
 	station.Status = status
 	station.ConfidenceLevel = confidenceLevel
+	station.VerifiedEmpty = false
+	if status != models.StationStatusVerified {
+		station.AverageConfidence = 0
+		station.AgreementScore = 0
+		station.VerifiedAt = nil
+	}
 	
 	if verifiedResults != nil {
 		station.VerifiedResults = make(map[string]int)
@@ -113,10 +453,248 @@ func (s *StorageService) UpdatePollingStationStatus(stationID, status string, ve
 		now := time.Now()
 		station.ConsensusReached = &now
 	}
+	added := s.applyToVerifiedTally(station, 1)
+
+	// A verified station reverting to Pending takes its result out of the aggregate too
+	if aggregateChanged || (removed && !added) {
+		s.recordTallySnapshot(station)
+	}
+
+	return nil
+}
+
+// MarkPollingStationEmpty verifies a station that legitimately had no turnout, and so will never receive witness
+// submissions, with a zero count for every candidate and spoilt votes. The station is flagged as VerifiedEmpty
+// until a later consensus run replaces its result.
+func (s *StorageService) MarkPollingStationEmpty(stationID string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	station, exists := s.pollingStations[stationID]
+	if !exists {
+		return fmt.Errorf("polling station not found: %s", stationID)
+	}
+	process, exists := s.votingProcesses[station.VotingProcessID]
+	if !exists {
+		return fmt.Errorf("polling station %s is not part of a voting process", stationID)
+	}
+	if err := models.ValidateStationTransition(station.Status, models.StationStatusVerified); err != nil {
+		return err
+	}
+
+	zeroResults := make(map[string]int, len(process.Candidates)+1)
+	for _, candidate := range process.Candidates {
+		zeroResults[candidate.Name] = 0
+	}
+	zeroResults[models.SpoiltVotesKey] = 0
+
+	aggregateChanged := station.Status != models.StationStatusVerified || !maps.Equal(station.VerifiedResults, zeroResults)
+	s.applyToVerifiedTally(station, -1)
+
+	now := time.Now()
+	if station.Status != models.StationStatusVerified {
+		station.VerifiedAt = &now
+	}
+	station.Status = models.StationStatusVerified
+	station.VerifiedResults = zeroResults
+	station.ConfidenceLevel = 0
+	station.AverageConfidence = 0
+	station.AgreementScore = 0
+	station.ConsensusReached = &now
+	station.ConsensusExplanation = "Marked empty by an administrator: the station had no turnout and is verified with zero votes."
+	station.VerifiedEmpty = true
+	s.applyToVerifiedTally(station, 1)
+
+	if aggregateChanged {
+		s.recordTallySnapshot(station)
+	}
+
+	return nil
+}
+
+// FileStationChallenge records an observer challenge against a verified polling station's result.
+// The station stays verified and keeps counting in the tally while the challenge is open.
+func (s *StorageService) FileStationChallenge(stationID string, challenge models.StationChallenge) (*models.StationChallenge, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	station, exists := s.pollingStations[stationID]
+	if !exists {
+		return nil, fmt.Errorf("polling station not found: %s", stationID)
+	}
+	if station.Status != models.StationStatusVerified {
+		return nil, fmt.Errorf("polling station %s is not verified", stationID)
+	}
+	if station.Challenge.Open() {
+		return nil, fmt.Errorf("polling station %s already has an open challenge", stationID)
+	}
+
+	challenge.VerifiedResults = make(map[string]int, len(station.VerifiedResults))
+	for candidate, votes := range station.VerifiedResults {
+		challenge.VerifiedResults[candidate] = votes
+	}
+	station.Challenge = &challenge
+
+	challengeCopy := challenge
+	return &challengeCopy, nil
+}
 
+// ResolveStationChallenge closes a polling station's open challenge, returning the station to plain Verified in the tally
+func (s *StorageService) ResolveStationChallenge(stationID, resolution string) (*models.StationChallenge, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	station, exists := s.pollingStations[stationID]
+	if !exists {
+		return nil, fmt.Errorf("polling station not found: %s", stationID)
+	}
+	if !station.Challenge.Open() {
+		return nil, fmt.Errorf("polling station %s has no open challenge", stationID)
+	}
+
+	// Replace rather than mutate the challenge, since copies handed out by GetPollingStation share it
+	now := time.Now()
+	resolved := *station.Challenge
+	resolved.ResolvedAt = &now
+	resolved.Resolution = resolution
+	station.Challenge = &resolved
+
+	challengeCopy := resolved
+	return &challengeCopy, nil
+}
+
+// SetPollingStationAverageConfidence records the mean submission confidence behind a station's verified result
+func (s *StorageService) SetPollingStationAverageConfidence(stationID string, average float64) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	station, exists := s.pollingStations[stationID]
+	if !exists {
+		return fmt.Errorf("polling station not found: %s", stationID)
+	}
+
+	station.AverageConfidence = average
 	return nil
 }
 
+// SetPollingStationAgreementScore records the share of a station's witnesses that agree with its verified result
+func (s *StorageService) SetPollingStationAgreementScore(stationID string, score float64) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	station, exists := s.pollingStations[stationID]
+	if !exists {
+		return fmt.Errorf("polling station not found: %s", stationID)
+	}
+
+	station.AgreementScore = score
+	return nil
+}
+
+// SetPollingStationConsensusExplanation records why the last consensus run reached its decision for a station
+func (s *StorageService) SetPollingStationConsensusExplanation(stationID, explanation string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	station, exists := s.pollingStations[stationID]
+	if !exists {
+		return fmt.Errorf("polling station not found: %s", stationID)
+	}
+
+	station.ConsensusExplanation = explanation
+	return nil
+}
+
+// recordTallySnapshot appends the current aggregated tally of a station's voting process to its trend.
+// Must be called with the write lock held.
+func (s *StorageService) recordTallySnapshot(station *models.PollingStation) {
+	process, exists := s.votingProcesses[station.VotingProcessID]
+	if !exists {
+		return
+	}
+
+	// Mirror the tally service: every candidate and spoilt votes start at zero
+	aggregatedTally := make(map[string]int)
+	for _, candidate := range process.Candidates {
+		aggregatedTally[candidate.Name] = 0
+	}
+	aggregatedTally[models.SpoiltVotesKey] = 0
+
+	verifiedStations := 0
+	if tally, exists := s.verifiedTallies[process.ID]; exists {
+		verifiedStations = tally.VerifiedStations
+		for candidate, votes := range tally.Votes {
+			aggregatedTally[candidate] += votes
+		}
+	}
+
+	s.tallyTrends[process.ID] = append(s.tallyTrends[process.ID], models.TallySnapshot{
+		Timestamp:        time.Now(),
+		PollingStationID: station.ID,
+		VerifiedStations: verifiedStations,
+		AggregatedTally:  aggregatedTally,
+	})
+}
+
+// applyToVerifiedTally adds (sign 1) or removes (sign -1) a station's verified result to or from its voting
+// process's running tally. Stations that are not verified contribute nothing. It reports whether the tally changed.
+// Must be called with the write lock held.
+func (s *StorageService) applyToVerifiedTally(station *models.PollingStation, sign int) bool {
+	if station.VotingProcessID == "" || station.Status != models.StationStatusVerified || station.VerifiedResults == nil {
+		return false
+	}
+
+	tally, exists := s.verifiedTallies[station.VotingProcessID]
+	if !exists {
+		tally = &VerifiedTally{Votes: make(map[string]int), reporters: make(map[string]int)}
+		s.verifiedTallies[station.VotingProcessID] = tally
+	}
+
+	tally.VerifiedStations += sign
+	for candidate, votes := range station.VerifiedResults {
+		tally.Votes[candidate] += sign * votes
+		tally.reporters[candidate] += sign
+		// Drop keys no verified station reports any more, so a reverted write-in does not linger at zero
+		if tally.reporters[candidate] <= 0 {
+			delete(tally.Votes, candidate)
+			delete(tally.reporters, candidate)
+		}
+	}
+	return true
+}
+
+// GetVerifiedTally returns a copy of the running sum of a voting process's verified station results
+func (s *StorageService) GetVerifiedTally(processID string) (*VerifiedTally, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	if _, exists := s.votingProcesses[processID]; !exists {
+		return nil, fmt.Errorf("voting process not found: %s", processID)
+	}
+
+	tally := &VerifiedTally{Votes: make(map[string]int)}
+	if running, exists := s.verifiedTallies[processID]; exists {
+		tally.VerifiedStations = running.VerifiedStations
+		maps.Copy(tally.Votes, running.Votes)
+	}
+	return tally, nil
+}
+
+// GetTallyTrend returns the aggregated tally snapshots of a voting process in chronological order
+func (s *StorageService) GetTallyTrend(processID string) ([]models.TallySnapshot, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	if _, exists := s.votingProcesses[processID]; !exists {
+		return nil, fmt.Errorf("voting process not found: %s", processID)
+	}
+
+	// Return a copy to avoid race conditions
+	trend := make([]models.TallySnapshot, len(s.tallyTrends[processID]))
+	copy(trend, s.tallyTrends[processID])
+	return trend, nil
+}
+
 // GetAllPollingStations returns all polling stations
 func (s *StorageService) GetAllPollingStations() map[string]*models.PollingStation {
 	s.mutex.RLock()
@@ -125,9 +703,23 @@ func (s *StorageService) GetAllPollingStations() map[string]*models.PollingStati
 	// Return a copy to avoid race conditions
 	result := make(map[string]*models.PollingStation)
 	for k, v := range s.pollingStations {
-		stationCopy := *v
-		result[k] = &stationCopy
+		result[k] = copyPollingStation(v)
+	}
+	return result
+}
+
+// ListPollingStations returns copies of all polling stations sorted by ID
+func (s *StorageService) ListPollingStations() []*models.PollingStation {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	result := make([]*models.PollingStation, 0, len(s.pollingStations))
+	for _, station := range s.pollingStations {
+		result = append(result, copyPollingStation(station))
 	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
 	return result
 }
 
@@ -161,12 +753,21 @@ func (s *StorageService) StoreVotingProcess(votingProcess models.VotingProcess)
 			s.pollingStations[stationID] = &models.PollingStation{
 				ID:              stationID,
 				VotingProcessID: votingProcess.ID,
-				Status:          "Pending",
+				Status:          models.StationStatusPending,
 				Submissions:     []models.Submission{},
 			}
 		} else {
-			// Update existing station to associate with voting process
-			s.pollingStations[stationID].VotingProcessID = votingProcess.ID
+			// Update existing station to associate with voting process, moving any verified result with it
+			station := s.pollingStations[stationID]
+			moved := s.applyToVerifiedTally(station, -1) && station.VotingProcessID != votingProcess.ID
+			if moved {
+				s.recordTallySnapshot(station)
+			}
+			station.VotingProcessID = votingProcess.ID
+			s.applyToVerifiedTally(station, 1)
+			if moved {
+				s.recordTallySnapshot(station)
+			}
 		}
 	}
 
@@ -186,6 +787,49 @@ func (s *StorageService) GetVotingProcess(processID string) (*models.VotingProce
 	return nil, fmt.Errorf("voting process not found: %s", processID)
 }
 
+// AddPollingStationsToVotingProcess registers new polling stations to a voting process still in Setup.
+// Stations already in the process or registered to another process are rejected, and nothing is added on error.
+func (s *StorageService) AddPollingStationsToVotingProcess(processID string, stationIDs []string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	process, exists := s.votingProcesses[processID]
+	if !exists {
+		return fmt.Errorf("voting process not found: %s", processID)
+	}
+
+	if process.Status != models.ProcessStatusSetup {
+		return fmt.Errorf("voting process %s is not in setup (status: %s)", processID, process.Status)
+	}
+
+	for _, stationID := range stationIDs {
+		if station, exists := s.pollingStations[stationID]; exists && station.VotingProcessID != "" {
+			return fmt.Errorf("duplicate polling station ID: %s is already registered to voting process %s", stationID, station.VotingProcessID)
+		}
+	}
+
+	for _, stationID := range stationIDs {
+		if station, exists := s.pollingStations[stationID]; exists {
+			station.VotingProcessID = processID
+			s.applyToVerifiedTally(station, 1)
+		} else {
+			s.pollingStations[stationID] = &models.PollingStation{
+				ID:              stationID,
+				VotingProcessID: processID,
+				Status:          models.StationStatusPending,
+				Submissions:     []models.Submission{},
+			}
+		}
+	}
+
+	// Build a new slice so copies returned by GetVotingProcess never share the appended backing array
+	pollingStations := make([]string, 0, len(process.PollingStations)+len(stationIDs))
+	pollingStations = append(pollingStations, process.PollingStations...)
+	process.PollingStations = append(pollingStations, stationIDs...)
+
+	return nil
+}
+
 // UpdateVotingProcessStatus updates the status of a voting process
 func (s *StorageService) UpdateVotingProcessStatus(processID, status string) error {
 	s.mutex.Lock()
@@ -195,16 +839,19 @@ func (s *StorageService) UpdateVotingProcessStatus(processID, status string) err
 	if !exists {
 		return fmt.Errorf("voting process not found: %s", processID)
 	}
+	if err := models.ValidateProcessTransition(process.Status, status); err != nil {
+		return err
+	}
 
 	process.Status = status
 	now := time.Now()
 
 	switch status {
-	case "Active":
+	case models.ProcessStatusActive:
 		if process.StartedAt == nil {
 			process.StartedAt = &now
 		}
-	case "Complete":
+	case models.ProcessStatusComplete:
 		if process.CompletedAt == nil {
 			process.CompletedAt = &now
 		}
@@ -213,6 +860,69 @@ func (s *StorageService) UpdateVotingProcessStatus(processID, status string) err
 	return nil
 }
 
+// CompleteVotingProcessIfVerified moves an Active voting process to Complete once at least the given fraction
+// of its polling stations are verified. The check and the status change happen under one lock, so concurrent
+// verifications complete the process exactly once. It reports whether this call completed the process.
+func (s *StorageService) CompleteVotingProcessIfVerified(processID string, fraction float64) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	process, exists := s.votingProcesses[processID]
+	if !exists {
+		return false, fmt.Errorf("voting process not found: %s", processID)
+	}
+
+	if process.Status != models.ProcessStatusActive || fraction <= 0 || len(process.PollingStations) == 0 {
+		return false, nil
+	}
+
+	verified := 0
+	for _, stationID := range process.PollingStations {
+		if station, exists := s.pollingStations[stationID]; exists && station.Status == models.StationStatusVerified {
+			verified++
+		}
+	}
+
+	if float64(verified)/float64(len(process.PollingStations)) < fraction {
+		return false, nil
+	}
+
+	if err := models.ValidateProcessTransition(process.Status, models.ProcessStatusComplete); err != nil {
+		return false, err
+	}
+
+	now := time.Now()
+	process.Status = models.ProcessStatusComplete
+	process.CompletedAt = &now
+
+	return true, nil
+}
+
+// ActivateScheduledVotingProcesses moves every Setup voting process whose scheduled start has passed to Active.
+// The check and the status change happen under one lock so a concurrent manual start cannot start a process twice.
+func (s *StorageService) ActivateScheduledVotingProcesses(now time.Time) []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	activated := []string{}
+	for processID, process := range s.votingProcesses {
+		if process.Status != models.ProcessStatusSetup || process.ScheduledStart == nil || now.Before(*process.ScheduledStart) {
+			continue
+		}
+		if err := models.ValidateProcessTransition(process.Status, models.ProcessStatusActive); err != nil {
+			continue
+		}
+
+		startedAt := now
+		process.Status = models.ProcessStatusActive
+		process.StartedAt = &startedAt
+		activated = append(activated, processID)
+	}
+
+	sort.Strings(activated)
+	return activated
+}
+
 // GetAllVotingProcesses returns all voting processes
 func (s *StorageService) GetAllVotingProcesses() map[string]*models.VotingProcess {
 	s.mutex.RLock()
@@ -227,6 +937,25 @@ func (s *StorageService) GetAllVotingProcesses() map[string]*models.VotingProces
 	return result
 }
 
+// ListVotingProcesses returns copies of all voting processes sorted by creation time, then by ID
+func (s *StorageService) ListVotingProcesses() []*models.VotingProcess {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	result := make([]*models.VotingProcess, 0, len(s.votingProcesses))
+	for _, process := range s.votingProcesses {
+		processCopy := *process
+		result = append(result, &processCopy)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].CreatedAt.Equal(result[j].CreatedAt) {
+			return result[i].CreatedAt.Before(result[j].CreatedAt)
+		}
+		return result[i].ID < result[j].ID
+	})
+	return result
+}
+
 // GetPollingStationsByVotingProcess returns all polling stations for a voting process
 func (s *StorageService) GetPollingStationsByVotingProcess(processID string) ([]*models.PollingStation, error) {
 	s.mutex.RLock()
@@ -240,14 +969,68 @@ func (s *StorageService) GetPollingStationsByVotingProcess(processID string) ([]
 	var stations []*models.PollingStation
 	for _, stationID := range process.PollingStations {
 		if station, exists := s.pollingStations[stationID]; exists {
-			stationCopy := *station
-			stations = append(stations, &stationCopy)
+			stations = append(stations, copyPollingStation(station))
 		}
 	}
 
 	return stations, nil
 }
 
+// GetSubmissionCountsByVotingProcess counts the submissions and distinct wallets of every station in a voting process
+// under a single read lock, in the process's station order. Compacted stations report their submission summary.
+func (s *StorageService) GetSubmissionCountsByVotingProcess(processID string) ([]models.StationSubmissionCount, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	process, exists := s.votingProcesses[processID]
+	if !exists {
+		return nil, fmt.Errorf("voting process not found: %s", processID)
+	}
+
+	counts := make([]models.StationSubmissionCount, 0, len(process.PollingStations))
+	for _, stationID := range process.PollingStations {
+		count := models.StationSubmissionCount{PollingStationID: stationID}
+
+		if station, exists := s.pollingStations[stationID]; exists && station.Compacted && station.SubmissionSummary != nil {
+			count.TotalSubmissions = station.SubmissionSummary.TotalSubmissions
+			count.UniqueWallets = station.SubmissionSummary.UniqueWallets
+		} else {
+			wallets := make(map[string]bool)
+			for _, submission := range s.submissions[stationID] {
+				wallets[submission.WalletAddress] = true
+			}
+			count.TotalSubmissions = len(s.submissions[stationID])
+			count.UniqueWallets = len(wallets)
+		}
+
+		counts = append(counts, count)
+	}
+
+	return counts, nil
+}
+
+// GetDistinctWalletCountByVotingProcess counts the different wallets that submitted to any station of a voting
+// process, so a wallet witnessing several stations is counted once. Compacted processes report the count taken
+// when their raw submissions were dropped.
+func (s *StorageService) GetDistinctWalletCountByVotingProcess(processID string) (int, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	process, exists := s.votingProcesses[processID]
+	if !exists {
+		return 0, fmt.Errorf("voting process not found: %s", processID)
+	}
+
+	wallets := make(map[string]bool)
+	for _, stationID := range process.PollingStations {
+		for _, submission := range s.submissions[stationID] {
+			wallets[submission.WalletAddress] = true
+		}
+	}
+
+	return process.CompactedWallets + len(wallets), nil
+}
+
 // IsPollingStationInActiveVotingProcess checks if a polling station belongs to an active voting process
 func (s *StorageService) IsPollingStationInActiveVotingProcess(stationID string) bool {
 	s.mutex.RLock()
@@ -263,5 +1046,84 @@ func (s *StorageService) IsPollingStationInActiveVotingProcess(stationID string)
 		return false
 	}
 
-	return process.Status == "Active"
-}
\ No newline at end of file
+	return process.Status == models.ProcessStatusActive
+}
+
+// IsPollingStationEmbargoed reports whether the voting process a polling station belongs to withholds its vote counts
+func (s *StorageService) IsPollingStationEmbargoed(stationID string) bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	station, exists := s.pollingStations[stationID]
+	if !exists || station.VotingProcessID == "" {
+		return false
+	}
+
+	process, exists := s.votingProcesses[station.VotingProcessID]
+	return exists && process.ResultsEmbargoed()
+}
+
+// CompactVotingProcessSubmissions drops the raw submissions of every station in a completed voting process.
+// Verified results and consensus metadata are kept, and each station records a summary of what it received.
+func (s *StorageService) CompactVotingProcessSubmissions(processID string) (int, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	process, exists := s.votingProcesses[processID]
+	if !exists {
+		return 0, fmt.Errorf("voting process not found: %s", processID)
+	}
+
+	if process.Status != models.ProcessStatusComplete {
+		return 0, fmt.Errorf("voting process %s is not complete (status: %s)", processID, process.Status)
+	}
+
+	now := time.Now()
+	compactedStations := 0
+	processWallets := make(map[string]bool)
+
+	for _, stationID := range process.PollingStations {
+		station, exists := s.pollingStations[stationID]
+		if !exists || station.Compacted {
+			continue
+		}
+
+		summary := &models.SubmissionSummary{
+			SubmissionTypes: make(map[string]int),
+			CompactedAt:     now,
+		}
+		wallets := make(map[string]bool)
+		for _, submission := range s.submissions[stationID] {
+			summary.TotalSubmissions++
+			summary.SubmissionTypes[submission.SubmissionType]++
+			wallets[submission.WalletAddress] = true
+			processWallets[submission.WalletAddress] = true
+
+			// Drop the wallet tracking entry so the raw submission can be released
+			if walletStations, exists := s.walletSubmissions[submission.WalletAddress]; exists {
+				delete(walletStations, stationID)
+				if len(walletStations) == 0 {
+					delete(s.walletSubmissions, submission.WalletAddress)
+				}
+			}
+			if history, exists := s.submissionHistory[submission.WalletAddress]; exists {
+				delete(history, stationID)
+				if len(history) == 0 {
+					delete(s.submissionHistory, submission.WalletAddress)
+				}
+			}
+		}
+		summary.UniqueWallets = len(wallets)
+
+		delete(s.submissions, stationID)
+		station.Submissions = nil
+		station.Compacted = true
+		station.SubmissionSummary = summary
+		compactedStations++
+	}
+
+	process.CompactedAt = &now
+	process.CompactedWallets += len(processWallets)
+
+	return compactedStations, nil
+}
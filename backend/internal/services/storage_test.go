@@ -1,10 +1,14 @@
 package services
 
 import (
+	"errors"
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/sirupsen/logrus"
+
 	"oyah-backend/internal/models"
 )
 
@@ -116,6 +120,83 @@ func TestStorageService_DuplicateSubmissionPrevention(t *testing.T) {
 	}
 }
 
+func TestStorageService_DuplicateWindow(t *testing.T) {
+	capturedAt := time.Now()
+	newSubmission := func(id string) models.Submission {
+		return models.Submission{
+			ID:               id,
+			WalletAddress:    "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY",
+			PollingStationID: "STATION_001",
+			Timestamp:        capturedAt,
+			Results:          map[string]int{"Candidate A": 100, "Candidate B": 150},
+			SubmissionType:   "image_ocr",
+			Confidence:       0.85,
+		}
+	}
+
+	t.Run("within window is coalesced", func(t *testing.T) {
+		storage := NewStorageService()
+
+		if err := storage.StoreSubmission(newSubmission("sub1")); err != nil {
+			t.Fatalf("StoreSubmission() error = %v", err)
+		}
+		if err := storage.StoreSubmission(newSubmission("sub2")); !errors.Is(err, ErrSubmissionCoalesced) {
+			t.Fatalf("Expected ErrSubmissionCoalesced, got %v", err)
+		}
+
+		submissions := storage.GetSubmissionsByStation("STATION_001")
+		if len(submissions) != 1 || submissions[0].ID != "sub1" {
+			t.Errorf("Expected only the first submission to be stored, got %v", submissions)
+		}
+	})
+
+	t.Run("outside window is processed", func(t *testing.T) {
+		storage := NewStorageService()
+		storage.SetDuplicateWindow(20 * time.Millisecond)
+
+		if err := storage.StoreSubmission(newSubmission("sub1")); err != nil {
+			t.Fatalf("StoreSubmission() error = %v", err)
+		}
+		time.Sleep(30 * time.Millisecond)
+		if err := storage.StoreSubmission(newSubmission("sub2")); err != nil {
+			t.Fatalf("StoreSubmission() error = %v", err)
+		}
+
+		submissions := storage.GetSubmissionsByStation("STATION_001")
+		if len(submissions) != 1 || submissions[0].ID != "sub2" {
+			t.Errorf("Expected the second submission to replace the first, got %v", submissions)
+		}
+	})
+
+	t.Run("different results within window are processed", func(t *testing.T) {
+		storage := NewStorageService()
+
+		if err := storage.StoreSubmission(newSubmission("sub1")); err != nil {
+			t.Fatalf("StoreSubmission() error = %v", err)
+		}
+		corrected := newSubmission("sub2")
+		corrected.Results = map[string]int{"Candidate A": 101, "Candidate B": 150}
+		if err := storage.StoreSubmission(corrected); err != nil {
+			t.Fatalf("StoreSubmission() error = %v", err)
+		}
+
+		if submissions := storage.GetSubmissionsByStation("STATION_001"); submissions[0].ID != "sub2" {
+			t.Errorf("Expected the corrected submission to be stored, got %s", submissions[0].ID)
+		}
+	})
+
+	t.Run("disabled window never coalesces", func(t *testing.T) {
+		storage := NewStorageService()
+		storage.SetDuplicateWindow(0)
+
+		for _, id := range []string{"sub1", "sub2"} {
+			if err := storage.StoreSubmission(newSubmission(id)); err != nil {
+				t.Fatalf("StoreSubmission() error = %v", err)
+			}
+		}
+	})
+}
+
 func TestStorageService_MultipleWalletsForSameStation(t *testing.T) {
 	storage := NewStorageService()
 
@@ -285,4 +366,289 @@ func TestStorageService_GetAllPollingStations(t *testing.T) {
 			t.Errorf("Expected station %s to exist", stationID)
 		}
 	}
-}
\ No newline at end of file
+}
+
+func TestStorageService_ListPollingStations(t *testing.T) {
+	storage := NewStorageService()
+
+	// Insert out of order so map iteration cannot accidentally match the expected order
+	stations := []string{"STATION_C", "STATION_A", "STATION_E", "STATION_B", "STATION_D"}
+	if err := storage.StoreVotingProcess(models.VotingProcess{ID: "vp-list", PollingStations: stations, Status: "Setup"}); err != nil {
+		t.Fatalf("StoreVotingProcess() error = %v", err)
+	}
+
+	expected := []string{"STATION_A", "STATION_B", "STATION_C", "STATION_D", "STATION_E"}
+	for run := 0; run < 20; run++ {
+		listed := storage.ListPollingStations()
+		if len(listed) != len(expected) {
+			t.Fatalf("Expected %d polling stations, got %d", len(expected), len(listed))
+		}
+		for i, station := range listed {
+			if station.ID != expected[i] {
+				t.Fatalf("Run %d: expected station %s at position %d, got %s", run, expected[i], i, station.ID)
+			}
+		}
+	}
+}
+
+func TestStorageService_ListVotingProcesses(t *testing.T) {
+	storage := NewStorageService()
+
+	base := time.Now()
+	processes := []models.VotingProcess{
+		{ID: "vp-late", CreatedAt: base.Add(2 * time.Hour)},
+		{ID: "vp-tie-b", CreatedAt: base},
+		{ID: "vp-early", CreatedAt: base.Add(-time.Hour)},
+		{ID: "vp-tie-a", CreatedAt: base},
+	}
+	for _, process := range processes {
+		if err := storage.StoreVotingProcess(process); err != nil {
+			t.Fatalf("StoreVotingProcess() error = %v", err)
+		}
+	}
+
+	// Sorted by creation time, with equal times ordered by ID
+	expected := []string{"vp-early", "vp-tie-a", "vp-tie-b", "vp-late"}
+	for run := 0; run < 20; run++ {
+		listed := storage.ListVotingProcesses()
+		if len(listed) != len(expected) {
+			t.Fatalf("Expected %d voting processes, got %d", len(expected), len(listed))
+		}
+		for i, process := range listed {
+			if process.ID != expected[i] {
+				t.Fatalf("Run %d: expected process %s at position %d, got %s", run, expected[i], i, process.ID)
+			}
+		}
+	}
+
+	// Returned entries are copies
+	storage.ListVotingProcesses()[0].Title = "modified"
+	if process, _ := storage.GetVotingProcess("vp-early"); process.Title == "modified" {
+		t.Error("Expected ListVotingProcesses to return copies")
+	}
+}
+
+func TestStorageService_GetDistinctWalletCountByVotingProcess(t *testing.T) {
+	storage := NewStorageService()
+
+	for _, process := range []models.VotingProcess{
+		{ID: "vp-wallets", PollingStations: []string{"WALLETS_001", "WALLETS_002", "WALLETS_003"}, Status: "Active"},
+		{ID: "vp-other", PollingStations: []string{"OTHER_001"}, Status: "Active"},
+	} {
+		if err := storage.StoreVotingProcess(process); err != nil {
+			t.Fatalf("StoreVotingProcess() error = %v", err)
+		}
+	}
+
+	// wallet-a and wallet-b witness several stations; wallet-d only submits to another process
+	submissions := []struct {
+		wallet  string
+		station string
+	}{
+		{"wallet-a", "WALLETS_001"},
+		{"wallet-b", "WALLETS_001"},
+		{"wallet-a", "WALLETS_002"},
+		{"wallet-b", "WALLETS_002"},
+		{"wallet-c", "WALLETS_002"},
+		{"wallet-a", "WALLETS_003"},
+		{"wallet-d", "OTHER_001"},
+	}
+	for i, s := range submissions {
+		err := storage.StoreSubmission(models.Submission{
+			ID:               fmt.Sprintf("distinct-%d", i),
+			WalletAddress:    s.wallet,
+			PollingStationID: s.station,
+			Timestamp:        time.Now(),
+			Results:          map[string]int{"Candidate A": 100 + i},
+			SubmissionType:   "image_ocr",
+		})
+		if err != nil {
+			t.Fatalf("StoreSubmission() error = %v", err)
+		}
+	}
+
+	count, err := storage.GetDistinctWalletCountByVotingProcess("vp-wallets")
+	if err != nil {
+		t.Fatalf("GetDistinctWalletCountByVotingProcess() error = %v", err)
+	}
+	if count != 3 {
+		t.Errorf("Expected 3 distinct wallets, got %d", count)
+	}
+
+	// The count survives compaction of the raw submissions
+	if err := storage.UpdateVotingProcessStatus("vp-wallets", "Complete"); err != nil {
+		t.Fatalf("UpdateVotingProcessStatus() error = %v", err)
+	}
+	if _, err := storage.CompactVotingProcessSubmissions("vp-wallets"); err != nil {
+		t.Fatalf("CompactVotingProcessSubmissions() error = %v", err)
+	}
+	count, err = storage.GetDistinctWalletCountByVotingProcess("vp-wallets")
+	if err != nil {
+		t.Fatalf("GetDistinctWalletCountByVotingProcess() error = %v", err)
+	}
+	if count != 3 {
+		t.Errorf("Expected 3 distinct wallets after compaction, got %d", count)
+	}
+
+	if _, err := storage.GetDistinctWalletCountByVotingProcess("non-existent"); err == nil {
+		t.Error("Expected an error for an unknown voting process")
+	}
+}
+
+func TestStorageService_SubmissionHistoryLimit(t *testing.T) {
+	storage := NewStorageService()
+	storage.SetDuplicateWindow(0)
+	storage.SetSubmissionHistoryLimit(3)
+
+	const resubmissions = 10
+	for i := 0; i < resubmissions; i++ {
+		err := storage.StoreSubmission(models.Submission{
+			ID:               fmt.Sprintf("history-%d", i),
+			WalletAddress:    "history-wallet",
+			PollingStationID: "HISTORY_STATION",
+			Timestamp:        time.Now(),
+			Results:          map[string]int{"Candidate A": 100 + i},
+			SubmissionType:   "image_ocr",
+		})
+		if err != nil {
+			t.Fatalf("StoreSubmission() error = %v", err)
+		}
+
+		history := storage.GetSubmissionHistory("history-wallet", "HISTORY_STATION")
+		if len(history) > 3 {
+			t.Fatalf("After %d submissions: expected at most 3 retained, got %d", i+1, len(history))
+		}
+		if expected := min(i, 3); len(history) != expected {
+			t.Errorf("After %d submissions: expected %d retained, got %d", i+1, expected, len(history))
+		}
+	}
+
+	// The most recent replaced submissions are kept, oldest first; the latest is the station's live submission
+	history := storage.GetSubmissionHistory("history-wallet", "HISTORY_STATION")
+	for i, expectedID := range []string{"history-6", "history-7", "history-8"} {
+		if history[i].ID != expectedID {
+			t.Errorf("History entry %d: expected %s, got %s", i, expectedID, history[i].ID)
+		}
+	}
+	live := storage.GetSubmissionsByStation("HISTORY_STATION")
+	if len(live) != 1 || live[0].ID != "history-9" {
+		t.Errorf("Expected only history-9 to be live, got %+v", live)
+	}
+
+	// Lowering the limit evicts the oldest entries
+	storage.SetSubmissionHistoryLimit(1)
+	history = storage.GetSubmissionHistory("history-wallet", "HISTORY_STATION")
+	if len(history) != 1 || history[0].ID != "history-8" {
+		t.Errorf("Expected only history-8 after lowering the limit, got %+v", history)
+	}
+
+	// A zero limit keeps no history
+	storage.SetSubmissionHistoryLimit(0)
+	if history := storage.GetSubmissionHistory("history-wallet", "HISTORY_STATION"); len(history) != 0 {
+		t.Errorf("Expected no history with a zero limit, got %d entries", len(history))
+	}
+}
+
+func TestStorageService_ConcurrentTallyReadsDuringWrites(t *testing.T) {
+	storage := NewStorageService()
+	logger := logrus.New()
+	logger.SetLevel(logrus.PanicLevel)
+
+	consensusService := NewConsensusService(storage, logger)
+	tallyService := NewTallyService(storage, logger)
+
+	stationIDs := []string{"RACE001", "RACE002", "RACE003"}
+	if err := storage.StoreVotingProcess(models.VotingProcess{
+		ID:              "vp-race",
+		Candidates:      []models.Candidate{{ID: "c1", Name: "Alice"}, {ID: "c2", Name: "Bob"}},
+		PollingStations: stationIDs,
+		Status:          "Active",
+		CreatedAt:       time.Now(),
+	}); err != nil {
+		t.Fatalf("StoreVotingProcess() error = %v", err)
+	}
+
+	const submissionsPerStation = 20
+	var writers, readers sync.WaitGroup
+	done := make(chan struct{})
+
+	// Witnesses submit to every station while consensus re-verifies it after each submission
+	for _, stationID := range stationIDs {
+		writers.Add(1)
+		go func(stationID string) {
+			defer writers.Done()
+			for i := 0; i < submissionsPerStation; i++ {
+				submission := models.Submission{
+					ID:               fmt.Sprintf("%s-sub-%d", stationID, i),
+					WalletAddress:    fmt.Sprintf("%s-wallet-%d", stationID, i),
+					PollingStationID: stationID,
+					Timestamp:        time.Now(),
+					Results:          map[string]int{"Alice": 120, "Bob": 80},
+					SubmissionType:   "image_ocr",
+					Confidence:       0.9,
+				}
+				if err := storage.StoreSubmission(submission); err != nil {
+					t.Errorf("StoreSubmission() error = %v", err)
+					return
+				}
+				if _, err := consensusService.ProcessConsensus(stationID); err != nil {
+					t.Errorf("ProcessConsensus() error = %v", err)
+					return
+				}
+			}
+		}(stationID)
+	}
+
+	// Observers fetch tallies and station copies the whole time, and are free to modify what they get back
+	for r := 0; r < 4; r++ {
+		readers.Add(1)
+		go func() {
+			defer readers.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+
+				if _, err := tallyService.GetTallyData("vp-race"); err != nil {
+					t.Errorf("GetTallyData() error = %v", err)
+					return
+				}
+				stations, err := storage.GetPollingStationsByVotingProcess("vp-race")
+				if err != nil {
+					t.Errorf("GetPollingStationsByVotingProcess() error = %v", err)
+					return
+				}
+				for _, station := range stations {
+					for candidate := range station.VerifiedResults {
+						station.VerifiedResults[candidate]++
+					}
+					for i := range station.Submissions {
+						station.Submissions[i].Results["Alice"] = -1
+					}
+				}
+			}
+		}()
+	}
+
+	writers.Wait()
+	close(done)
+	readers.Wait()
+
+	// The readers' modifications never reach storage
+	tally, err := tallyService.GetTallyData("vp-race")
+	if err != nil {
+		t.Fatalf("GetTallyData() error = %v", err)
+	}
+	if tally.AggregatedTally["Alice"] != 120*len(stationIDs) || tally.AggregatedTally["Bob"] != 80*len(stationIDs) {
+		t.Errorf("Expected Alice %d and Bob %d, got %v", 120*len(stationIDs), 80*len(stationIDs), tally.AggregatedTally)
+	}
+	for _, stationID := range stationIDs {
+		for _, submission := range storage.GetSubmissionsByStation(stationID) {
+			if submission.Results["Alice"] != 120 {
+				t.Errorf("Submission %s results were modified through a copy: %v", submission.ID, submission.Results)
+			}
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package services
+
+import (
+	"oyah-backend/internal/models"
+)
+
+// submissionTypes lists the capture methods a submission can use; they always appear in a breakdown
+var submissionTypes = []string{"image_ocr", "audio_stt"}
+
+// SubmissionTypeStats summarizes the submissions of one capture method
+type SubmissionTypeStats struct {
+	Count             int      `json:"count"`
+	AverageConfidence *float64 `json:"averageConfidence"` // Nil when there are no submissions or they were compacted
+}
+
+// SubmissionTypeBreakdown groups a polling station's submissions by capture method for capture-quality analysis
+type SubmissionTypeBreakdown struct {
+	PollingStationID string                         `json:"pollingStationId"`
+	TotalSubmissions int                            `json:"totalSubmissions"`
+	Compacted        bool                           `json:"compacted"`
+	Types            map[string]SubmissionTypeStats `json:"types"`
+}
+
+// BreakdownSubmissionTypes counts a polling station's submissions per type and averages their confidence.
+// Compacted stations only retain per-type counts, so their averages are nil.
+func BreakdownSubmissionTypes(station *models.PollingStation, submissions []models.Submission) *SubmissionTypeBreakdown {
+	breakdown := &SubmissionTypeBreakdown{
+		PollingStationID: station.ID,
+		Types:            make(map[string]SubmissionTypeStats, len(submissionTypes)),
+	}
+	for _, submissionType := range submissionTypes {
+		breakdown.Types[submissionType] = SubmissionTypeStats{}
+	}
+
+	if station.Compacted && station.SubmissionSummary != nil {
+		breakdown.Compacted = true
+		breakdown.TotalSubmissions = station.SubmissionSummary.TotalSubmissions
+		for submissionType, count := range station.SubmissionSummary.SubmissionTypes {
+			breakdown.Types[submissionType] = SubmissionTypeStats{Count: count}
+		}
+		return breakdown
+	}
+
+	confidenceSums := make(map[string]float64)
+	for _, submission := range submissions {
+		stats := breakdown.Types[submission.SubmissionType]
+		stats.Count++
+		breakdown.Types[submission.SubmissionType] = stats
+		confidenceSums[submission.SubmissionType] += submission.Confidence
+	}
+	breakdown.TotalSubmissions = len(submissions)
+
+	for submissionType, stats := range breakdown.Types {
+		if stats.Count == 0 {
+			continue
+		}
+		average := confidenceSums[submissionType] / float64(stats.Count)
+		stats.AverageConfidence = &average
+		breakdown.Types[submissionType] = stats
+	}
+
+	return breakdown
+}
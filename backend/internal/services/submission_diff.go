@@ -0,0 +1,75 @@
+package services
+
+import (
+	"sort"
+
+	"oyah-backend/internal/models"
+)
+
+// SubmissionDiffLine compares one result key across two submissions.
+// A nil value means the key is missing from that submission; missing values count as zero in the delta.
+type SubmissionDiffLine struct {
+	Key      string `json:"key"`
+	ValueA   *int   `json:"valueA"`
+	ValueB   *int   `json:"valueB"`
+	Delta    int    `json:"delta"` // ValueB - ValueA
+	Mismatch bool   `json:"mismatch"`
+}
+
+// SubmissionDiff is a side-by-side comparison of two submissions' results
+type SubmissionDiff struct {
+	SubmissionA        string               `json:"submissionA"`
+	SubmissionB        string               `json:"submissionB"`
+	SamePollingStation bool                 `json:"samePollingStation"`
+	Lines              []SubmissionDiffLine `json:"lines"`
+	MismatchCount      int                  `json:"mismatchCount"`
+	Identical          bool                 `json:"identical"`
+}
+
+// DiffSubmissions compares the results of two submissions key by key, ordered by key
+func DiffSubmissions(a, b models.Submission) *SubmissionDiff {
+	keys := make(map[string]bool)
+	for key := range a.Results {
+		keys[key] = true
+	}
+	for key := range b.Results {
+		keys[key] = true
+	}
+
+	sortedKeys := make([]string, 0, len(keys))
+	for key := range keys {
+		sortedKeys = append(sortedKeys, key)
+	}
+	sort.Strings(sortedKeys)
+
+	diff := &SubmissionDiff{
+		SubmissionA:        a.ID,
+		SubmissionB:        b.ID,
+		SamePollingStation: a.PollingStationID == b.PollingStationID,
+		Lines:              make([]SubmissionDiffLine, 0, len(sortedKeys)),
+	}
+
+	for _, key := range sortedKeys {
+		line := SubmissionDiffLine{Key: key}
+
+		valueA, inA := a.Results[key]
+		if inA {
+			line.ValueA = &valueA
+		}
+		valueB, inB := b.Results[key]
+		if inB {
+			line.ValueB = &valueB
+		}
+
+		line.Delta = valueB - valueA
+		line.Mismatch = inA != inB || valueA != valueB
+		if line.Mismatch {
+			diff.MismatchCount++
+		}
+
+		diff.Lines = append(diff.Lines, line)
+	}
+
+	diff.Identical = diff.MismatchCount == 0
+	return diff
+}
@@ -0,0 +1,68 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oyah-backend/internal/models"
+)
+
+func TestDiffSubmissions(t *testing.T) {
+	t.Run("OverlappingAndDifferingKeys", func(t *testing.T) {
+		a := models.Submission{ID: "a", PollingStationID: "PS001", Results: map[string]int{"Alice": 100, "Bob": 80, "spoilt": 2}}
+		b := models.Submission{ID: "b", PollingStationID: "PS001", Results: map[string]int{"Alice": 100, "Bob": 85, "spoilt": 2}}
+
+		diff := DiffSubmissions(a, b)
+
+		assert.True(t, diff.SamePollingStation)
+		assert.False(t, diff.Identical)
+		assert.Equal(t, 1, diff.MismatchCount)
+		require.Len(t, diff.Lines, 3)
+
+		assert.Equal(t, "Alice", diff.Lines[0].Key)
+		assert.False(t, diff.Lines[0].Mismatch)
+		assert.Equal(t, 0, diff.Lines[0].Delta)
+
+		assert.Equal(t, "Bob", diff.Lines[1].Key)
+		assert.True(t, diff.Lines[1].Mismatch)
+		assert.Equal(t, 80, *diff.Lines[1].ValueA)
+		assert.Equal(t, 85, *diff.Lines[1].ValueB)
+		assert.Equal(t, 5, diff.Lines[1].Delta)
+	})
+
+	t.Run("CandidateMissingFromOneSubmission", func(t *testing.T) {
+		a := models.Submission{ID: "a", PollingStationID: "PS001", Results: map[string]int{"Alice": 100, "Carol": 7}}
+		b := models.Submission{ID: "b", PollingStationID: "PS002", Results: map[string]int{"Alice": 100, "Dave": 0}}
+
+		diff := DiffSubmissions(a, b)
+
+		assert.False(t, diff.SamePollingStation)
+		assert.Equal(t, 2, diff.MismatchCount)
+		require.Len(t, diff.Lines, 3)
+
+		carol := diff.Lines[1]
+		assert.Equal(t, "Carol", carol.Key)
+		require.NotNil(t, carol.ValueA)
+		assert.Nil(t, carol.ValueB)
+		assert.Equal(t, -7, carol.Delta)
+		assert.True(t, carol.Mismatch)
+
+		// A key present with zero still differs from a missing key
+		dave := diff.Lines[2]
+		assert.Equal(t, "Dave", dave.Key)
+		assert.Nil(t, dave.ValueA)
+		require.NotNil(t, dave.ValueB)
+		assert.Equal(t, 0, dave.Delta)
+		assert.True(t, dave.Mismatch)
+	})
+
+	t.Run("IdenticalResults", func(t *testing.T) {
+		results := map[string]int{"Alice": 10, "Bob": 5}
+		diff := DiffSubmissions(models.Submission{ID: "a", Results: results}, models.Submission{ID: "b", Results: results})
+
+		assert.True(t, diff.Identical)
+		assert.Equal(t, 0, diff.MismatchCount)
+	})
+}
@@ -0,0 +1,64 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"oyah-backend/internal/models"
+)
+
+// submissionIDNamespace is the UUID namespace for deterministic submission IDs
+var submissionIDNamespace = uuid.NewSHA1(uuid.NameSpaceURL, []byte("oyah-backend/submissions"))
+
+// SubmissionIDGenerator generates submission IDs, either randomly or derived from submission content
+type SubmissionIDGenerator struct {
+	deterministic bool
+}
+
+// NewSubmissionIDGenerator creates a generator that produces random IDs
+func NewSubmissionIDGenerator() *SubmissionIDGenerator {
+	return &SubmissionIDGenerator{}
+}
+
+// SetDeterministic enables or disables deriving IDs from (wallet + station + content hash)
+func (g *SubmissionIDGenerator) SetDeterministic(deterministic bool) {
+	g.deterministic = deterministic
+}
+
+// IsDeterministic reports whether IDs are derived from submission content
+func (g *SubmissionIDGenerator) IsDeterministic() bool {
+	return g.deterministic
+}
+
+// GenerateID returns the ID for a submission request
+func (g *SubmissionIDGenerator) GenerateID(req models.SubmissionRequest) string {
+	if !g.deterministic {
+		return uuid.New().String()
+	}
+
+	name := req.WalletAddress + "|" + req.PollingStationID + "|" + submissionContentHash(req)
+	return uuid.NewSHA1(submissionIDNamespace, []byte(name)).String()
+}
+
+// submissionContentHash hashes the submitted results and submission type in a canonical order
+func submissionContentHash(req models.SubmissionRequest) string {
+	candidates := make([]string, 0, len(req.Results))
+	for candidate := range req.Results {
+		candidates = append(candidates, candidate)
+	}
+	sort.Strings(candidates)
+
+	var content strings.Builder
+	content.WriteString(req.SubmissionType)
+	for _, candidate := range candidates {
+		content.WriteString(fmt.Sprintf("|%q:%d", candidate, req.Results[candidate]))
+	}
+
+	sum := sha256.Sum256([]byte(content.String()))
+	return hex.EncodeToString(sum[:])
+}
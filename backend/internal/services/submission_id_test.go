@@ -0,0 +1,72 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"oyah-backend/internal/models"
+)
+
+func newIDTestRequest(results map[string]int) models.SubmissionRequest {
+	return models.SubmissionRequest{
+		WalletAddress:    "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY",
+		PollingStationID: "STATION_001",
+		Timestamp:        time.Now(),
+		Results:          results,
+		SubmissionType:   "image_ocr",
+		Confidence:       0.9,
+	}
+}
+
+func TestSubmissionIDGenerator_RandomByDefault(t *testing.T) {
+	generator := NewSubmissionIDGenerator()
+	assert.False(t, generator.IsDeterministic())
+
+	req := newIDTestRequest(map[string]int{"Candidate A": 100, "Candidate B": 50})
+	id1 := generator.GenerateID(req)
+	id2 := generator.GenerateID(req)
+
+	assert.NotEqual(t, id1, id2)
+	_, err := uuid.Parse(id1)
+	assert.NoError(t, err)
+}
+
+func TestSubmissionIDGenerator_Deterministic(t *testing.T) {
+	generator := NewSubmissionIDGenerator()
+	generator.SetDeterministic(true)
+
+	base := newIDTestRequest(map[string]int{"Candidate A": 100, "Candidate B": 50})
+
+	t.Run("IdenticalContent", func(t *testing.T) {
+		retry := newIDTestRequest(map[string]int{"Candidate B": 50, "Candidate A": 100})
+		retry.Timestamp = base.Timestamp.Add(time.Minute)
+		retry.Confidence = 0.5
+
+		assert.Equal(t, generator.GenerateID(base), generator.GenerateID(retry))
+	})
+
+	t.Run("DifferentResults", func(t *testing.T) {
+		changed := newIDTestRequest(map[string]int{"Candidate A": 101, "Candidate B": 50})
+		assert.NotEqual(t, generator.GenerateID(base), generator.GenerateID(changed))
+	})
+
+	t.Run("DifferentWallet", func(t *testing.T) {
+		other := newIDTestRequest(map[string]int{"Candidate A": 100, "Candidate B": 50})
+		other.WalletAddress = "5FHneW46xGXgs5mUiveU4sbTyGBzmstUspZC92UhjJM694ty"
+		assert.NotEqual(t, generator.GenerateID(base), generator.GenerateID(other))
+	})
+
+	t.Run("DifferentStation", func(t *testing.T) {
+		other := newIDTestRequest(map[string]int{"Candidate A": 100, "Candidate B": 50})
+		other.PollingStationID = "STATION_002"
+		assert.NotEqual(t, generator.GenerateID(base), generator.GenerateID(other))
+	})
+
+	t.Run("ValidUUID", func(t *testing.T) {
+		_, err := uuid.Parse(generator.GenerateID(base))
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,85 @@
+package services
+
+import (
+	"math"
+	"time"
+)
+
+// intakeHighWaterFraction is the share of the intake buffer at which new submissions are turned away,
+// leaving headroom so submissions already accepted are never starved
+const intakeHighWaterFraction = 0.9
+
+// DefaultIntakeRetryAfter is how long clients are told to wait before retrying a rejected submission
+const DefaultIntakeRetryAfter = 5 * time.Second
+
+// SubmissionIntake bounds how many submissions are stored and processed at once.
+// When the buffer is nearly full, new submissions are rejected so clients can back off and retry.
+type SubmissionIntake struct {
+	slots      chan struct{}
+	highWater  int
+	retryAfter time.Duration
+
+	rejectedCounter *Counter
+}
+
+// NewSubmissionIntake creates an intake with room for capacity submissions in flight
+func NewSubmissionIntake(capacity int, retryAfter time.Duration) *SubmissionIntake {
+	if capacity <= 0 {
+		capacity = 1
+	}
+	if retryAfter <= 0 {
+		retryAfter = DefaultIntakeRetryAfter
+	}
+
+	highWater := int(math.Ceil(float64(capacity) * intakeHighWaterFraction))
+	if highWater < 1 {
+		highWater = 1
+	}
+
+	return &SubmissionIntake{
+		slots:           make(chan struct{}, capacity),
+		highWater:       highWater,
+		retryAfter:      retryAfter,
+		rejectedCounter: NewCounter("oyah_submission_intake_rejected_total", "Submissions rejected because the intake was nearly full"),
+	}
+}
+
+// Metrics returns the intake's counters for registration with a metrics registry
+func (i *SubmissionIntake) Metrics() []*Counter {
+	return []*Counter{i.rejectedCounter}
+}
+
+// TryAcquire reserves a slot for a submission without blocking.
+// It returns false when the intake has reached its high-water mark; callers must Release acquired slots.
+func (i *SubmissionIntake) TryAcquire() bool {
+	if len(i.slots) >= i.highWater {
+		i.rejectedCounter.Inc()
+		return false
+	}
+
+	select {
+	case i.slots <- struct{}{}:
+		return true
+	default:
+		i.rejectedCounter.Inc()
+		return false
+	}
+}
+
+// Release frees a slot reserved by TryAcquire
+func (i *SubmissionIntake) Release() {
+	select {
+	case <-i.slots:
+	default:
+	}
+}
+
+// InFlight returns the number of submissions currently holding a slot
+func (i *SubmissionIntake) InFlight() int {
+	return len(i.slots)
+}
+
+// RetryAfter returns how long rejected clients should wait before retrying
+func (i *SubmissionIntake) RetryAfter() time.Duration {
+	return i.retryAfter
+}
@@ -0,0 +1,38 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubmissionIntake_RejectsAtHighWaterMark(t *testing.T) {
+	// 90% of 10 slots: the tenth submission is turned away
+	intake := NewSubmissionIntake(10, 2*time.Second)
+
+	for i := 0; i < 9; i++ {
+		assert.True(t, intake.TryAcquire(), "submission %d should be accepted", i+1)
+	}
+	assert.False(t, intake.TryAcquire())
+	assert.Equal(t, 9, intake.InFlight())
+	assert.Equal(t, int64(1), intake.Metrics()[0].Value())
+
+	// Releasing a slot makes room again
+	intake.Release()
+	assert.True(t, intake.TryAcquire())
+	assert.Equal(t, 2*time.Second, intake.RetryAfter())
+}
+
+func TestSubmissionIntake_Defaults(t *testing.T) {
+	intake := NewSubmissionIntake(0, 0)
+
+	assert.True(t, intake.TryAcquire())
+	assert.False(t, intake.TryAcquire())
+	assert.Equal(t, DefaultIntakeRetryAfter, intake.RetryAfter())
+
+	// Releasing more than was acquired never blocks
+	intake.Release()
+	intake.Release()
+	assert.Equal(t, 0, intake.InFlight())
+}
@@ -2,6 +2,8 @@ package services
 
 import (
 	"fmt"
+	"math"
+	"sort"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -10,43 +12,176 @@ import (
 
 // TallyService handles tally calculation and aggregation for voting processes
 type TallyService struct {
-	storageService *StorageService
-	logger         *logrus.Logger
+	storageService   *StorageService
+	consensusService *ConsensusService
+	percentageBasis  string
+	logger           *logrus.Logger
 }
 
+// Bases for candidate percentages
+const (
+	// PercentageBasisValid divides by candidate votes only, leaving spoilt ballots out (default)
+	PercentageBasisValid = "valid"
+	// PercentageBasisAll divides by every counted ballot, spoilt ones included
+	PercentageBasisAll = "all"
+)
+
 // TallyResponse represents the response structure for tally data
 type TallyResponse struct {
 	VotingProcess   VotingProcessInfo `json:"votingProcess"`
 	AggregatedTally map[string]int    `json:"aggregatedTally"`
 	PollingStations []StationStatus   `json:"pollingStations"`
 	LastUpdated     time.Time         `json:"lastUpdated"`
+
+	// Leading candidates filling the available seats, by verified votes; empty until votes are verified
+	Winners []string `json:"winners,omitempty"`
+	SeatTie bool     `json:"seatTie,omitempty"` // The last seat is tied with the next candidate
+
+	// Provisional view: verified results plus the leading result of each pending or provisionally verified station
+	ProvisionalTally    map[string]int `json:"provisionalTally,omitempty"`
+	ProvisionalStations []string       `json:"provisionalStations,omitempty"`
+
+	// Verified stations whose ballot total deviates from the declared expected ballots
+	IntegrityWarnings []IntegrityWarning `json:"integrityWarnings,omitempty"`
+
+	// Each candidate's share of the aggregated tally, rounded to two decimals, and the votes it was divided by
+	Percentages     map[string]float64 `json:"percentages,omitempty"`
+	PercentageBasis string             `json:"percentageBasis,omitempty"` // "valid" | "all"
+
+	// Vote counts are withheld because the process publishes results only once Complete
+	Embargoed bool `json:"embargoed,omitempty"`
+
+	// Verified votes for candidates the voting process did not declare, kept out of the aggregate and winners
+	WriteIns map[string]int `json:"writeIns,omitempty"`
+
+	// Verified stations left out of the aggregate on request, e.g. while their results are disputed
+	ExcludedStations []string `json:"excludedStations,omitempty"`
+}
+
+// IntegrityWarning flags a verified station whose candidate and spoilt votes do not add up to its expected ballots
+type IntegrityWarning struct {
+	PollingStationID string `json:"pollingStationId"`
+	ExpectedBallots  int    `json:"expectedBallots"`
+	CountedBallots   int    `json:"countedBallots"` // Candidate plus spoilt votes in the verified result
+	Deviation        int    `json:"deviation"`      // Counted minus expected
+	Message          string `json:"message"`
+}
+
+// TallyBatchResult holds either the tally of one voting process in a batch request or the error that prevented it
+type TallyBatchResult struct {
+	Tally *TallyResponse `json:"tally,omitempty"`
+	Error *APIError      `json:"error,omitempty"`
 }
 
 // VotingProcessInfo represents voting process information in tally response
 type VotingProcessInfo struct {
-	ID         string             `json:"id"`
-	Title      string             `json:"title"`
-	Position   string             `json:"position"`
-	Candidates []models.Candidate `json:"candidates"`
-	Status     string             `json:"status"`
+	ID             string             `json:"id"`
+	Title          string             `json:"title"`
+	Position       string             `json:"position"`
+	Candidates     []models.Candidate `json:"candidates"`
+	Status         string             `json:"status"`
+	SeatsAvailable int                `json:"seatsAvailable"`
+
+	VerificationCriteria *VerificationCriteria `json:"verificationCriteria,omitempty"` // Rules stations must meet to verify
 }
 
 // StationStatus represents polling station status in tally response
 type StationStatus struct {
 	ID         string         `json:"id"`
-	Status     string         `json:"status"` // "Pending" | "Verified"
+	Status     string         `json:"status"` // "Pending" | "Verified" | "Challenged"
 	Results    map[string]int `json:"results,omitempty"`
 	Confidence float64        `json:"confidence,omitempty"`
+	Empty      bool           `json:"empty,omitempty"` // Verified with zero votes because the station had no turnout
+
+	FirstSubmissionAt *time.Time `json:"firstSubmissionAt,omitempty"`
+	LastSubmissionAt  *time.Time `json:"lastSubmissionAt,omitempty"` // A stale value points to a station that stopped reporting
+
+	ChallengeID string `json:"challengeId,omitempty"` // Open observer challenge; the station's result still counts
+}
+
+// TallySheet represents a printable per-station tally sheet.
+// Vote figures are nil while the station is still pending verification.
+type TallySheet struct {
+	VotingProcessID  string           `json:"votingProcessId,omitempty"`
+	Title            string           `json:"title"`
+	Position         string           `json:"position"`
+	PollingStationID string           `json:"pollingStationId"`
+	Status           string           `json:"status"` // "Pending" | "Verified"
+	Verified         bool             `json:"verified"`
+	Lines            []TallySheetLine `json:"lines"`
+	Embargoed        bool             `json:"embargoed,omitempty"` // Vote counts are withheld until the voting process is Complete
+	Spoilt           *int             `json:"spoilt"`
+	TotalVotes       *int             `json:"totalVotes"`
+	Confidence       *float64         `json:"confidence"`
+	ConsensusReached *time.Time       `json:"consensusReached"`
+	GeneratedAt      time.Time        `json:"generatedAt"`
+}
+
+// TallySheetLine represents a single candidate row on a tally sheet
+type TallySheetLine struct {
+	CandidateID   string `json:"candidateId,omitempty"`
+	CandidateName string `json:"candidateName"`
+	Votes         *int   `json:"votes"`
+}
+
+// ProgressReport summarizes how far a voting process is towards verifying every polling station
+type ProgressReport struct {
+	VotingProcessID     string     `json:"votingProcessId"`
+	TotalStations       int        `json:"totalStations"`
+	VerifiedStations    int        `json:"verifiedStations"`
+	ReportingStations   int        `json:"reportingStations"` // Stations with at least one submission
+	ProgressFraction    float64    `json:"progressFraction"`  // Verified stations / total stations
+	ProgressPercentage  float64    `json:"progressPercentage"`
+	EstimatedCompletion *time.Time `json:"estimatedCompletion"` // Nil until a rate can be estimated
+	GeneratedAt         time.Time  `json:"generatedAt"`
+}
+
+// TallyProjection extrapolates the final tally of a voting process from its verified stations,
+// assuming pending stations follow the verified distribution. It is an estimate, never an official result.
+type TallyProjection struct {
+	VotingProcessID   string         `json:"votingProcessId"`
+	Official          bool           `json:"official"` // Always false
+	VerifiedTally     map[string]int `json:"verifiedTally"`
+	ProjectedTally    map[string]int `json:"projectedTally"`
+	TotalStations     int            `json:"totalStations"`
+	VerifiedStations  int            `json:"verifiedStations"`
+	ReportingFraction float64        `json:"reportingFraction"` // Verified stations / total stations
+	Confidence        string         `json:"confidence"`        // "none" | "low" | "moderate" | "high"
+	Caveat            string         `json:"caveat"`
+	Embargoed         bool           `json:"embargoed,omitempty"` // Tallies are withheld until the voting process is Complete
+	GeneratedAt       time.Time      `json:"generatedAt"`
 }
 
 // NewTallyService creates a new tally service instance
 func NewTallyService(storage *StorageService, logger *logrus.Logger) *TallyService {
 	return &TallyService{
-		storageService: storage,
-		logger:         logger,
+		storageService:  storage,
+		percentageBasis: PercentageBasisValid,
+		logger:          logger,
 	}
 }
 
+// SetPercentageBasis sets whether candidate percentages are computed over valid votes only or over all ballots,
+// spoilt ones included. An empty basis restores the default of valid votes.
+func (t *TallyService) SetPercentageBasis(basis string) error {
+	switch basis {
+	case "":
+		basis = PercentageBasisValid
+	case PercentageBasisValid, PercentageBasisAll:
+	default:
+		return fmt.Errorf("unknown percentage basis %q", basis)
+	}
+
+	t.percentageBasis = basis
+	t.logger.WithField("percentage_basis", basis).Info("Percentage basis updated")
+	return nil
+}
+
+// SetConsensusService sets the consensus service used to find the leading result of pending stations
+func (t *TallyService) SetConsensusService(consensusService *ConsensusService) {
+	t.consensusService = consensusService
+}
+
 // GetTallyData calculates and returns aggregated tally data for a voting process
 func (t *TallyService) GetTallyData(votingProcessID string) (*TallyResponse, error) {
 	logger := t.logger.WithFields(logrus.Fields{
@@ -72,24 +207,59 @@ func (t *TallyService) GetTallyData(votingProcessID string) (*TallyResponse, err
 
 	logger.WithField("polling_stations_count", len(pollingStations)).Info("Retrieved polling stations")
 
-	// Calculate aggregated tally from verified results only
-	aggregatedTally := t.calculateAggregatedTally(pollingStations, votingProcess.Candidates, logger)
+	// Read the aggregated tally from the running sum of verified results instead of re-summing every station
+	verifiedTally, err := t.storageService.GetVerifiedTally(votingProcessID)
+	if err != nil {
+		logger.WithError(err).Error("Failed to get verified tally")
+		return nil, fmt.Errorf("failed to get verified tally: %w", err)
+	}
+	aggregatedTally, writeIns := splitVerifiedTally(verifiedTally, votingProcess.Candidates, logger)
 
 	// Build station status list
 	stationStatuses := t.buildStationStatusList(pollingStations, logger)
 
+	// Multi-seat positions elect the top N candidates rather than a single leader
+	seatsAvailable := votingProcess.SeatsAvailable
+	if seatsAvailable < 1 {
+		seatsAvailable = 1
+	}
+	winners, seatTie := selectWinners(aggregatedTally, votingProcess.Candidates, seatsAvailable)
+
 	// Create response
 	response := &TallyResponse{
 		VotingProcess: VotingProcessInfo{
-			ID:         votingProcess.ID,
-			Title:      votingProcess.Title,
-			Position:   votingProcess.Position,
-			Candidates: votingProcess.Candidates,
-			Status:     votingProcess.Status,
+			ID:             votingProcess.ID,
+			Title:          votingProcess.Title,
+			Position:       votingProcess.Position,
+			Candidates:     votingProcess.Candidates,
+			Status:         votingProcess.Status,
+			SeatsAvailable: seatsAvailable,
 		},
-		AggregatedTally: aggregatedTally,
-		PollingStations: stationStatuses,
-		LastUpdated:     time.Now(),
+		AggregatedTally:   aggregatedTally,
+		WriteIns:          writeIns,
+		PollingStations:   stationStatuses,
+		LastUpdated:       time.Now(),
+		Winners:           winners,
+		SeatTie:           seatTie,
+		IntegrityWarnings: checkBallotTotals(votingProcess, pollingStations),
+	}
+
+	// Every tally lists all candidates and spoilt votes, even before any station verifies
+	t.HandleZeroResultScenarios(response)
+
+	response.PercentageBasis = t.percentageBasis
+	response.Percentages = candidatePercentages(response.AggregatedTally, votingProcess.Candidates, t.percentageBasis)
+
+	// Publish the verification rules so observers can see how stations are verified
+	if t.consensusService != nil {
+		criteria := t.consensusService.VerificationCriteria(votingProcess)
+		response.VotingProcess.VerificationCriteria = &criteria
+	}
+
+	// Jurisdictions that forbid early publication only see which stations have verified
+	if votingProcess.ResultsEmbargoed() {
+		applyResultEmbargo(response)
+		logger.Info("Tally results embargoed until the voting process is complete")
 	}
 
 	logger.WithFields(logrus.Fields{
@@ -101,41 +271,205 @@ func (t *TallyService) GetTallyData(votingProcessID string) (*TallyResponse, err
 	return response, nil
 }
 
-// calculateAggregatedTally calculates the aggregated tally from verified polling stations only
-func (t *TallyService) calculateAggregatedTally(stations []*models.PollingStation, candidates []models.Candidate, logger *logrus.Entry) map[string]int {
-	aggregatedTally := make(map[string]int)
+// applyResultEmbargo strips every vote count from a tally, leaving only the status of each station
+func applyResultEmbargo(response *TallyResponse) {
+	response.Embargoed = true
+	response.AggregatedTally = nil
+	response.Winners = nil
+	response.SeatTie = false
+	response.Percentages = nil
+	response.IntegrityWarnings = nil
+	response.WriteIns = nil
+	for i := range response.PollingStations {
+		response.PollingStations[i].Results = nil
+		response.PollingStations[i].Confidence = 0
+	}
+}
+
+// checkBallotTotals cross-checks each verified station's total votes against the ballots declared for it.
+// Stations without a declared total, or not yet verified, are skipped.
+func checkBallotTotals(votingProcess *models.VotingProcess, stations []*models.PollingStation) []IntegrityWarning {
+	if len(votingProcess.ExpectedBallots) == 0 {
+		return nil
+	}
+
+	var warnings []IntegrityWarning
+	for _, station := range stations {
+		expected, declared := votingProcess.ExpectedBallots[station.ID]
+		if !declared || station.Status != models.StationStatusVerified || station.VerifiedResults == nil {
+			continue
+		}
+
+		counted := 0
+		for _, votes := range station.VerifiedResults {
+			counted += votes
+		}
+
+		deviation := counted - expected
+		if deviation <= votingProcess.BallotTolerance && -deviation <= votingProcess.BallotTolerance {
+			continue
+		}
+
+		warnings = append(warnings, IntegrityWarning{
+			PollingStationID: station.ID,
+			ExpectedBallots:  expected,
+			CountedBallots:   counted,
+			Deviation:        deviation,
+			Message:          fmt.Sprintf("Verified results total %d ballots but %d were expected (tolerance %d)", counted, expected, votingProcess.BallotTolerance),
+		})
+	}
+
+	// Stable order regardless of storage iteration
+	sort.Slice(warnings, func(i, j int) bool {
+		return warnings[i].PollingStationID < warnings[j].PollingStationID
+	})
+
+	return warnings
+}
+
+// splitVerifiedTally turns a voting process's running verified tally into the aggregated tally of its declared candidates
+// and spoilt votes, plus the write-ins. Every aggregate the tally service reports is read this way.
+func splitVerifiedTally(verifiedTally *VerifiedTally, candidates []models.Candidate, logger *logrus.Entry) (aggregatedTally map[string]int, writeIns map[string]int) {
+	aggregatedTally = make(map[string]int)
 
 	// Initialize tally with all candidates and spoilt votes
 	for _, candidate := range candidates {
 		aggregatedTally[candidate.Name] = 0
 	}
-	aggregatedTally["spoilt"] = 0
+	aggregatedTally[models.SpoiltVotesKey] = 0
 
-	verifiedCount := 0
-	
-	// Sum up verified results only
-	for _, station := range stations {
-		if station.Status == "Verified" && station.VerifiedResults != nil {
-			verifiedCount++
-			for candidate, votes := range station.VerifiedResults {
-				if _, exists := aggregatedTally[candidate]; exists {
-					aggregatedTally[candidate] += votes
-				} else {
-					// Handle case where verified results contain candidates not in the original list
-					// This could happen if there are write-in candidates or data inconsistencies
-					logger.WithFields(logrus.Fields{
-						"station_id": station.ID,
-						"candidate":  candidate,
-					}).Warn("Found candidate in verified results not in original candidate list")
-					aggregatedTally[candidate] = votes
-				}
-			}
+	for candidate, votes := range verifiedTally.Votes {
+		if _, exists := aggregatedTally[candidate]; exists {
+			aggregatedTally[candidate] += votes
+			continue
 		}
+		if writeIns == nil {
+			writeIns = make(map[string]int)
+		}
+		writeIns[candidate] = votes
+	}
+
+	logger.WithFields(logrus.Fields{
+		"verified_stations_processed": verifiedTally.VerifiedStations,
+		"write_ins":                   len(writeIns),
+	}).Info("Read aggregated tally from verified results")
+
+	return aggregatedTally, writeIns
+}
+
+// selectWinners returns the names of the candidates holding the available seats, ordered by verified votes.
+// Equal counts are ordered by name; seatTie reports whether the last seat is tied with the first unseated candidate.
+// No winners are returned until at least one vote has been verified.
+func selectWinners(aggregatedTally map[string]int, candidates []models.Candidate, seats int) ([]string, bool) {
+	totalVotes := 0
+	for _, candidate := range candidates {
+		totalVotes += aggregatedTally[candidate.Name]
+	}
+	if totalVotes == 0 {
+		return nil, false
+	}
+
+	ranked := rankCandidates(aggregatedTally, candidates)
+
+	if seats >= len(ranked) {
+		return ranked, false
+	}
+
+	seatTie := aggregatedTally[ranked[seats-1]] == aggregatedTally[ranked[seats]]
+	return ranked[:seats], seatTie
+}
+
+// rankCandidates orders candidate names by verified votes, highest first, with equal counts ordered by name
+func rankCandidates(aggregatedTally map[string]int, candidates []models.Candidate) []string {
+	ranked := make([]string, 0, len(candidates))
+	for _, candidate := range candidates {
+		ranked = append(ranked, candidate.Name)
 	}
 
-	logger.WithField("verified_stations_processed", verifiedCount).Info("Processed verified stations for aggregation")
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if aggregatedTally[ranked[i]] != aggregatedTally[ranked[j]] {
+			return aggregatedTally[ranked[i]] > aggregatedTally[ranked[j]]
+		}
+		return ranked[i] < ranked[j]
+	})
+
+	return ranked
+}
+
+// Leaderboard ranks a voting process's candidates by verified votes for live visualizations
+type Leaderboard struct {
+	VotingProcessID string             `json:"votingProcessId"`
+	TotalVotes      int                `json:"totalVotes"` // Verified candidate votes, excluding spoilt ballots
+	Candidates      []LeaderboardEntry `json:"candidates"` // Highest first
+}
 
-	return aggregatedTally
+// LeaderboardEntry is one candidate's position on a leaderboard
+type LeaderboardEntry struct {
+	Rank        int     `json:"rank"` // Candidates with equal votes share a rank
+	CandidateID string  `json:"candidateId"`
+	Name        string  `json:"name"`
+	Votes       int     `json:"votes"`
+	Percentage  float64 `json:"percentage"` // Share of the tally's percentage basis, rounded to two decimals
+}
+
+// candidatePercentages computes each candidate's share of a tally, rounded to two decimals. The valid basis divides
+// by candidate votes only; the all basis also counts spoilt ballots.
+func candidatePercentages(tally map[string]int, candidates []models.Candidate, basis string) map[string]float64 {
+	total := 0
+	for _, candidate := range candidates {
+		total += tally[candidate.Name]
+	}
+	if basis == PercentageBasisAll {
+		total += tally[models.SpoiltVotesKey]
+	}
+
+	percentages := make(map[string]float64, len(candidates))
+	for _, candidate := range candidates {
+		percentages[candidate.Name] = 0
+		if total > 0 {
+			percentages[candidate.Name] = math.Round(float64(tally[candidate.Name])/float64(total)*10000) / 100
+		}
+	}
+	return percentages
+}
+
+// BuildLeaderboard ranks the candidates of a tally using the same ordering as the tally's winners.
+// Embargoed tallies withhold vote counts, so they have no leaderboard.
+func BuildLeaderboard(tally *TallyResponse) *Leaderboard {
+	if tally == nil || tally.Embargoed {
+		return nil
+	}
+
+	candidateIDs := make(map[string]string, len(tally.VotingProcess.Candidates))
+	leaderboard := &Leaderboard{
+		VotingProcessID: tally.VotingProcess.ID,
+		Candidates:      make([]LeaderboardEntry, 0, len(tally.VotingProcess.Candidates)),
+	}
+	for _, candidate := range tally.VotingProcess.Candidates {
+		candidateIDs[candidate.Name] = candidate.ID
+		leaderboard.TotalVotes += tally.AggregatedTally[candidate.Name]
+	}
+
+	percentages := tally.Percentages
+	if percentages == nil {
+		percentages = candidatePercentages(tally.AggregatedTally, tally.VotingProcess.Candidates, PercentageBasisValid)
+	}
+
+	for i, name := range rankCandidates(tally.AggregatedTally, tally.VotingProcess.Candidates) {
+		entry := LeaderboardEntry{
+			Rank:        i + 1,
+			CandidateID: candidateIDs[name],
+			Name:        name,
+			Votes:       tally.AggregatedTally[name],
+			Percentage:  percentages[name],
+		}
+		if i > 0 && entry.Votes == leaderboard.Candidates[i-1].Votes {
+			entry.Rank = leaderboard.Candidates[i-1].Rank
+		}
+		leaderboard.Candidates = append(leaderboard.Candidates, entry)
+	}
+
+	return leaderboard
 }
 
 // buildStationStatusList builds the list of station statuses for the response
@@ -144,17 +478,26 @@ func (t *TallyService) buildStationStatusList(stations []*models.PollingStation,
 
 	for _, station := range stations {
 		status := StationStatus{
-			ID:     station.ID,
-			Status: station.Status,
+			ID:                station.ID,
+			Status:            station.Status,
+			Empty:             station.VerifiedEmpty,
+			FirstSubmissionAt: station.FirstSubmissionAt,
+			LastSubmissionAt:  station.LastSubmissionAt,
 		}
 
 		// Include results and confidence only for verified stations
-		if station.Status == "Verified" && station.VerifiedResults != nil {
+		if station.Status == models.StationStatusVerified && station.VerifiedResults != nil {
 			status.Results = make(map[string]int)
 			for k, v := range station.VerifiedResults {
 				status.Results[k] = v
 			}
 			status.Confidence = station.ConfidenceLevel
+
+			// Challenged stations still count, but are flagged until an administrator resolves the challenge
+			if station.Challenge.Open() {
+				status.Status = models.StationStatusChallenged
+				status.ChallengeID = station.Challenge.ID
+			}
 		}
 		// For pending stations, results remain nil as per requirements
 
@@ -168,7 +511,7 @@ func (t *TallyService) buildStationStatusList(stations []*models.PollingStation,
 func (t *TallyService) countVerifiedStations(stations []*models.PollingStation) int {
 	count := 0
 	for _, station := range stations {
-		if station.Status == "Verified" {
+		if station.Status == models.StationStatusVerified {
 			count++
 		}
 	}
@@ -179,7 +522,7 @@ func (t *TallyService) countVerifiedStations(stations []*models.PollingStation)
 func (t *TallyService) countPendingStations(stations []*models.PollingStation) int {
 	count := 0
 	for _, station := range stations {
-		if station.Status == "Pending" {
+		if station.Status == models.StationStatusPending {
 			count++
 		}
 	}
@@ -195,6 +538,177 @@ func (t *TallyService) sumTotalVotes(tally map[string]int) int {
 	return total
 }
 
+// GetVotingProcessProgress reports the fraction of verified stations and estimates when verification completes.
+// The estimate extrapolates the verification rate since the process started.
+func (t *TallyService) GetVotingProcessProgress(votingProcessID string) (*ProgressReport, error) {
+	votingProcess, err := t.storageService.GetVotingProcess(votingProcessID)
+	if err != nil {
+		return nil, fmt.Errorf("voting process not found: %w", err)
+	}
+
+	stations, err := t.storageService.GetPollingStationsByVotingProcess(votingProcessID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get polling stations: %w", err)
+	}
+
+	now := time.Now()
+	report := &ProgressReport{
+		VotingProcessID:  votingProcessID,
+		TotalStations:    len(votingProcess.PollingStations),
+		VerifiedStations: t.countVerifiedStations(stations),
+		GeneratedAt:      now,
+	}
+
+	var lastVerified *time.Time
+	for _, station := range stations {
+		if station.Status == models.StationStatusVerified || station.Compacted || len(t.storageService.GetSubmissionsByStation(station.ID)) > 0 {
+			report.ReportingStations++
+		}
+		if station.ConsensusReached != nil && (lastVerified == nil || station.ConsensusReached.After(*lastVerified)) {
+			lastVerified = station.ConsensusReached
+		}
+	}
+
+	if report.TotalStations > 0 {
+		report.ProgressFraction = float64(report.VerifiedStations) / float64(report.TotalStations)
+		report.ProgressPercentage = report.ProgressFraction * 100
+	}
+
+	switch {
+	case report.TotalStations > 0 && report.VerifiedStations == report.TotalStations:
+		report.EstimatedCompletion = lastVerified
+	case report.VerifiedStations > 0 && votingProcess.StartedAt != nil:
+		elapsed := now.Sub(*votingProcess.StartedAt)
+		remaining := time.Duration(float64(elapsed) * float64(report.TotalStations-report.VerifiedStations) / float64(report.VerifiedStations))
+		estimate := now.Add(remaining)
+		report.EstimatedCompletion = &estimate
+	}
+
+	return report, nil
+}
+
+// GetTallyProjection scales the verified aggregate by total stations / verified stations.
+// The confidence caveat reflects how much of the process has reported.
+func (t *TallyService) GetTallyProjection(votingProcessID string) (*TallyProjection, error) {
+	logger := t.logger.WithFields(logrus.Fields{
+		"voting_process_id": votingProcessID,
+		"service":           "tally",
+	})
+
+	votingProcess, err := t.storageService.GetVotingProcess(votingProcessID)
+	if err != nil {
+		return nil, fmt.Errorf("voting process not found: %w", err)
+	}
+
+	stations, err := t.storageService.GetPollingStationsByVotingProcess(votingProcessID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get polling stations: %w", err)
+	}
+
+	// Extrapolate from the same running verified tally GetTallyData reports
+	runningTally, err := t.storageService.GetVerifiedTally(votingProcessID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get verified tally: %w", err)
+	}
+	verifiedTally, _ := splitVerifiedTally(runningTally, votingProcess.Candidates, logger)
+	projection := &TallyProjection{
+		VotingProcessID:  votingProcessID,
+		VerifiedTally:    verifiedTally,
+		ProjectedTally:   make(map[string]int, len(verifiedTally)),
+		TotalStations:    len(votingProcess.PollingStations),
+		VerifiedStations: t.countVerifiedStations(stations),
+		GeneratedAt:      time.Now(),
+	}
+
+	if projection.TotalStations > 0 {
+		projection.ReportingFraction = float64(projection.VerifiedStations) / float64(projection.TotalStations)
+	}
+
+	scale := 0.0
+	if projection.VerifiedStations > 0 {
+		scale = float64(projection.TotalStations) / float64(projection.VerifiedStations)
+	}
+	for candidate, votes := range verifiedTally {
+		projection.ProjectedTally[candidate] = int(math.Round(float64(votes) * scale))
+	}
+
+	// A projection would reveal the embargoed counts it extrapolates from
+	if votingProcess.ResultsEmbargoed() {
+		projection.Embargoed = true
+		projection.VerifiedTally = nil
+		projection.ProjectedTally = nil
+		projection.Confidence = "none"
+		projection.Caveat = fmt.Sprintf("Projection withheld: voting process %s publishes results only once it is Complete.", votingProcessID)
+		logger.Info("Tally projection embargoed until the voting process is complete")
+		return projection, nil
+	}
+
+	percentage := projection.ReportingFraction * 100
+	switch {
+	case projection.VerifiedStations == 0:
+		projection.Confidence = "none"
+		projection.Caveat = "Projection, not an official result: no stations have been verified yet, so nothing can be extrapolated."
+	case projection.ReportingFraction < 0.25:
+		projection.Confidence = "low"
+		projection.Caveat = fmt.Sprintf("Projection, not an official result: only %.1f%% of stations are verified, so the remaining stations may differ substantially.", percentage)
+	case projection.ReportingFraction < 0.75:
+		projection.Confidence = "moderate"
+		projection.Caveat = fmt.Sprintf("Projection, not an official result: %.1f%% of stations are verified; pending stations are assumed to follow the verified distribution.", percentage)
+	default:
+		projection.Confidence = "high"
+		projection.Caveat = fmt.Sprintf("Projection, not an official result: %.1f%% of stations are verified.", percentage)
+	}
+
+	return projection, nil
+}
+
+// GetTallyLastModified returns when a voting process's verified tally last changed:
+// the latest of its lifecycle timestamps and its stations' verification times
+func (t *TallyService) GetTallyLastModified(votingProcessID string) (time.Time, error) {
+	votingProcess, err := t.storageService.GetVotingProcess(votingProcessID)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("voting process not found: %w", err)
+	}
+
+	stations, err := t.storageService.GetPollingStationsByVotingProcess(votingProcessID)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get polling stations: %w", err)
+	}
+
+	lastModified := votingProcess.CreatedAt
+	candidates := []*time.Time{votingProcess.StartedAt, votingProcess.CompletedAt}
+	for _, station := range stations {
+		candidates = append(candidates, station.ConsensusReached, station.LastSubmissionAt)
+	}
+	for _, timestamp := range candidates {
+		if timestamp != nil && timestamp.After(lastModified) {
+			lastModified = *timestamp
+		}
+	}
+
+	return lastModified, nil
+}
+
+// GetTallyTrend returns the snapshots of a voting process's aggregated tally taken each time a station verified.
+// While the process's results are embargoed the snapshots keep their times and station counts but not their tallies.
+func (t *TallyService) GetTallyTrend(votingProcessID string) ([]models.TallySnapshot, error) {
+	votingProcess, err := t.storageService.GetVotingProcess(votingProcessID)
+	if err != nil {
+		return nil, fmt.Errorf("voting process not found: %w", err)
+	}
+	trend, err := t.storageService.GetTallyTrend(votingProcessID)
+	if err != nil {
+		return nil, fmt.Errorf("voting process not found: %w", err)
+	}
+
+	if votingProcess.ResultsEmbargoed() {
+		for i := range trend {
+			trend[i].AggregatedTally = nil
+		}
+	}
+	return trend, nil
+}
+
 // GetTallyDataWithFreshness returns tally data with freshness tracking
 func (t *TallyService) GetTallyDataWithFreshness(votingProcessID string) (*TallyResponse, error) {
 	// For now, this is the same as GetTallyData since we calculate fresh data each time
@@ -225,8 +739,162 @@ func (t *TallyService) HandleZeroResultScenarios(response *TallyResponse) {
 		}
 		
 		// Ensure spoilt votes are represented
-		if _, exists := response.AggregatedTally["spoilt"]; !exists {
-			response.AggregatedTally["spoilt"] = 0
+		if _, exists := response.AggregatedTally[models.SpoiltVotesKey]; !exists {
+			response.AggregatedTally[models.SpoiltVotesKey] = 0
+		}
+	}
+}
+
+// ExcludeStations removes the listed verified stations' results from the aggregated tally and winners, and records
+// which stations were left out. Pending or unknown station IDs are ignored since they contribute nothing.
+func (t *TallyService) ExcludeStations(response *TallyResponse, stationIDs []string) {
+	if response.Embargoed || len(stationIDs) == 0 {
+		return
+	}
+
+	exclude := make(map[string]bool, len(stationIDs))
+	for _, stationID := range stationIDs {
+		exclude[stationID] = true
+	}
+
+	for _, station := range response.PollingStations {
+		counted := station.Status == models.StationStatusVerified || station.Status == models.StationStatusChallenged
+		if !exclude[station.ID] || !counted || station.Results == nil {
+			continue
+		}
+		for candidate, votes := range station.Results {
+			if _, declared := response.AggregatedTally[candidate]; declared {
+				response.AggregatedTally[candidate] -= votes
+			} else if response.WriteIns != nil {
+				response.WriteIns[candidate] -= votes
+			}
+		}
+		response.ExcludedStations = append(response.ExcludedStations, station.ID)
+	}
+
+	if len(response.ExcludedStations) > 0 {
+		response.Winners, response.SeatTie = selectWinners(response.AggregatedTally, response.VotingProcess.Candidates, response.VotingProcess.SeatsAvailable)
+		response.Percentages = candidatePercentages(response.AggregatedTally, response.VotingProcess.Candidates, response.PercentageBasis)
+		t.logger.WithFields(logrus.Fields{
+			"voting_process_id": response.VotingProcess.ID,
+			"excluded_stations": response.ExcludedStations,
+		}).Info("Excluded stations from aggregated tally")
+	}
+}
+
+// IncludeProvisionalTally adds a provisional tally combining the verified aggregate with the leading result of each
+// station not yet counted in it: pending stations and those waiting out the stability window as VerifiedProvisional.
+// The verified AggregatedTally is left unchanged.
+func (t *TallyService) IncludeProvisionalTally(response *TallyResponse) error {
+	if t.consensusService == nil {
+		return fmt.Errorf("consensus service not configured for provisional tally")
+	}
+
+	// An embargoed tally must not leak counts through the provisional view either
+	if response.Embargoed {
+		return nil
+	}
+
+	provisionalTally := make(map[string]int, len(response.AggregatedTally))
+	for candidate, votes := range response.AggregatedTally {
+		provisionalTally[candidate] = votes
+	}
+
+	provisionalStations := []string{}
+	for _, station := range response.PollingStations {
+		if station.Status != models.StationStatusPending && station.Status != models.StationStatusVerifiedProvisional {
+			continue
+		}
+
+		leadingGroup := t.consensusService.GetLeadingGroup(station.ID)
+		if leadingGroup == nil {
+			continue
+		}
+
+		for candidate, votes := range leadingGroup.Results {
+			provisionalTally[candidate] += votes
+		}
+		provisionalStations = append(provisionalStations, station.ID)
+	}
+
+	response.ProvisionalTally = provisionalTally
+	response.ProvisionalStations = provisionalStations
+
+	t.logger.WithFields(logrus.Fields{
+		"voting_process_id":    response.VotingProcess.ID,
+		"provisional_stations": len(provisionalStations),
+	}).Info("Provisional tally calculated")
+
+	return nil
+}
+
+// GetStationTallySheet builds the printable tally sheet for a polling station
+func (t *TallyService) GetStationTallySheet(stationID string) (*TallySheet, error) {
+	station, err := t.storageService.GetPollingStation(stationID)
+	if err != nil {
+		return nil, err
+	}
+
+	sheet := &TallySheet{
+		VotingProcessID:  station.VotingProcessID,
+		PollingStationID: station.ID,
+		Status:           station.Status,
+		Verified:         station.Status == models.StationStatusVerified && station.VerifiedResults != nil,
+		Lines:            []TallySheetLine{},
+		GeneratedAt:      time.Now(),
+	}
+
+	var candidates []models.Candidate
+	if station.VotingProcessID != "" {
+		if votingProcess, err := t.storageService.GetVotingProcess(station.VotingProcessID); err == nil {
+			sheet.Title = votingProcess.Title
+			sheet.Position = votingProcess.Position
+			candidates = votingProcess.Candidates
+			sheet.Embargoed = votingProcess.ResultsEmbargoed()
 		}
 	}
-}
\ No newline at end of file
+	// An embargoed sheet lists the candidates and the station's status, but no counts
+	counted := sheet.Verified && !sheet.Embargoed
+
+	// One line per candidate, in ballot order
+	listed := make(map[string]bool)
+	for _, candidate := range candidates {
+		line := TallySheetLine{
+			CandidateID:   candidate.ID,
+			CandidateName: candidate.Name,
+		}
+		if counted {
+			votes := station.VerifiedResults[candidate.Name]
+			line.Votes = &votes
+		}
+		sheet.Lines = append(sheet.Lines, line)
+		listed[candidate.Name] = true
+	}
+
+	if !counted {
+		return sheet, nil
+	}
+
+	// Candidates in the verified results but not on the ballot are listed after it
+	extras := []string{}
+	for name := range station.VerifiedResults {
+		if name != models.SpoiltVotesKey && !listed[name] {
+			extras = append(extras, name)
+		}
+	}
+	sort.Strings(extras)
+	for _, name := range extras {
+		votes := station.VerifiedResults[name]
+		sheet.Lines = append(sheet.Lines, TallySheetLine{CandidateName: name, Votes: &votes})
+	}
+
+	spoilt := station.VerifiedResults[models.SpoiltVotesKey]
+	total := t.sumTotalVotes(station.VerifiedResults)
+	confidence := station.ConfidenceLevel
+	sheet.Spoilt = &spoilt
+	sheet.TotalVotes = &total
+	sheet.Confidence = &confidence
+	sheet.ConsensusReached = station.ConsensusReached
+
+	return sheet, nil
+}
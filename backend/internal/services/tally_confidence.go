@@ -0,0 +1,67 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"oyah-backend/internal/models"
+)
+
+// confidenceBuckets is how many equal-width ranges the [0, 1] confidence scale is split into
+const confidenceBuckets = 10
+
+// ConfidenceDistribution counts a voting process's verified stations by consensus confidence, for dashboard histograms
+type ConfidenceDistribution struct {
+	VotingProcessID  string             `json:"votingProcessId"`
+	VerifiedStations int                `json:"verifiedStations"`
+	Buckets          []ConfidenceBucket `json:"buckets"` // Ascending, covering 0 to 1
+	GeneratedAt      time.Time          `json:"generatedAt"`
+}
+
+// ConfidenceBucket is one confidence range of the distribution. Min is inclusive and Max exclusive,
+// except for the last bucket, which also holds stations verified with a confidence of exactly 1.
+type ConfidenceBucket struct {
+	Min   float64 `json:"min"`
+	Max   float64 `json:"max"`
+	Count int     `json:"count"`
+}
+
+// GetConfidenceDistribution buckets a voting process's verified stations by their consensus confidence level
+func (t *TallyService) GetConfidenceDistribution(votingProcessID string) (*ConfidenceDistribution, error) {
+	if _, err := t.storageService.GetVotingProcess(votingProcessID); err != nil {
+		return nil, fmt.Errorf("voting process not found: %w", err)
+	}
+
+	stations, err := t.storageService.GetPollingStationsByVotingProcess(votingProcessID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get polling stations: %w", err)
+	}
+
+	distribution := &ConfidenceDistribution{
+		VotingProcessID: votingProcessID,
+		Buckets:         make([]ConfidenceBucket, confidenceBuckets),
+		GeneratedAt:     time.Now(),
+	}
+	for i := range distribution.Buckets {
+		distribution.Buckets[i].Min = float64(i) / confidenceBuckets
+		distribution.Buckets[i].Max = float64(i+1) / confidenceBuckets
+	}
+
+	for _, station := range stations {
+		if station.Status != models.StationStatusVerified {
+			continue
+		}
+		distribution.VerifiedStations++
+		distribution.Buckets[confidenceBucketIndex(station.ConfidenceLevel)].Count++
+	}
+
+	return distribution, nil
+}
+
+// confidenceBucketIndex returns the bucket a confidence level falls in, clamping values outside [0, 1]
+func confidenceBucketIndex(confidence float64) int {
+	// The small epsilon keeps boundaries such as 0.7, stored as 0.69999..., in the bucket they name
+	index := int(math.Floor(confidence*confidenceBuckets + 1e-9))
+	return min(max(index, 0), confidenceBuckets-1)
+}
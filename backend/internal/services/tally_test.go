@@ -74,10 +74,12 @@ func TestTallyService_GetTallyData(t *testing.T) {
 		ConfidenceLevel: 0.0,
 	}
 
-	// Manually add stations to storage (simulating what would happen through normal flow)
-	storage.pollingStations["station-1"] = station1
-	storage.pollingStations["station-2"] = station2
-	storage.pollingStations["station-3"] = station3
+	// Verify stations through storage, as consensus would, so the running verified tally includes them
+	for _, station := range []*models.PollingStation{station1, station2, station3} {
+		if station.Status == "Verified" {
+			require.NoError(t, storage.UpdatePollingStationStatus(station.ID, station.Status, station.VerifiedResults, station.ConfidenceLevel))
+		}
+	}
 
 	// Test GetTallyData
 	response, err := tallyService.GetTallyData("test-process-1")
@@ -237,7 +239,7 @@ func TestTallyService_CalculateAggregatedTally(t *testing.T) {
 	}
 
 	logger_entry := logger.WithField("test", "calculate_aggregated_tally")
-	result := tallyService.calculateAggregatedTally(stations, candidates, logger_entry)
+	result, writeIns := tallyService.calculateAggregatedTally(stations, candidates, logger_entry)
 
 	expected := map[string]int{
 		"Alice":  250, // 100 + 150
@@ -246,6 +248,7 @@ func TestTallyService_CalculateAggregatedTally(t *testing.T) {
 	}
 
 	assert.Equal(t, expected, result)
+	assert.Nil(t, writeIns)
 }
 
 func TestTallyService_BuildStationStatusList(t *testing.T) {
@@ -329,4 +332,559 @@ func TestTallyService_SumTotalVotes(t *testing.T) {
 
 	total := tallyService.sumTotalVotes(tally)
 	assert.Equal(t, 360, total)
-}
\ No newline at end of file
+}
+
+func TestTallyService_GetTallyTrend(t *testing.T) {
+	storage := NewStorageService()
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+	tallyService := NewTallyService(storage, logger)
+
+	votingProcess := models.VotingProcess{
+		ID:       "trend-process",
+		Title:    "Trend Election",
+		Position: "Mayor",
+		Candidates: []models.Candidate{
+			{ID: "candidate-1", Name: "Alice"},
+			{ID: "candidate-2", Name: "Bob"},
+		},
+		PollingStations: []string{"trend-1", "trend-2", "trend-3"},
+		Status:          "Active",
+		CreatedAt:       time.Now(),
+	}
+	require.NoError(t, storage.StoreVotingProcess(votingProcess))
+
+	trend, err := tallyService.GetTallyTrend("trend-process")
+	require.NoError(t, err)
+	assert.Empty(t, trend)
+
+	// Pending updates do not change the aggregate
+	require.NoError(t, storage.UpdatePollingStationStatus("trend-1", "Pending", nil, 0))
+
+	require.NoError(t, storage.UpdatePollingStationStatus("trend-1", "Verified", map[string]int{"Alice": 100, "Bob": 50, "spoilt": 2}, 0.9))
+	// Re-running consensus with the same verified results does not add a snapshot
+	require.NoError(t, storage.UpdatePollingStationStatus("trend-1", "Verified", map[string]int{"Alice": 100, "Bob": 50, "spoilt": 2}, 0.9))
+	require.NoError(t, storage.UpdatePollingStationStatus("trend-2", "Verified", map[string]int{"Alice": 30, "Bob": 80, "spoilt": 1}, 0.8))
+
+	trend, err = tallyService.GetTallyTrend("trend-process")
+	require.NoError(t, err)
+	require.Len(t, trend, 2)
+
+	assert.Equal(t, "trend-1", trend[0].PollingStationID)
+	assert.Equal(t, 1, trend[0].VerifiedStations)
+	assert.Equal(t, 100, trend[0].AggregatedTally["Alice"])
+	assert.Equal(t, 50, trend[0].AggregatedTally["Bob"])
+
+	assert.Equal(t, "trend-2", trend[1].PollingStationID)
+	assert.Equal(t, 2, trend[1].VerifiedStations)
+	assert.False(t, trend[1].Timestamp.Before(trend[0].Timestamp))
+
+	// The latest snapshot matches the current tally
+	tally, err := tallyService.GetTallyData("trend-process")
+	require.NoError(t, err)
+	assert.Equal(t, tally.AggregatedTally, trend[len(trend)-1].AggregatedTally)
+
+	// A verified station reverting to Pending takes its votes back out of the trend
+	require.NoError(t, storage.UpdatePollingStationStatus("trend-2", "Pending", nil, 0))
+
+	trend, err = tallyService.GetTallyTrend("trend-process")
+	require.NoError(t, err)
+	require.Len(t, trend, 3)
+	assert.Equal(t, "trend-2", trend[2].PollingStationID)
+	assert.Equal(t, 1, trend[2].VerifiedStations)
+	assert.Equal(t, 100, trend[2].AggregatedTally["Alice"])
+	assert.Equal(t, 50, trend[2].AggregatedTally["Bob"])
+
+	tally, err = tallyService.GetTallyData("trend-process")
+	require.NoError(t, err)
+	assert.Equal(t, tally.AggregatedTally, trend[len(trend)-1].AggregatedTally)
+
+	_, err = tallyService.GetTallyTrend("missing")
+	assert.Error(t, err)
+}
+
+func TestTallyService_Embargo(t *testing.T) {
+	storage := NewStorageService()
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+	tallyService := NewTallyService(storage, logger)
+
+	require.NoError(t, storage.StoreVotingProcess(models.VotingProcess{
+		ID:       "embargo-process",
+		Title:    "Embargoed Election",
+		Position: "Mayor",
+		Candidates: []models.Candidate{
+			{ID: "candidate-1", Name: "Alice"},
+			{ID: "candidate-2", Name: "Bob"},
+		},
+		PollingStations:      []string{"embargo-1", "embargo-2"},
+		Status:               "Active",
+		EmbargoUntilComplete: true,
+		CreatedAt:            time.Now(),
+	}))
+	require.NoError(t, storage.UpdatePollingStationStatus("embargo-1", "Verified", map[string]int{"Alice": 100, "Bob": 50, "spoilt": 2}, 0.9))
+
+	t.Run("SheetWithholdsCounts", func(t *testing.T) {
+		sheet, err := tallyService.GetStationTallySheet("embargo-1")
+		require.NoError(t, err)
+
+		assert.True(t, sheet.Embargoed)
+		assert.Equal(t, "Verified", sheet.Status)
+		require.Len(t, sheet.Lines, 2)
+		for _, line := range sheet.Lines {
+			assert.Nil(t, line.Votes, "candidate %s", line.CandidateName)
+		}
+		assert.Nil(t, sheet.Spoilt)
+		assert.Nil(t, sheet.TotalVotes)
+		assert.Nil(t, sheet.Confidence)
+	})
+
+	t.Run("TrendWithholdsTallies", func(t *testing.T) {
+		trend, err := tallyService.GetTallyTrend("embargo-process")
+		require.NoError(t, err)
+
+		require.Len(t, trend, 1)
+		assert.Equal(t, "embargo-1", trend[0].PollingStationID)
+		assert.Equal(t, 1, trend[0].VerifiedStations)
+		assert.Nil(t, trend[0].AggregatedTally)
+	})
+
+	t.Run("ProjectionWithholdsTallies", func(t *testing.T) {
+		projection, err := tallyService.GetTallyProjection("embargo-process")
+		require.NoError(t, err)
+
+		assert.True(t, projection.Embargoed)
+		assert.Nil(t, projection.VerifiedTally)
+		assert.Nil(t, projection.ProjectedTally)
+		assert.Equal(t, 1, projection.VerifiedStations)
+		assert.Contains(t, projection.Caveat, "withheld")
+	})
+
+	t.Run("CountsRevealedAfterCompletion", func(t *testing.T) {
+		require.NoError(t, storage.UpdateVotingProcessStatus("embargo-process", "Complete"))
+
+		sheet, err := tallyService.GetStationTallySheet("embargo-1")
+		require.NoError(t, err)
+		assert.False(t, sheet.Embargoed)
+		require.NotNil(t, sheet.Lines[0].Votes)
+		assert.Equal(t, 100, *sheet.Lines[0].Votes)
+
+		trend, err := tallyService.GetTallyTrend("embargo-process")
+		require.NoError(t, err)
+		assert.Equal(t, 100, trend[len(trend)-1].AggregatedTally["Alice"])
+
+		projection, err := tallyService.GetTallyProjection("embargo-process")
+		require.NoError(t, err)
+		assert.False(t, projection.Embargoed)
+		assert.Equal(t, 200, projection.ProjectedTally["Alice"])
+	})
+}
+
+func TestTallyService_GetTallyData_MultiSeatWinners(t *testing.T) {
+	storage := NewStorageService()
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+	tallyService := NewTallyService(storage, logger)
+
+	require.NoError(t, storage.StoreVotingProcess(models.VotingProcess{
+		ID:       "council-process",
+		Title:    "Council Election",
+		Position: "Ward Council",
+		Candidates: []models.Candidate{
+			{ID: "c1", Name: "Alice"},
+			{ID: "c2", Name: "Bob"},
+			{ID: "c3", Name: "Carol"},
+			{ID: "c4", Name: "Dave"},
+			{ID: "c5", Name: "Eve"},
+		},
+		PollingStations: []string{"council-1", "council-2"},
+		Status:          "Active",
+		SeatsAvailable:  3,
+		CreatedAt:       time.Now(),
+	}))
+
+	t.Run("NoWinnersBeforeVerification", func(t *testing.T) {
+		tally, err := tallyService.GetTallyData("council-process")
+		require.NoError(t, err)
+		assert.Equal(t, 3, tally.VotingProcess.SeatsAvailable)
+		assert.Empty(t, tally.Winners)
+	})
+
+	// Totals: Dave 110, Carol 95, Alice 90, Eve 45, Bob 25; spoilt votes never win a seat
+	require.NoError(t, storage.UpdatePollingStationStatus("council-1", "Verified",
+		map[string]int{"Alice": 40, "Bob": 10, "Carol": 90, "Dave": 30, "Eve": 20, "spoilt": 500}, 0.9))
+	require.NoError(t, storage.UpdatePollingStationStatus("council-2", "Verified",
+		map[string]int{"Alice": 50, "Bob": 15, "Carol": 5, "Dave": 80, "Eve": 25}, 0.9))
+
+	t.Run("TopThreeCandidatesWin", func(t *testing.T) {
+		tally, err := tallyService.GetTallyData("council-process")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Dave", "Carol", "Alice"}, tally.Winners)
+		assert.False(t, tally.SeatTie)
+	})
+
+	t.Run("TieForLastSeatReported", func(t *testing.T) {
+		winners, seatTie := selectWinners(
+			map[string]int{"Alice": 100, "Bob": 60, "Carol": 60, "Dave": 10},
+			[]models.Candidate{{Name: "Alice"}, {Name: "Bob"}, {Name: "Carol"}, {Name: "Dave"}},
+			2,
+		)
+		assert.Equal(t, []string{"Alice", "Bob"}, winners)
+		assert.True(t, seatTie)
+	})
+
+	t.Run("SingleSeatDefault", func(t *testing.T) {
+		require.NoError(t, storage.StoreVotingProcess(models.VotingProcess{
+			ID:              "mayor-process",
+			Title:           "Mayoral Election",
+			Position:        "Mayor",
+			Candidates:      []models.Candidate{{ID: "m1", Name: "Frank"}, {ID: "m2", Name: "Grace"}},
+			PollingStations: []string{"mayor-1"},
+			Status:          "Active",
+			CreatedAt:       time.Now(),
+		}))
+		require.NoError(t, storage.UpdatePollingStationStatus("mayor-1", "Verified", map[string]int{"Frank": 30, "Grace": 70}, 0.9))
+
+		tally, err := tallyService.GetTallyData("mayor-process")
+		require.NoError(t, err)
+		assert.Equal(t, 1, tally.VotingProcess.SeatsAvailable)
+		assert.Equal(t, []string{"Grace"}, tally.Winners)
+	})
+}
+
+func TestTallyService_GetTallyData_IntegrityWarnings(t *testing.T) {
+	storage := NewStorageService()
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+	tallyService := NewTallyService(storage, logger)
+
+	require.NoError(t, storage.StoreVotingProcess(models.VotingProcess{
+		ID:       "integrity-process",
+		Title:    "Presidential Election",
+		Position: "President",
+		Candidates: []models.Candidate{
+			{ID: "c1", Name: "Alice"},
+			{ID: "c2", Name: "Bob"},
+		},
+		PollingStations: []string{"matching", "deviating", "undeclared", "pending"},
+		Status:          "Active",
+		ExpectedBallots: map[string]int{"matching": 300, "deviating": 300, "pending": 300},
+		BallotTolerance: 5,
+		CreatedAt:       time.Now(),
+	}))
+
+	// 298 counted against 300 expected is within the tolerance of 5
+	require.NoError(t, storage.UpdatePollingStationStatus("matching", "Verified",
+		map[string]int{"Alice": 150, "Bob": 140, "spoilt": 8}, 0.9))
+	// 350 counted against 300 expected
+	require.NoError(t, storage.UpdatePollingStationStatus("deviating", "Verified",
+		map[string]int{"Alice": 200, "Bob": 140, "spoilt": 10}, 0.9))
+	// No declared total, so it is never checked
+	require.NoError(t, storage.UpdatePollingStationStatus("undeclared", "Verified",
+		map[string]int{"Alice": 1000, "Bob": 1000}, 0.9))
+
+	tally, err := tallyService.GetTallyData("integrity-process")
+	require.NoError(t, err)
+
+	require.Len(t, tally.IntegrityWarnings, 1)
+	warning := tally.IntegrityWarnings[0]
+	assert.Equal(t, "deviating", warning.PollingStationID)
+	assert.Equal(t, 300, warning.ExpectedBallots)
+	assert.Equal(t, 350, warning.CountedBallots)
+	assert.Equal(t, 50, warning.Deviation)
+	assert.NotEmpty(t, warning.Message)
+}
+
+func TestTallyService_GetTallyData_PercentageBasis(t *testing.T) {
+	storage := NewStorageService()
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+	tallyService := NewTallyService(storage, logger)
+
+	require.NoError(t, storage.StoreVotingProcess(models.VotingProcess{
+		ID:       "percentage-process",
+		Title:    "Presidential Election",
+		Position: "President",
+		Candidates: []models.Candidate{
+			{ID: "c1", Name: "Alice"},
+			{ID: "c2", Name: "Bob"},
+		},
+		PollingStations: []string{"station-1"},
+		Status:          "Active",
+		CreatedAt:       time.Now(),
+	}))
+	// One ballot in ten was spoilt
+	require.NoError(t, storage.UpdatePollingStationStatus("station-1", "Verified",
+		map[string]int{"Alice": 600, "Bob": 300, "spoilt": 100}, 0.9))
+
+	tests := []struct {
+		basis    string
+		expected map[string]float64
+	}{
+		{"", map[string]float64{"Alice": 66.67, "Bob": 33.33}},
+		{PercentageBasisValid, map[string]float64{"Alice": 66.67, "Bob": 33.33}},
+		{PercentageBasisAll, map[string]float64{"Alice": 60, "Bob": 30}},
+	}
+
+	for _, test := range tests {
+		require.NoError(t, tallyService.SetPercentageBasis(test.basis))
+
+		tally, err := tallyService.GetTallyData("percentage-process")
+		require.NoError(t, err)
+
+		expectedBasis := test.basis
+		if expectedBasis == "" {
+			expectedBasis = PercentageBasisValid
+		}
+		assert.Equal(t, expectedBasis, tally.PercentageBasis)
+		assert.Equal(t, test.expected, tally.Percentages, "basis %q", test.basis)
+
+		leaderboard := BuildLeaderboard(tally)
+		require.NotNil(t, leaderboard)
+		for _, entry := range leaderboard.Candidates {
+			assert.Equal(t, test.expected[entry.Name], entry.Percentage, "basis %q leaderboard entry %s", test.basis, entry.Name)
+		}
+	}
+
+	assert.Error(t, tallyService.SetPercentageBasis("ballots"))
+}
+
+func TestTallyService_GetTallyData_WriteIns(t *testing.T) {
+	storage := NewStorageService()
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+	tallyService := NewTallyService(storage, logger)
+
+	require.NoError(t, storage.StoreVotingProcess(models.VotingProcess{
+		ID:       "write-in-process",
+		Title:    "Mayoral Election",
+		Position: "Mayor",
+		Candidates: []models.Candidate{
+			{ID: "c1", Name: "Alice"},
+			{ID: "c2", Name: "Bob"},
+		},
+		PollingStations: []string{"write-in-1", "write-in-2"},
+		Status:          "Active",
+		CreatedAt:       time.Now(),
+		ValidationRules: models.ValidationRules{WriteInPolicy: models.WriteInPolicyAllow},
+	}))
+	require.NoError(t, storage.UpdatePollingStationStatus("write-in-1", "Verified",
+		map[string]int{"Alice": 100, "Bob": 80, "Carol": 30, "spoilt": 2}, 0.9))
+	require.NoError(t, storage.UpdatePollingStationStatus("write-in-2", "Verified",
+		map[string]int{"Alice": 90, "Bob": 95, "Carol": 45}, 0.9))
+
+	tally, err := tallyService.GetTallyData("write-in-process")
+	require.NoError(t, err)
+
+	// Write-ins are summed across stations, apart from the declared candidates
+	assert.Equal(t, map[string]int{"Alice": 190, "Bob": 175, "spoilt": 2}, tally.AggregatedTally)
+	assert.Equal(t, map[string]int{"Carol": 75}, tally.WriteIns)
+	assert.Equal(t, []string{"Alice"}, tally.Winners)
+
+	tallyService.ExcludeStations(tally, []string{"write-in-2"})
+	assert.Equal(t, map[string]int{"Alice": 100, "Bob": 80, "spoilt": 2}, tally.AggregatedTally)
+	assert.Equal(t, map[string]int{"Carol": 30}, tally.WriteIns)
+}
+
+func TestTallyService_GetTallyData_VerificationCriteria(t *testing.T) {
+	storage := NewStorageService()
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+	tallyService := NewTallyService(storage, logger)
+
+	require.NoError(t, storage.StoreVotingProcess(models.VotingProcess{
+		ID:       "criteria-process",
+		Title:    "Presidential Election",
+		Position: "President",
+		Candidates: []models.Candidate{
+			{ID: "c1", Name: "Alice"},
+			{ID: "c2", Name: "Bob"},
+		},
+		PollingStations:     []string{"criteria-1"},
+		Status:              "Active",
+		ValidationRules:     models.ValidationRules{RejectEmptyTally: true, SubmissionTypes: []string{"audio_stt"}, MaxVotes: 800},
+		MinLocationClusters: 2,
+		CreatedAt:           time.Now(),
+	}))
+
+	t.Run("OmittedWithoutConsensusService", func(t *testing.T) {
+		tally, err := tallyService.GetTallyData("criteria-process")
+		require.NoError(t, err)
+		assert.Nil(t, tally.VotingProcess.VerificationCriteria)
+	})
+
+	consensusService := NewConsensusService(storage, logger)
+	consensusService.SetConsensusThreshold(5)
+	consensusService.SetMinAgreeingWallets(4)
+	consensusService.SetTolerance(2, 0)
+	consensusService.SetStabilityWindow(30 * time.Second)
+	tallyService.SetConsensusService(consensusService)
+
+	t.Run("ReportsCustomCriteria", func(t *testing.T) {
+		tally, err := tallyService.GetTallyData("criteria-process")
+		require.NoError(t, err)
+		require.NotNil(t, tally.VotingProcess.VerificationCriteria)
+
+		assert.Equal(t, VerificationCriteria{
+			ConsensusThreshold:     5,
+			MinAgreeingWallets:     4,
+			MajorityPercentage:     50,
+			ToleranceAbsolute:      2,
+			MinLocationClusters:    2,
+			ClusterRadiusMeters:    DefaultClusterRadiusMeters,
+			StabilityWindowSeconds: 30,
+			RejectEmptyTally:       true,
+			SubmissionTypes:        []string{"audio_stt"},
+			MaxVotes:               800,
+		}, *tally.VotingProcess.VerificationCriteria)
+	})
+}
+
+func TestTallyService_GetTallyData_ZeroResultsListEveryCandidate(t *testing.T) {
+	storage := NewStorageService()
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+	tallyService := NewTallyService(storage, logger)
+
+	require.NoError(t, storage.StoreVotingProcess(models.VotingProcess{
+		ID:       "zero-process",
+		Title:    "Presidential Election",
+		Position: "President",
+		Candidates: []models.Candidate{
+			{ID: "c1", Name: "Alice"},
+			{ID: "c2", Name: "Bob"},
+			{ID: "c3", Name: "Carol"},
+		},
+		PollingStations: []string{"zero-1", "zero-2"},
+		Status:          "Active",
+		CreatedAt:       time.Now(),
+	}))
+
+	// A pending submission never contributes to the verified tally
+	require.NoError(t, storage.StoreSubmission(models.Submission{
+		ID:               "zero-sub",
+		WalletAddress:    "wallet-1",
+		PollingStationID: "zero-1",
+		Timestamp:        time.Now(),
+		Results:          map[string]int{"Alice": 10},
+		SubmissionType:   "image_ocr",
+	}))
+
+	tally, err := tallyService.GetTallyData("zero-process")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"Alice": 0, "Bob": 0, "Carol": 0, models.SpoiltVotesKey: 0}, tally.AggregatedTally)
+}
+
+func TestTallyService_GetTallyData_IncrementalTallyMatchesRecomputation(t *testing.T) {
+	storage := NewStorageService()
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+	tallyService := NewTallyService(storage, logger)
+
+	candidates := []models.Candidate{{ID: "c1", Name: "Alice"}, {ID: "c2", Name: "Bob"}}
+	require.NoError(t, storage.StoreVotingProcess(models.VotingProcess{
+		ID:              "incremental-process",
+		Title:           "Incremental Election",
+		Position:        "President",
+		Candidates:      candidates,
+		PollingStations: []string{"inc-1", "inc-2", "inc-3"},
+		Status:          "Active",
+		CreatedAt:       time.Now(),
+		ValidationRules: models.ValidationRules{WriteInPolicy: models.WriteInPolicyAllow},
+	}))
+
+	steps := []struct {
+		name  string
+		apply func() error
+	}{
+		{"first station verifies", func() error {
+			return storage.UpdatePollingStationStatus("inc-1", "Verified", map[string]int{"Alice": 100, "Bob": 50, "spoilt": 2}, 0.9)
+		}},
+		{"second station verifies with a write-in", func() error {
+			return storage.UpdatePollingStationStatus("inc-2", "Verified", map[string]int{"Alice": 40, "Bob": 60, "Dave": 7}, 0.8)
+		}},
+		{"third station is marked empty", func() error {
+			return storage.MarkPollingStationEmpty("inc-3")
+		}},
+		{"first station's verified result changes", func() error {
+			return storage.UpdatePollingStationStatus("inc-1", "Verified", map[string]int{"Alice": 90, "Bob": 55, "spoilt": 3}, 0.95)
+		}},
+		{"second station reverts to pending", func() error {
+			return storage.UpdatePollingStationStatus("inc-2", "Pending", nil, 0)
+		}},
+		{"second station verifies again without the write-in", func() error {
+			return storage.UpdatePollingStationStatus("inc-2", "Verified", map[string]int{"Alice": 45, "Bob": 60}, 0.85)
+		}},
+	}
+
+	for _, step := range steps {
+		require.NoError(t, step.apply(), step.name)
+
+		response, err := tallyService.GetTallyData("incremental-process")
+		require.NoError(t, err, step.name)
+
+		stations, err := storage.GetPollingStationsByVotingProcess("incremental-process")
+		require.NoError(t, err, step.name)
+		recomputed, recomputedWriteIns := tallyService.calculateAggregatedTally(stations, candidates, logrus.NewEntry(logger))
+
+		assert.Equal(t, recomputed, response.AggregatedTally, step.name)
+		assert.Equal(t, recomputedWriteIns, response.WriteIns, step.name)
+
+		// The projection extrapolates from the same aggregate
+		projection, err := tallyService.GetTallyProjection("incremental-process")
+		require.NoError(t, err, step.name)
+		assert.Equal(t, response.AggregatedTally, projection.VerifiedTally, step.name)
+
+		verifiedTally, err := storage.GetVerifiedTally("incremental-process")
+		require.NoError(t, err, step.name)
+		assert.Equal(t, tallyService.countVerifiedStations(stations), verifiedTally.VerifiedStations, step.name)
+	}
+
+	// After the reversion only the write-in-free results remain
+	response, err := tallyService.GetTallyData("incremental-process")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"Alice": 135, "Bob": 115, "spoilt": 3}, response.AggregatedTally)
+	assert.Nil(t, response.WriteIns)
+}
+
+// calculateAggregatedTally recomputes the aggregated tally from verified polling stations only, so tests can check
+// the running verified tally the tally service reads against a sum over the stations themselves.
+// Votes for candidates missing from the declared list are write-ins, summed separately; writeIns is nil when there are none.
+func (t *TallyService) calculateAggregatedTally(stations []*models.PollingStation, candidates []models.Candidate, logger *logrus.Entry) (aggregatedTally map[string]int, writeIns map[string]int) {
+	aggregatedTally = make(map[string]int)
+
+	// Initialize tally with all candidates and spoilt votes
+	for _, candidate := range candidates {
+		aggregatedTally[candidate.Name] = 0
+	}
+	aggregatedTally[models.SpoiltVotesKey] = 0
+
+	verifiedCount := 0
+	
+	// Sum up verified results only
+	for _, station := range stations {
+		if station.Status == models.StationStatusVerified && station.VerifiedResults != nil {
+			verifiedCount++
+			for candidate, votes := range station.VerifiedResults {
+				if _, exists := aggregatedTally[candidate]; exists {
+					aggregatedTally[candidate] += votes
+				} else {
+					// Verified results naming a candidate not in the original list are write-ins,
+					// accepted because the voting process allows them
+					logger.WithFields(logrus.Fields{
+						"station_id": station.ID,
+						"candidate":  candidate,
+					}).Warn("Found candidate in verified results not in original candidate list")
+					if writeIns == nil {
+						writeIns = make(map[string]int)
+					}
+					writeIns[candidate] += votes
+				}
+			}
+		}
+	}
+
+	logger.WithField("verified_stations_processed", verifiedCount).Info("Processed verified stations for aggregation")
+
+	return aggregatedTally, writeIns
+}
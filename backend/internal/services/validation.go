@@ -2,17 +2,28 @@ package services
 
 import (
 	"fmt"
+	"net/http"
 	"regexp"
+	"slices"
+	"sort"
 	"strings"
 	"time"
 
 	"oyah-backend/internal/models"
 )
 
+// Limits on the free-form tags a submission may carry
+const (
+	MaxSubmissionTags           = 10
+	MaxSubmissionTagKeyLength   = 64
+	MaxSubmissionTagValueLength = 256
+)
+
 // ValidationService provides validation logic for submissions
 type ValidationService struct {
 	walletAddressRegex *regexp.Regexp
 	storageService     *StorageService
+	failureCounter     *CounterVec
 }
 
 // NewValidationService creates a new validation service instance
@@ -23,49 +34,128 @@ func NewValidationService(storage *StorageService) *ValidationService {
 	return &ValidationService{
 		walletAddressRegex: walletRegex,
 		storageService:     storage,
+		failureCounter:     NewCounterVec("oyah_validation_failures_total", "Submissions rejected by validation, by failure reason", "reason"),
 	}
 }
 
+// Metrics returns the validation failure counters for registration with a metrics registry
+func (v *ValidationService) Metrics() *CounterVec {
+	return v.failureCounter
+}
+
+// recordFailure counts a rejected submission under the given failure reason
+func (v *ValidationService) recordFailure(reason string) {
+	v.failureCounter.WithLabelValue(reason).Inc()
+}
+
 // ValidateSubmission validates a submission request
 func (v *ValidationService) ValidateSubmission(req models.SubmissionRequest) error {
 	// Validate wallet address format
 	if err := v.validateWalletAddress(req.WalletAddress); err != nil {
+		v.recordFailure("invalid_wallet")
 		return fmt.Errorf("invalid wallet address: %w", err)
 	}
 
 	// Validate polling station ID
 	if err := v.validatePollingStationID(req.PollingStationID); err != nil {
+		v.recordFailure("invalid_station_id")
 		return fmt.Errorf("invalid polling station ID: %w", err)
 	}
 
 	// Validate GPS coordinates
 	if err := v.validateGPSCoordinates(req.GPSCoordinates); err != nil {
+		v.recordFailure("bad_gps")
 		return fmt.Errorf("invalid GPS coordinates: %w", err)
 	}
 
 	// Validate timestamp (should be within last 8 hours)
 	if err := v.validateTimestamp(req.Timestamp); err != nil {
+		v.recordFailure("stale_timestamp")
 		return fmt.Errorf("invalid timestamp: %w", err)
 	}
 
 	// Validate results
 	if err := v.validateResults(req.Results); err != nil {
+		v.recordFailure("invalid_results")
 		return fmt.Errorf("invalid results: %w", err)
 	}
 
+	// Keys that only differ in case or spacing cannot be attributed to a single candidate
+	if err := v.validateUnambiguousCandidates(req.Results); err != nil {
+		v.recordFailure("ambiguous_candidate")
+		return err
+	}
+
 	// Validate submission type
 	if err := v.validateSubmissionType(req.SubmissionType); err != nil {
+		v.recordFailure("invalid_submission_type")
 		return fmt.Errorf("invalid submission type: %w", err)
 	}
 
 	// Validate confidence (should be between 0 and 1)
 	if err := v.validateConfidence(req.Confidence); err != nil {
+		v.recordFailure("invalid_confidence")
 		return fmt.Errorf("invalid confidence: %w", err)
 	}
 
+	// Validate optional metadata tags
+	if err := v.validateTags(req.Tags); err != nil {
+		v.recordFailure("invalid_tags")
+		return fmt.Errorf("invalid tags: %w", err)
+	}
+
 	// Validate that polling station belongs to an active voting process
 	if err := v.validatePollingStationInActiveVotingProcess(req.PollingStationID); err != nil {
-		return fmt.Errorf("polling station validation failed: %w", err)
+		v.recordFailure("station_not_active")
+		return err
+	}
+
+	// The remaining checks apply the rules of the station's voting process
+	process := v.stationProcess(req.PollingStationID)
+	if process == nil {
+		return nil
+	}
+
+	// Only pre-registered witnesses may submit to a closed voting process
+	if err := v.validateWalletAllowed(process, req.WalletAddress); err != nil {
+		v.recordFailure("wallet_not_authorized")
+		return err
+	}
+
+	// The voting process may only accept some capture channels
+	if err := v.validateSubmissionTypeAllowed(process, req.SubmissionType); err != nil {
+		v.recordFailure("submission_type_not_allowed")
+		return err
+	}
+
+	// Reject all-zero tallies when the voting process asks for it
+	if err := v.validateNonEmptyTally(process, req.PollingStationID, req.Results); err != nil {
+		v.recordFailure("empty_tally")
+		return err
+	}
+
+	// Reject totals above the voting process's vote limit
+	if err := v.validateMaxVotes(process, req.PollingStationID, req.Results); err != nil {
+		v.recordFailure("too_many_votes")
+		return err
+	}
+
+	// Referendum results may only name the configured options
+	if err := v.validateReferendumOptions(process, req.Results); err != nil {
+		v.recordFailure("unknown_option")
+		return err
+	}
+
+	// Undeclared candidates are refused when the voting process does not accept write-ins
+	if err := v.validateWriteIns(process, req.Results); err != nil {
+		v.recordFailure("write_in_not_allowed")
+		return err
+	}
+
+	// Every declared candidate must be reported when the voting process requires complete results
+	if err := v.validateCompleteResults(process, req.Results); err != nil {
+		v.recordFailure("incomplete_results")
+		return err
 	}
 
 	return nil
@@ -121,7 +211,7 @@ func (v *ValidationService) validateGPSCoordinates(coords models.GPSCoordinates)
 // validateTimestamp validates that timestamp is within acceptable range
 func (v *ValidationService) validateTimestamp(timestamp time.Time) error {
 	now := time.Now()
-	
+
 	// Check if timestamp is in the future (with 5 minute tolerance for clock skew)
 	if timestamp.After(now.Add(5 * time.Minute)) {
 		return fmt.Errorf("timestamp cannot be in the future")
@@ -155,6 +245,41 @@ func (v *ValidationService) validateResults(results map[string]int) error {
 	return nil
 }
 
+// normalizeCandidateName folds case and collapses whitespace, so "Alice " and "alice" name the same candidate
+func normalizeCandidateName(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
+
+// validateUnambiguousCandidates rejects results whose keys normalize to the same candidate.
+// Merging or picking one of the counts would silently alter the reported result.
+func (v *ValidationService) validateUnambiguousCandidates(results map[string]int) error {
+	keysByName := make(map[string][]string, len(results))
+	for candidate := range results {
+		name := normalizeCandidateName(candidate)
+		keysByName[name] = append(keysByName[name], candidate)
+	}
+
+	names := make([]string, 0, len(keysByName))
+	for name, keys := range keysByName {
+		if len(keys) > 1 {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	sort.Strings(names)
+	keys := keysByName[names[0]]
+	sort.Strings(keys)
+	return NewAPIError(
+		ErrorTypeAmbiguousCandidate,
+		"Ambiguous candidate",
+		fmt.Sprintf("results keys %q all refer to candidate %q; submit a single count per candidate", keys, names[0]),
+		http.StatusBadRequest,
+	)
+}
+
 // validateSubmissionType validates the submission type
 func (v *ValidationService) validateSubmissionType(submissionType string) error {
 	validTypes := map[string]bool{
@@ -178,6 +303,31 @@ func (v *ValidationService) validateConfidence(confidence float64) error {
 	return nil
 }
 
+// validateTags bounds the number and size of a submission's tags. Keys may not contain ':', which separates
+// key and value when filtering submissions by tag.
+func (v *ValidationService) validateTags(tags map[string]string) error {
+	if len(tags) > MaxSubmissionTags {
+		return fmt.Errorf("at most %d tags are allowed, got %d", MaxSubmissionTags, len(tags))
+	}
+
+	for key, value := range tags {
+		if strings.TrimSpace(key) == "" {
+			return fmt.Errorf("tag key cannot be empty")
+		}
+		if strings.Contains(key, ":") {
+			return fmt.Errorf("tag key %q cannot contain ':'", key)
+		}
+		if len(key) > MaxSubmissionTagKeyLength {
+			return fmt.Errorf("tag key %q must be at most %d characters", key, MaxSubmissionTagKeyLength)
+		}
+		if len(value) > MaxSubmissionTagValueLength {
+			return fmt.Errorf("value of tag %q must be at most %d characters", key, MaxSubmissionTagValueLength)
+		}
+	}
+
+	return nil
+}
+
 // validatePollingStationInActiveVotingProcess validates that the polling station belongs to an active voting process
 func (v *ValidationService) validatePollingStationInActiveVotingProcess(stationID string) error {
 	if v.storageService == nil {
@@ -186,9 +336,218 @@ func (v *ValidationService) validatePollingStationInActiveVotingProcess(stationI
 	}
 
 	// Check if polling station belongs to an active voting process
-	if !v.storageService.IsPollingStationInActiveVotingProcess(stationID) {
-		return fmt.Errorf("polling station %s does not belong to an active voting process", stationID)
+	if v.storageService.IsPollingStationInActiveVotingProcess(stationID) {
+		return nil
 	}
 
-	return nil
-}
\ No newline at end of file
+	// Tell the witness why the station is not accepting submissions
+	var process *models.VotingProcess
+	if station, err := v.storageService.GetPollingStation(stationID); err == nil && station.VotingProcessID != "" {
+		process, _ = v.storageService.GetVotingProcess(station.VotingProcessID)
+	}
+	if process == nil {
+		return NewAPIError(
+			ErrorTypeUnknownStation,
+			"Unknown polling station",
+			fmt.Sprintf("polling station %s does not belong to any voting process", stationID),
+			http.StatusNotFound,
+		)
+	}
+
+	switch process.Status {
+	case models.ProcessStatusSetup:
+		details := fmt.Sprintf("voting process %s for polling station %s has not started yet", process.ID, stationID)
+		if process.ScheduledStart != nil {
+			details += fmt.Sprintf(" (scheduled to start at %s)", process.ScheduledStart.UTC().Format(time.RFC3339))
+		}
+		return NewAPIError(ErrorTypeProcessNotStarted, "Voting process not started", details, http.StatusConflict)
+	case models.ProcessStatusComplete:
+		return NewAPIError(
+			ErrorTypeProcessCompleted,
+			"Voting process completed",
+			fmt.Sprintf("voting process %s for polling station %s is complete and no longer accepts submissions", process.ID, stationID),
+			http.StatusConflict,
+		)
+	default:
+		return fmt.Errorf("polling station validation failed: polling station %s does not belong to an active voting process (status: %s)", stationID, process.Status)
+	}
+}
+
+// stationProcess returns the voting process a polling station belongs to, or nil when there is none to validate against
+func (v *ValidationService) stationProcess(stationID string) *models.VotingProcess {
+	if v.storageService == nil {
+		return nil
+	}
+
+	station, err := v.storageService.GetPollingStation(stationID)
+	if err != nil || station.VotingProcessID == "" {
+		return nil
+	}
+	process, err := v.storageService.GetVotingProcess(station.VotingProcessID)
+	if err != nil {
+		return nil
+	}
+	return process
+}
+
+// validateWalletAllowed rejects wallets missing from the voting process's allowlist.
+// A process without an allowlist is open to every wallet.
+func (v *ValidationService) validateWalletAllowed(process *models.VotingProcess, walletAddress string) error {
+	if len(process.AllowedWallets) == 0 || slices.Contains(process.AllowedWallets, walletAddress) {
+		return nil
+	}
+	return NewAPIError(
+		ErrorTypeWalletNotAuthorized,
+		"Wallet not authorized",
+		fmt.Sprintf("wallet %s is not registered to submit results for voting process %s", walletAddress, process.ID),
+		http.StatusForbidden,
+	)
+}
+
+// validateSubmissionTypeAllowed rejects submissions captured through a channel the voting process does not accept,
+// e.g. OCR submissions to a process that only takes audio transcriptions. A process listing no types accepts every channel.
+func (v *ValidationService) validateSubmissionTypeAllowed(process *models.VotingProcess, submissionType string) error {
+	if len(process.SubmissionTypes) == 0 || slices.Contains(process.SubmissionTypes, submissionType) {
+		return nil
+	}
+	return NewAPIError(
+		ErrorTypeSubmissionTypeNotAllowed,
+		"Submission type not allowed",
+		fmt.Sprintf("voting process %s does not accept %s submissions; allowed: %q", process.ID, submissionType, process.SubmissionTypes),
+		http.StatusBadRequest,
+	)
+}
+
+// validateNonEmptyTally rejects submissions whose votes sum to zero, which usually indicates a failed capture.
+// Only applies when the voting process has RejectEmptyTally enabled, since some stations legitimately have zero turnout.
+func (v *ValidationService) validateNonEmptyTally(process *models.VotingProcess, stationID string, results map[string]int) error {
+	if !process.RejectEmptyTally || totalVotes(results) > 0 {
+		return nil
+	}
+	return NewAPIError(
+		ErrorTypeEmptyTally,
+		"Empty tally",
+		fmt.Sprintf("submission for polling station %s has zero total votes", stationID),
+		http.StatusBadRequest,
+	)
+}
+
+// validateMaxVotes rejects submissions reporting more votes in total, spoilt included, than the voting process allows,
+// which usually indicates a misread digit. Only applies when the voting process sets MaxVotes.
+func (v *ValidationService) validateMaxVotes(process *models.VotingProcess, stationID string, results map[string]int) error {
+	if process.MaxVotes <= 0 {
+		return nil
+	}
+	total := totalVotes(results)
+	if total <= process.MaxVotes {
+		return nil
+	}
+	return NewAPIError(
+		ErrorTypeTooManyVotes,
+		"Too many votes",
+		fmt.Sprintf("submission for polling station %s reports %d votes, above the limit of %d for voting process %s", stationID, total, process.MaxVotes, process.ID),
+		http.StatusBadRequest,
+	)
+}
+
+// validateReferendumOptions rejects results keys other than a referendum's options and spoilt.
+// Only applies when the voting process is a referendum, whose candidates are its options.
+func (v *ValidationService) validateReferendumOptions(process *models.VotingProcess, results map[string]int) error {
+	if !process.Referendum {
+		return nil
+	}
+
+	unknown := undeclaredCandidates(process, results)
+	if len(unknown) == 0 {
+		return nil
+	}
+
+	options := make([]string, 0, len(process.Candidates))
+	for _, candidate := range process.Candidates {
+		options = append(options, candidate.Name)
+	}
+	return NewAPIError(
+		ErrorTypeUnknownOption,
+		"Unknown referendum option",
+		fmt.Sprintf("results keys %q are not options of referendum %s; allowed: %q and %q", unknown, process.ID, options, models.SpoiltVotesKey),
+		http.StatusBadRequest,
+	)
+}
+
+// validateWriteIns rejects results naming a candidate the voting process did not declare.
+// Only applies when the voting process has the reject write-in policy; by default write-ins are tallied separately.
+func (v *ValidationService) validateWriteIns(process *models.VotingProcess, results map[string]int) error {
+	if process.WriteInPolicy != models.WriteInPolicyReject {
+		return nil
+	}
+
+	writeIns := undeclaredCandidates(process, results)
+	if len(writeIns) == 0 {
+		return nil
+	}
+
+	return NewAPIError(
+		ErrorTypeWriteInNotAllowed,
+		"Write-in candidates not allowed",
+		fmt.Sprintf("results keys %q are not declared candidates of voting process %s, which does not accept write-ins", writeIns, process.ID),
+		http.StatusBadRequest,
+	)
+}
+
+// validateCompleteResults rejects results that omit a declared candidate, so every station's results cover the same candidates.
+// Only applies when the voting process has RequireCompleteResults enabled; a zero count still has to be reported.
+func (v *ValidationService) validateCompleteResults(process *models.VotingProcess, results map[string]int) error {
+	if !process.RequireCompleteResults {
+		return nil
+	}
+
+	var missing []string
+	for _, candidate := range process.Candidates {
+		if _, reported := results[candidate.Name]; !reported {
+			missing = append(missing, candidate.Name)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	sort.Strings(missing)
+	return NewAPIError(
+		ErrorTypeIncompleteResults,
+		"Incomplete results",
+		fmt.Sprintf("results omit declared candidates %q of voting process %s, which requires a count for every candidate", missing, process.ID),
+		http.StatusBadRequest,
+	)
+}
+
+// totalVotes sums a submission's results, spoilt votes included
+func totalVotes(results map[string]int) int {
+	total := 0
+	for _, votes := range results {
+		total += votes
+	}
+	return total
+}
+
+// undeclaredCandidates returns the results keys, sorted, that are neither a declared candidate of the process nor spoilt
+func undeclaredCandidates(process *models.VotingProcess, results map[string]int) []string {
+	var undeclared []string
+	for key := range results {
+		if key == models.SpoiltVotesKey || isDeclaredCandidate(process.Candidates, key) {
+			continue
+		}
+		undeclared = append(undeclared, key)
+	}
+	sort.Strings(undeclared)
+	return undeclared
+}
+
+// isDeclaredCandidate reports whether a results key names one of the candidates
+func isDeclaredCandidate(candidates []models.Candidate, name string) bool {
+	for _, candidate := range candidates {
+		if candidate.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -1,6 +1,9 @@
 package services
 
 import (
+	"fmt"
+	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -324,6 +327,66 @@ func TestValidationService_ValidateConfidence(t *testing.T) {
 	}
 }
 
+func TestValidationService_ValidateTags(t *testing.T) {
+	validator := NewValidationService(nil)
+
+	tooMany := make(map[string]string, MaxSubmissionTags+1)
+	for i := 0; i <= MaxSubmissionTags; i++ {
+		tooMany[fmt.Sprintf("key-%d", i)] = "value"
+	}
+
+	tests := []struct {
+		name    string
+		tags    map[string]string
+		wantErr bool
+	}{
+		{
+			name:    "no tags",
+			tags:    nil,
+			wantErr: false,
+		},
+		{
+			name:    "valid tags",
+			tags:    map[string]string{"device": "Pixel 7", "appVersion": "1.4.2", "org": "redcross"},
+			wantErr: false,
+		},
+		{
+			name:    "too many tags",
+			tags:    tooMany,
+			wantErr: true,
+		},
+		{
+			name:    "empty key",
+			tags:    map[string]string{" ": "value"},
+			wantErr: true,
+		},
+		{
+			name:    "key containing colon",
+			tags:    map[string]string{"org:name": "redcross"},
+			wantErr: true,
+		},
+		{
+			name:    "key too long",
+			tags:    map[string]string{strings.Repeat("k", MaxSubmissionTagKeyLength+1): "value"},
+			wantErr: true,
+		},
+		{
+			name:    "value too long",
+			tags:    map[string]string{"note": strings.Repeat("v", MaxSubmissionTagValueLength+1)},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validator.validateTags(tt.tags)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateTags() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestValidationService_ValidateSubmission(t *testing.T) {
 	validator := NewValidationService(nil)
 
@@ -538,4 +601,630 @@ func TestValidationService_ValidateSubmissionWithVotingProcess(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestValidationService_ValidateSubmissionEmptyTally(t *testing.T) {
+	storage := NewStorageService()
+
+	processes := []models.VotingProcess{
+		{ID: "vp-strict", PollingStations: []string{"STATION_STRICT"}, Status: "Setup", ValidationRules: models.ValidationRules{RejectEmptyTally: true}},
+		{ID: "vp-lenient", PollingStations: []string{"STATION_LENIENT"}, Status: "Setup"},
+	}
+	for _, process := range processes {
+		if err := storage.StoreVotingProcess(process); err != nil {
+			t.Fatalf("Failed to store voting process: %v", err)
+		}
+		if err := storage.UpdateVotingProcessStatus(process.ID, "Active"); err != nil {
+			t.Fatalf("Failed to activate voting process: %v", err)
+		}
+	}
+
+	validator := NewValidationService(storage)
+
+	submission := func(stationID string, results map[string]int) models.SubmissionRequest {
+		return models.SubmissionRequest{
+			WalletAddress:    "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY",
+			PollingStationID: stationID,
+			GPSCoordinates:   models.GPSCoordinates{Latitude: 40.7128, Longitude: -74.0060},
+			Timestamp:        time.Now().Add(-1 * time.Hour),
+			Results:          results,
+			SubmissionType:   "image_ocr",
+			Confidence:       0.85,
+		}
+	}
+
+	zeroResults := map[string]int{"Alice": 0, "Bob": 0, "spoilt": 0}
+
+	tests := []struct {
+		name       string
+		submission models.SubmissionRequest
+		wantErr    bool
+	}{
+		{
+			name:       "all-zero submission rejected when enabled",
+			submission: submission("STATION_STRICT", zeroResults),
+			wantErr:    true,
+		},
+		{
+			name:       "non-zero submission accepted when enabled",
+			submission: submission("STATION_STRICT", map[string]int{"Alice": 0, "Bob": 0, "spoilt": 1}),
+			wantErr:    false,
+		},
+		{
+			name:       "all-zero submission accepted when disabled",
+			submission: submission("STATION_LENIENT", zeroResults),
+			wantErr:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validator.ValidateSubmission(tt.submission)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateSubmission() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				return
+			}
+
+			apiError, ok := err.(*APIError)
+			if !ok {
+				t.Fatalf("Expected *APIError, got %T", err)
+			}
+			if apiError.Type != ErrorTypeEmptyTally {
+				t.Errorf("Expected error type %s, got %s", ErrorTypeEmptyTally, apiError.Type)
+			}
+		})
+	}
+}
+
+func TestValidationService_ValidateSubmissionWalletAllowlist(t *testing.T) {
+	storage := NewStorageService()
+
+	allowedWallet := "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY"
+	otherWallet := "5FHneW46xGXgs5mUiveU4sbTyGBzmstUspZC92UhjJM694ty"
+
+	processes := []models.VotingProcess{
+		{ID: "vp-closed", PollingStations: []string{"STATION_CLOSED"}, Status: "Setup", ValidationRules: models.ValidationRules{AllowedWallets: []string{allowedWallet}}},
+		{ID: "vp-open", PollingStations: []string{"STATION_OPEN"}, Status: "Setup"},
+	}
+	for _, process := range processes {
+		if err := storage.StoreVotingProcess(process); err != nil {
+			t.Fatalf("Failed to store voting process: %v", err)
+		}
+		if err := storage.UpdateVotingProcessStatus(process.ID, "Active"); err != nil {
+			t.Fatalf("Failed to activate voting process: %v", err)
+		}
+	}
+
+	validator := NewValidationService(storage)
+
+	submission := func(stationID, wallet string) models.SubmissionRequest {
+		return models.SubmissionRequest{
+			WalletAddress:    wallet,
+			PollingStationID: stationID,
+			GPSCoordinates:   models.GPSCoordinates{Latitude: 40.7128, Longitude: -74.0060},
+			Timestamp:        time.Now().Add(-1 * time.Hour),
+			Results:          map[string]int{"Alice": 10, "Bob": 5},
+			SubmissionType:   "image_ocr",
+			Confidence:       0.85,
+		}
+	}
+
+	tests := []struct {
+		name       string
+		submission models.SubmissionRequest
+		wantErr    bool
+	}{
+		{
+			name:       "allowlisted wallet accepted in closed process",
+			submission: submission("STATION_CLOSED", allowedWallet),
+			wantErr:    false,
+		},
+		{
+			name:       "unlisted wallet rejected in closed process",
+			submission: submission("STATION_CLOSED", otherWallet),
+			wantErr:    true,
+		},
+		{
+			name:       "any wallet accepted in open process",
+			submission: submission("STATION_OPEN", otherWallet),
+			wantErr:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validator.ValidateSubmission(tt.submission)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateSubmission() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				return
+			}
+
+			apiError, ok := err.(*APIError)
+			if !ok {
+				t.Fatalf("Expected *APIError, got %T", err)
+			}
+			if apiError.Type != ErrorTypeWalletNotAuthorized {
+				t.Errorf("Expected error type %s, got %s", ErrorTypeWalletNotAuthorized, apiError.Type)
+			}
+			if apiError.StatusCode != http.StatusForbidden {
+				t.Errorf("Expected status %d, got %d", http.StatusForbidden, apiError.StatusCode)
+			}
+		})
+	}
+}
+
+func TestValidationService_ValidateSubmissionReferendumOptions(t *testing.T) {
+	storage := NewStorageService()
+
+	options := []models.Candidate{{ID: "yes", Name: "Yes"}, {ID: "no", Name: "No"}}
+	processes := []models.VotingProcess{
+		{ID: "vp-referendum", Candidates: options, PollingStations: []string{"STATION_REFERENDUM"}, Status: "Setup", Referendum: true},
+		{ID: "vp-election", Candidates: options, PollingStations: []string{"STATION_ELECTION"}, Status: "Setup"},
+	}
+	for _, process := range processes {
+		if err := storage.StoreVotingProcess(process); err != nil {
+			t.Fatalf("Failed to store voting process: %v", err)
+		}
+		if err := storage.UpdateVotingProcessStatus(process.ID, "Active"); err != nil {
+			t.Fatalf("Failed to activate voting process: %v", err)
+		}
+	}
+
+	validator := NewValidationService(storage)
+
+	submission := func(stationID string, results map[string]int) models.SubmissionRequest {
+		return models.SubmissionRequest{
+			WalletAddress:    "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY",
+			PollingStationID: stationID,
+			GPSCoordinates:   models.GPSCoordinates{Latitude: 40.7128, Longitude: -74.0060},
+			Timestamp:        time.Now().Add(-1 * time.Hour),
+			Results:          results,
+			SubmissionType:   "image_ocr",
+			Confidence:       0.85,
+		}
+	}
+
+	tests := []struct {
+		name       string
+		submission models.SubmissionRequest
+		wantErr    bool
+	}{
+		{
+			name:       "yes, no and spoilt accepted in referendum",
+			submission: submission("STATION_REFERENDUM", map[string]int{"Yes": 320, "No": 180, "spoilt": 4}),
+			wantErr:    false,
+		},
+		{
+			name:       "extra key rejected in referendum",
+			submission: submission("STATION_REFERENDUM", map[string]int{"Yes": 320, "No": 180, "Abstain": 12}),
+			wantErr:    true,
+		},
+		{
+			name:       "extra key accepted outside referendum mode",
+			submission: submission("STATION_ELECTION", map[string]int{"Yes": 320, "No": 180, "Abstain": 12}),
+			wantErr:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validator.ValidateSubmission(tt.submission)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateSubmission() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				return
+			}
+
+			apiError, ok := err.(*APIError)
+			if !ok {
+				t.Fatalf("Expected *APIError, got %T", err)
+			}
+			if apiError.Type != ErrorTypeUnknownOption {
+				t.Errorf("Expected error type %s, got %s", ErrorTypeUnknownOption, apiError.Type)
+			}
+			if apiError.StatusCode != http.StatusBadRequest {
+				t.Errorf("Expected status %d, got %d", http.StatusBadRequest, apiError.StatusCode)
+			}
+			if !strings.Contains(apiError.Details, "Abstain") {
+				t.Errorf("Expected details to name the unknown key, got %q", apiError.Details)
+			}
+		})
+	}
+}
+
+func TestValidationService_ValidateSubmissionWriteInPolicy(t *testing.T) {
+	storage := NewStorageService()
+
+	candidates := []models.Candidate{{ID: "c1", Name: "Alice"}, {ID: "c2", Name: "Bob"}}
+	processes := []models.VotingProcess{
+		{ID: "vp-no-write-ins", Candidates: candidates, PollingStations: []string{"STATION_NO_WRITE_INS"}, Status: "Setup", ValidationRules: models.ValidationRules{WriteInPolicy: models.WriteInPolicyReject}},
+		{ID: "vp-write-ins", Candidates: candidates, PollingStations: []string{"STATION_WRITE_INS"}, Status: "Setup", ValidationRules: models.ValidationRules{WriteInPolicy: models.WriteInPolicyAllow}},
+		{ID: "vp-default", Candidates: candidates, PollingStations: []string{"STATION_DEFAULT"}, Status: "Setup"},
+	}
+	for _, process := range processes {
+		if err := storage.StoreVotingProcess(process); err != nil {
+			t.Fatalf("Failed to store voting process: %v", err)
+		}
+		if err := storage.UpdateVotingProcessStatus(process.ID, "Active"); err != nil {
+			t.Fatalf("Failed to activate voting process: %v", err)
+		}
+	}
+
+	validator := NewValidationService(storage)
+
+	submission := func(stationID string, results map[string]int) models.SubmissionRequest {
+		return models.SubmissionRequest{
+			WalletAddress:    "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY",
+			PollingStationID: stationID,
+			GPSCoordinates:   models.GPSCoordinates{Latitude: 40.7128, Longitude: -74.0060},
+			Timestamp:        time.Now().Add(-1 * time.Hour),
+			Results:          results,
+			SubmissionType:   "image_ocr",
+			Confidence:       0.85,
+		}
+	}
+
+	declared := map[string]int{"Alice": 120, "Bob": 80, "spoilt": 3}
+	withWriteIn := map[string]int{"Alice": 120, "Bob": 80, "Carol": 7, "spoilt": 3}
+
+	tests := []struct {
+		name       string
+		submission models.SubmissionRequest
+		wantErr    bool
+	}{
+		{
+			name:       "declared candidates accepted when write-ins are rejected",
+			submission: submission("STATION_NO_WRITE_INS", declared),
+			wantErr:    false,
+		},
+		{
+			name:       "write-in rejected when write-ins are rejected",
+			submission: submission("STATION_NO_WRITE_INS", withWriteIn),
+			wantErr:    true,
+		},
+		{
+			name:       "write-in accepted when write-ins are allowed",
+			submission: submission("STATION_WRITE_INS", withWriteIn),
+			wantErr:    false,
+		},
+		{
+			name:       "write-in accepted by default",
+			submission: submission("STATION_DEFAULT", withWriteIn),
+			wantErr:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validator.ValidateSubmission(tt.submission)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateSubmission() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				return
+			}
+
+			apiError, ok := err.(*APIError)
+			if !ok {
+				t.Fatalf("Expected *APIError, got %T", err)
+			}
+			if apiError.Type != ErrorTypeWriteInNotAllowed {
+				t.Errorf("Expected error type %s, got %s", ErrorTypeWriteInNotAllowed, apiError.Type)
+			}
+			if !strings.Contains(apiError.Details, "Carol") {
+				t.Errorf("Expected details to name the write-in, got %q", apiError.Details)
+			}
+		})
+	}
+}
+
+func TestValidationService_ValidateSubmissionCompleteResults(t *testing.T) {
+	storage := NewStorageService()
+
+	candidates := []models.Candidate{{ID: "c1", Name: "Alice"}, {ID: "c2", Name: "Bob"}, {ID: "c3", Name: "Carol"}}
+	processes := []models.VotingProcess{
+		{ID: "vp-complete", Candidates: candidates, PollingStations: []string{"STATION_COMPLETE"}, Status: "Setup", ValidationRules: models.ValidationRules{RequireCompleteResults: true}},
+		{ID: "vp-partial", Candidates: candidates, PollingStations: []string{"STATION_PARTIAL"}, Status: "Setup"},
+	}
+	for _, process := range processes {
+		if err := storage.StoreVotingProcess(process); err != nil {
+			t.Fatalf("Failed to store voting process: %v", err)
+		}
+		if err := storage.UpdateVotingProcessStatus(process.ID, "Active"); err != nil {
+			t.Fatalf("Failed to activate voting process: %v", err)
+		}
+	}
+
+	validator := NewValidationService(storage)
+
+	submission := func(stationID string, results map[string]int) models.SubmissionRequest {
+		return models.SubmissionRequest{
+			WalletAddress:    "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY",
+			PollingStationID: stationID,
+			GPSCoordinates:   models.GPSCoordinates{Latitude: 40.7128, Longitude: -74.0060},
+			Timestamp:        time.Now().Add(-1 * time.Hour),
+			Results:          results,
+			SubmissionType:   "image_ocr",
+			Confidence:       0.85,
+		}
+	}
+
+	complete := map[string]int{"Alice": 120, "Bob": 80, "Carol": 0, "spoilt": 3}
+	partial := map[string]int{"Alice": 120, "Bob": 80, "spoilt": 3}
+
+	tests := []struct {
+		name       string
+		submission models.SubmissionRequest
+		wantErr    bool
+	}{
+		{
+			name:       "complete results accepted when required",
+			submission: submission("STATION_COMPLETE", complete),
+			wantErr:    false,
+		},
+		{
+			name:       "omitted candidate rejected when complete results are required",
+			submission: submission("STATION_COMPLETE", partial),
+			wantErr:    true,
+		},
+		{
+			name:       "omitted candidate accepted by default",
+			submission: submission("STATION_PARTIAL", partial),
+			wantErr:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validator.ValidateSubmission(tt.submission)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateSubmission() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				return
+			}
+
+			apiError, ok := err.(*APIError)
+			if !ok {
+				t.Fatalf("Expected *APIError, got %T", err)
+			}
+			if apiError.Type != ErrorTypeIncompleteResults {
+				t.Errorf("Expected error type %s, got %s", ErrorTypeIncompleteResults, apiError.Type)
+			}
+			if !strings.Contains(apiError.Details, "Carol") {
+				t.Errorf("Expected details to name the missing candidate, got %q", apiError.Details)
+			}
+		})
+	}
+}
+
+func TestValidationService_ValidateSubmissionValidationRules(t *testing.T) {
+	storage := NewStorageService()
+
+	// One rules config caps the votes per submission and only accepts audio transcriptions
+	rules := models.ValidationRules{MaxVotes: 300, SubmissionTypes: []string{"audio_stt"}}
+	processes := []models.VotingProcess{
+		{ID: "vp-rules", PollingStations: []string{"STATION_RULES"}, Status: "Setup", ValidationRules: rules},
+		{ID: "vp-no-rules", PollingStations: []string{"STATION_NO_RULES"}, Status: "Setup"},
+	}
+	for _, process := range processes {
+		if err := storage.StoreVotingProcess(process); err != nil {
+			t.Fatalf("Failed to store voting process: %v", err)
+		}
+		if err := storage.UpdateVotingProcessStatus(process.ID, "Active"); err != nil {
+			t.Fatalf("Failed to activate voting process: %v", err)
+		}
+	}
+
+	validator := NewValidationService(storage)
+
+	submission := func(stationID, submissionType string, results map[string]int) models.SubmissionRequest {
+		return models.SubmissionRequest{
+			WalletAddress:    "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY",
+			PollingStationID: stationID,
+			GPSCoordinates:   models.GPSCoordinates{Latitude: 40.7128, Longitude: -74.0060},
+			Timestamp:        time.Now().Add(-1 * time.Hour),
+			Results:          results,
+			SubmissionType:   submissionType,
+			Confidence:       0.85,
+		}
+	}
+
+	withinLimit := map[string]int{"Alice": 150, "Bob": 147, "spoilt": 3}
+	overLimit := map[string]int{"Alice": 150, "Bob": 148, "spoilt": 3}
+
+	tests := []struct {
+		name       string
+		submission models.SubmissionRequest
+		wantType   ErrorType // Empty when the submission is accepted
+	}{
+		{
+			name:       "allowed channel at the vote limit accepted",
+			submission: submission("STATION_RULES", "audio_stt", withinLimit),
+		},
+		{
+			name:       "votes above the limit rejected",
+			submission: submission("STATION_RULES", "audio_stt", overLimit),
+			wantType:   ErrorTypeTooManyVotes,
+		},
+		{
+			name:       "channel outside the allowed types rejected",
+			submission: submission("STATION_RULES", "image_ocr", withinLimit),
+			wantType:   ErrorTypeSubmissionTypeNotAllowed,
+		},
+		{
+			name:       "process without rules accepts any channel and total",
+			submission: submission("STATION_NO_RULES", "image_ocr", overLimit),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validator.ValidateSubmission(tt.submission)
+			if tt.wantType == "" {
+				if err != nil {
+					t.Fatalf("ValidateSubmission() unexpected error = %v", err)
+				}
+				return
+			}
+
+			apiError, ok := err.(*APIError)
+			if !ok {
+				t.Fatalf("Expected *APIError, got %T (%v)", err, err)
+			}
+			if apiError.Type != tt.wantType {
+				t.Errorf("Expected error type %s, got %s", tt.wantType, apiError.Type)
+			}
+		})
+	}
+
+	if got := validator.Metrics().Value("too_many_votes"); got != 1 {
+		t.Errorf("Expected 1 too_many_votes failure, got %d", got)
+	}
+	if got := validator.Metrics().Value("submission_type_not_allowed"); got != 1 {
+		t.Errorf("Expected 1 submission_type_not_allowed failure, got %d", got)
+	}
+}
+
+func TestValidationService_ValidateSubmissionAmbiguousCandidates(t *testing.T) {
+	validator := NewValidationService(nil)
+
+	tests := []struct {
+		name    string
+		results map[string]int
+		wantErr bool
+	}{
+		{
+			name:    "distinct candidates accepted",
+			results: map[string]int{"Alice": 120, "Bob": 80, "spoilt": 3},
+			wantErr: false,
+		},
+		{
+			name:    "case and trailing space normalize identically",
+			results: map[string]int{"Alice": 120, "alice ": 5, "Bob": 80},
+			wantErr: true,
+		},
+		{
+			name:    "inner whitespace normalizes identically",
+			results: map[string]int{"Mary  Wanjiru": 40, "mary wanjiru": 40},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validator.ValidateSubmission(models.SubmissionRequest{
+				WalletAddress:    "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY",
+				PollingStationID: "STATION_001",
+				GPSCoordinates:   models.GPSCoordinates{Latitude: 40.7128, Longitude: -74.0060},
+				Timestamp:        time.Now().Add(-1 * time.Hour),
+				Results:          tt.results,
+				SubmissionType:   "image_ocr",
+				Confidence:       0.85,
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateSubmission() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				return
+			}
+
+			apiError, ok := err.(*APIError)
+			if !ok {
+				t.Fatalf("Expected *APIError, got %T", err)
+			}
+			if apiError.Type != ErrorTypeAmbiguousCandidate {
+				t.Errorf("Expected error type %s, got %s", ErrorTypeAmbiguousCandidate, apiError.Type)
+			}
+			if apiError.StatusCode != http.StatusBadRequest {
+				t.Errorf("Expected status %d, got %d", http.StatusBadRequest, apiError.StatusCode)
+			}
+		})
+	}
+}
+
+func TestValidationService_ValidateSubmissionFailureMetrics(t *testing.T) {
+	storage := NewStorageService()
+
+	allowedWallet := "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY"
+	otherWallet := "5FHneW46xGXgs5mUiveU4sbTyGBzmstUspZC92UhjJM694ty"
+
+	process := models.VotingProcess{ID: "vp-metrics", PollingStations: []string{"STATION_METRICS"}, Status: "Setup", ValidationRules: models.ValidationRules{AllowedWallets: []string{allowedWallet}}}
+	if err := storage.StoreVotingProcess(process); err != nil {
+		t.Fatalf("Failed to store voting process: %v", err)
+	}
+	if err := storage.UpdateVotingProcessStatus(process.ID, "Active"); err != nil {
+		t.Fatalf("Failed to activate voting process: %v", err)
+	}
+
+	validator := NewValidationService(storage)
+
+	submission := func(modify func(*models.SubmissionRequest)) models.SubmissionRequest {
+		req := models.SubmissionRequest{
+			WalletAddress:    allowedWallet,
+			PollingStationID: "STATION_METRICS",
+			GPSCoordinates:   models.GPSCoordinates{Latitude: 40.7128, Longitude: -74.0060},
+			Timestamp:        time.Now().Add(-1 * time.Hour),
+			Results:          map[string]int{"Alice": 10, "Bob": 5},
+			SubmissionType:   "image_ocr",
+			Confidence:       0.85,
+		}
+		modify(&req)
+		return req
+	}
+
+	rejected := []models.SubmissionRequest{
+		submission(func(req *models.SubmissionRequest) { req.WalletAddress = "not-a-wallet" }),
+		submission(func(req *models.SubmissionRequest) { req.WalletAddress = "" }),
+		submission(func(req *models.SubmissionRequest) { req.GPSCoordinates.Latitude = 91 }),
+		submission(func(req *models.SubmissionRequest) { req.Timestamp = time.Now().Add(-9 * time.Hour) }),
+		submission(func(req *models.SubmissionRequest) { req.WalletAddress = otherWallet }),
+	}
+	for _, req := range rejected {
+		if err := validator.ValidateSubmission(req); err == nil {
+			t.Fatalf("ValidateSubmission() accepted %+v, want an error", req)
+		}
+	}
+	if err := validator.ValidateSubmission(submission(func(*models.SubmissionRequest) {})); err != nil {
+		t.Fatalf("ValidateSubmission() error = %v, want nil", err)
+	}
+
+	metrics := validator.Metrics()
+	if metrics.Name() != "oyah_validation_failures_total" {
+		t.Errorf("Metrics().Name() = %q, want oyah_validation_failures_total", metrics.Name())
+	}
+
+	want := map[string]int64{
+		"invalid_wallet":        2,
+		"bad_gps":               1,
+		"stale_timestamp":       1,
+		"wallet_not_authorized": 1,
+		"invalid_results":       0,
+	}
+	for reason, count := range want {
+		if got := metrics.Value(reason); got != count {
+			t.Errorf("failures with reason %q = %d, want %d", reason, got, count)
+		}
+	}
+
+	registry := NewMetricsRegistry()
+	registry.RegisterVec(metrics)
+	var body strings.Builder
+	if err := registry.WritePrometheus(&body); err != nil {
+		t.Fatalf("WritePrometheus() error = %v", err)
+	}
+	for _, line := range []string{
+		"# TYPE oyah_validation_failures_total counter",
+		`oyah_validation_failures_total{reason="bad_gps"} 1`,
+		`oyah_validation_failures_total{reason="invalid_wallet"} 2`,
+	} {
+		if !strings.Contains(body.String(), line) {
+			t.Errorf("WritePrometheus() output missing %q:\n%s", line, body.String())
+		}
+	}
+}
@@ -342,4 +342,90 @@ func TestStorageService_ThreadSafety(t *testing.T) {
 		allProcesses := storage.GetAllVotingProcesses()
 		assert.GreaterOrEqual(t, len(allProcesses), 5)
 	})
-}
\ No newline at end of file
+}
+
+func TestStorageService_VotingProcessStatusTransitions(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    []string // Statuses applied in order after Setup; only the last may fail
+		wantErr bool
+	}{
+		{"setup to active", []string{models.ProcessStatusActive}, false},
+		{"active to complete", []string{models.ProcessStatusActive, models.ProcessStatusComplete}, false},
+		{"same status is a no-op", []string{models.ProcessStatusActive, models.ProcessStatusActive}, false},
+		{"setup directly to complete", []string{models.ProcessStatusComplete}, true},
+		{"complete back to active", []string{models.ProcessStatusActive, models.ProcessStatusComplete, models.ProcessStatusActive}, true},
+		{"active back to setup", []string{models.ProcessStatusActive, models.ProcessStatusSetup}, true},
+		{"unknown status", []string{"Archived"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := NewStorageService()
+			process := models.VotingProcess{ID: "vp-transitions", PollingStations: []string{"STATION_T1"}, Status: models.ProcessStatusSetup}
+			require.NoError(t, storage.StoreVotingProcess(process))
+
+			var err error
+			for i, status := range tt.path {
+				err = storage.UpdateVotingProcessStatus(process.ID, status)
+				if i < len(tt.path)-1 {
+					require.NoError(t, err, "legal step to %s", status)
+				}
+			}
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, models.ErrInvalidStatusTransition)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			// A rejected transition leaves the process where it was
+			stored, _ := storage.GetVotingProcess(process.ID)
+			expected := tt.path[len(tt.path)-1]
+			if tt.wantErr {
+				expected = models.ProcessStatusSetup
+				if len(tt.path) > 1 {
+					expected = tt.path[len(tt.path)-2]
+				}
+			}
+			assert.Equal(t, expected, stored.Status)
+		})
+	}
+}
+
+func TestStorageService_PollingStationStatusTransitions(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    string
+		to      string
+		wantErr bool
+	}{
+		{"pending to verified", models.StationStatusPending, models.StationStatusVerified, false},
+		{"pending to provisional", models.StationStatusPending, models.StationStatusVerifiedProvisional, false},
+		{"provisional to verified", models.StationStatusVerifiedProvisional, models.StationStatusVerified, false},
+		{"provisional back to pending", models.StationStatusVerifiedProvisional, models.StationStatusPending, false},
+		{"verified back to pending", models.StationStatusVerified, models.StationStatusPending, false},
+		{"verified to provisional", models.StationStatusVerified, models.StationStatusVerifiedProvisional, true},
+		{"unknown status", models.StationStatusPending, "Disputed", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := NewStorageService()
+			process := models.VotingProcess{ID: "vp-station-transitions", PollingStations: []string{"STATION_T2"}, Status: models.ProcessStatusSetup}
+			require.NoError(t, storage.StoreVotingProcess(process))
+
+			// Reach the starting status through legal transitions
+			if tt.from != models.StationStatusPending {
+				require.NoError(t, storage.UpdatePollingStationStatus("STATION_T2", tt.from, nil, 0))
+			}
+
+			err := storage.UpdatePollingStationStatus("STATION_T2", tt.to, nil, 0)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, models.ErrInvalidStatusTransition)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"oyah-backend/internal/models"
+)
+
+// WalletActivityService analyzes wallet submission activity across polling stations.
+// It is advisory only: flagged wallets are reported, not blocked.
+type WalletActivityService struct {
+	storageService *StorageService
+	logger         *logrus.Logger
+	maxStations    int // Wallets submitting to more stations than this are flagged
+
+	minStationInterval time.Duration // Wallets submitting to two stations closer together than this are flagged; 0 disables
+}
+
+// DefaultMinStationInterval is the shortest plausible time for a witness to move between two polling stations
+const DefaultMinStationInterval = 5 * time.Minute
+
+// WalletActivity represents the stations a wallet has submitted results to
+type WalletActivity struct {
+	WalletAddress string                  `json:"walletAddress"`
+	StationCount  int                     `json:"stationCount"`
+	Stations      []WalletStationActivity `json:"stations"`
+	Flagged       bool                    `json:"flagged"`
+	MaxStations   int                     `json:"maxStations"`
+	Reason        string                  `json:"reason,omitempty"`
+
+	MinStationInterval string              `json:"minStationInterval,omitempty"`
+	RapidSwitches      []WalletRapidSwitch `json:"rapidSwitches,omitempty"` // Consecutive submissions to different stations within the minimum interval
+}
+
+// WalletRapidSwitch represents two consecutive submissions by one wallet to different stations, too close together to be plausible
+type WalletRapidSwitch struct {
+	FromPollingStationID string    `json:"fromPollingStationId"`
+	ToPollingStationID   string    `json:"toPollingStationId"`
+	FromTimestamp        time.Time `json:"fromTimestamp"`
+	ToTimestamp          time.Time `json:"toTimestamp"`
+	IntervalSeconds      float64   `json:"intervalSeconds"`
+}
+
+// WalletStationActivity represents a wallet's latest submission to a single polling station
+type WalletStationActivity struct {
+	PollingStationID string    `json:"pollingStationId"`
+	VotingProcessID  string    `json:"votingProcessId,omitempty"`
+	SubmissionID     string    `json:"submissionId"`
+	SubmittedAt      time.Time `json:"submittedAt"`
+
+	ReplacedSubmissions []models.Submission `json:"replacedSubmissions,omitempty"` // Earlier submissions this one replaced, oldest first, up to the history limit
+}
+
+// NewWalletActivityService creates a new wallet activity service instance
+func NewWalletActivityService(storage *StorageService, logger *logrus.Logger) *WalletActivityService {
+	return &WalletActivityService{
+		storageService: storage,
+		logger:         logger,
+		maxStations:    3, // A witness is expected to observe a single station
+
+		minStationInterval: DefaultMinStationInterval,
+	}
+}
+
+// SetMaxStationsPerWallet sets the station count above which a wallet is flagged
+func (w *WalletActivityService) SetMaxStationsPerWallet(maxStations int) {
+	if maxStations > 0 {
+		w.maxStations = maxStations
+		w.logger.WithField("max_stations", maxStations).Info("Wallet station limit updated")
+	}
+}
+
+// SetMinStationInterval sets the shortest interval between a wallet's submissions to different stations; 0 disables the check
+func (w *WalletActivityService) SetMinStationInterval(interval time.Duration) {
+	if interval < 0 {
+		return
+	}
+	w.minStationInterval = interval
+	w.logger.WithField("min_station_interval", interval.String()).Info("Wallet station interval updated")
+}
+
+// GetWalletActivity returns the stations a wallet submitted to and whether the wallet is flagged
+func (w *WalletActivityService) GetWalletActivity(walletAddress string) *WalletActivity {
+	submissions := w.storageService.GetSubmissionsByWallet(walletAddress)
+
+	activity := &WalletActivity{
+		WalletAddress: walletAddress,
+		StationCount:  len(submissions),
+		Stations:      make([]WalletStationActivity, 0, len(submissions)),
+		MaxStations:   w.maxStations,
+	}
+
+	for _, submission := range submissions {
+		stationActivity := WalletStationActivity{
+			PollingStationID: submission.PollingStationID,
+			SubmissionID:     submission.ID,
+			SubmittedAt:      submission.ProcessedAt,
+		}
+		if station, err := w.storageService.GetPollingStation(submission.PollingStationID); err == nil {
+			stationActivity.VotingProcessID = station.VotingProcessID
+		}
+		if history := w.storageService.GetSubmissionHistory(walletAddress, submission.PollingStationID); len(history) > 0 {
+			stationActivity.ReplacedSubmissions = history
+		}
+		activity.Stations = append(activity.Stations, stationActivity)
+	}
+
+	var reasons []string
+	if activity.StationCount > w.maxStations {
+		reasons = append(reasons, fmt.Sprintf("wallet submitted to %d stations (limit: %d)", activity.StationCount, w.maxStations))
+
+		w.logger.WithFields(logrus.Fields{
+			"wallet_address": walletAddress,
+			"station_count":  activity.StationCount,
+			"max_stations":   w.maxStations,
+			"service":        "wallet_activity",
+		}).Warn("Wallet flagged for submitting to too many polling stations")
+	}
+
+	if w.minStationInterval > 0 {
+		activity.MinStationInterval = w.minStationInterval.String()
+		activity.RapidSwitches = rapidStationSwitches(submissions, w.minStationInterval)
+		if len(activity.RapidSwitches) > 0 {
+			reasons = append(reasons, fmt.Sprintf("wallet submitted to different stations %d times within %s", len(activity.RapidSwitches), w.minStationInterval))
+
+			w.logger.WithFields(logrus.Fields{
+				"wallet_address":       walletAddress,
+				"rapid_switches":       len(activity.RapidSwitches),
+				"min_station_interval": w.minStationInterval.String(),
+				"service":              "wallet_activity",
+			}).Warn("Wallet flagged for submitting to polling stations too close together")
+		}
+	}
+
+	if len(reasons) > 0 {
+		activity.Flagged = true
+		activity.Reason = strings.Join(reasons, "; ")
+	}
+
+	return activity
+}
+
+// rapidStationSwitches orders a wallet's submissions by the witness's timestamp and returns each consecutive pair
+// at different stations that are closer together than the minimum interval
+func rapidStationSwitches(submissions []models.Submission, minInterval time.Duration) []WalletRapidSwitch {
+	ordered := make([]models.Submission, len(submissions))
+	copy(ordered, submissions)
+	sort.SliceStable(ordered, func(i, j int) bool { return ordered[i].Timestamp.Before(ordered[j].Timestamp) })
+
+	var switches []WalletRapidSwitch
+	for i := 1; i < len(ordered); i++ {
+		from, to := ordered[i-1], ordered[i]
+		if from.PollingStationID == to.PollingStationID {
+			continue
+		}
+		if interval := to.Timestamp.Sub(from.Timestamp); interval < minInterval {
+			switches = append(switches, WalletRapidSwitch{
+				FromPollingStationID: from.PollingStationID,
+				ToPollingStationID:   to.PollingStationID,
+				FromTimestamp:        from.Timestamp,
+				ToTimestamp:          to.Timestamp,
+				IntervalSeconds:      interval.Seconds(),
+			})
+		}
+	}
+	return switches
+}
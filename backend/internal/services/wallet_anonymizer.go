@@ -0,0 +1,45 @@
+package services
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// anonymizedWalletLength is the number of hex characters kept from the salted wallet hash
+const anonymizedWalletLength = 16
+
+// WalletAnonymizer replaces wallet addresses with stable salted hash prefixes in public responses
+type WalletAnonymizer struct {
+	enabled bool
+	salt    []byte
+}
+
+// NewWalletAnonymizer creates a wallet anonymizer; it is disabled until enabled explicitly
+func NewWalletAnonymizer(salt string) *WalletAnonymizer {
+	return &WalletAnonymizer{
+		salt: []byte(salt),
+	}
+}
+
+// SetEnabled enables or disables wallet anonymization
+func (a *WalletAnonymizer) SetEnabled(enabled bool) {
+	a.enabled = enabled
+}
+
+// IsEnabled reports whether wallet addresses are anonymized
+func (a *WalletAnonymizer) IsEnabled() bool {
+	return a.enabled
+}
+
+// Anonymize returns the public identifier for a wallet address.
+// The same address and salt always produce the same identifier.
+func (a *WalletAnonymizer) Anonymize(walletAddress string) string {
+	if !a.enabled {
+		return walletAddress
+	}
+
+	mac := hmac.New(sha256.New, a.salt)
+	mac.Write([]byte(walletAddress))
+	return "anon-" + hex.EncodeToString(mac.Sum(nil))[:anonymizedWalletLength]
+}
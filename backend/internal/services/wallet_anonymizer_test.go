@@ -0,0 +1,38 @@
+package services
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWalletAnonymizer_Anonymize(t *testing.T) {
+	wallet := "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY"
+
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		anonymizer := NewWalletAnonymizer("salt")
+		assert.False(t, anonymizer.IsEnabled())
+		assert.Equal(t, wallet, anonymizer.Anonymize(wallet))
+	})
+
+	t.Run("StableHashPrefix", func(t *testing.T) {
+		anonymizer := NewWalletAnonymizer("salt")
+		anonymizer.SetEnabled(true)
+
+		anonymized := anonymizer.Anonymize(wallet)
+		assert.True(t, strings.HasPrefix(anonymized, "anon-"))
+		assert.NotContains(t, anonymized, wallet)
+		assert.Equal(t, anonymized, anonymizer.Anonymize(wallet))
+		assert.NotEqual(t, anonymized, anonymizer.Anonymize("5FHneW46xGXgs5mUiveU4sbTyGBzmstUspZC92UhjJM694ty"))
+	})
+
+	t.Run("SaltChangesIdentifier", func(t *testing.T) {
+		first := NewWalletAnonymizer("salt-one")
+		first.SetEnabled(true)
+		second := NewWalletAnonymizer("salt-two")
+		second.SetEnabled(true)
+
+		assert.NotEqual(t, first.Anonymize(wallet), second.Anonymize(wallet))
+	})
+}
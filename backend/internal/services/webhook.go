@@ -0,0 +1,158 @@
+package services
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// WebhookEventStationVerified is sent when a polling station's results become verified
+const WebhookEventStationVerified = "station.verified"
+
+// WebhookPayload is the JSON body POSTed to a voting process's webhook URL
+type WebhookPayload struct {
+	Event            string         `json:"event"`
+	VotingProcessID  string         `json:"votingProcessId"`
+	PollingStationID string         `json:"pollingStationId"`
+	Results          map[string]int `json:"results"`
+	ConfidenceLevel  float64        `json:"confidenceLevel"`
+	Timestamp        time.Time      `json:"timestamp"`
+}
+
+// WebhookService delivers signed webhook notifications to integrators
+type WebhookService struct {
+	client   *http.Client
+	logger   *logrus.Logger
+	secret   []byte
+	notifier *RetryableNotifier // Retries failed deliveries with backoff and jitter
+	pending  sync.WaitGroup
+}
+
+// NewWebhookService creates a new webhook service instance
+func NewWebhookService(logger *logrus.Logger) *WebhookService {
+	return &WebhookService{
+		client:   &http.Client{Timeout: 5 * time.Second},
+		logger:   logger,
+		notifier: NewRetryableNotifier(DefaultRetryPolicy(), logger),
+	}
+}
+
+// SetSecret sets the shared secret used to sign webhook payloads
+func (w *WebhookService) SetSecret(secret string) {
+	w.secret = []byte(secret)
+}
+
+// SetTimeout sets the timeout of a single delivery attempt
+func (w *WebhookService) SetTimeout(timeout time.Duration) {
+	if timeout > 0 {
+		w.client.Timeout = timeout
+	}
+}
+
+// SetRetryPolicy sets how many times a failed delivery is retried and the initial delay between attempts
+func (w *WebhookService) SetRetryPolicy(maxRetries int, retryDelay time.Duration) {
+	policy := w.notifier.Policy()
+	if maxRetries >= 0 {
+		policy.MaxAttempts = maxRetries + 1
+	}
+	if retryDelay >= 0 {
+		policy.InitialBackoff = retryDelay
+	}
+	w.notifier.SetPolicy(policy)
+}
+
+// SetRetryBackoff caps the delay between retries and sets the fraction of each delay randomly added or removed
+func (w *WebhookService) SetRetryBackoff(maxDelay time.Duration, jitter float64) {
+	policy := w.notifier.Policy()
+	policy.MaxBackoff = maxDelay
+	policy.Jitter = jitter
+	w.notifier.SetPolicy(policy)
+}
+
+// SignWebhookPayload returns the hex-encoded HMAC-SHA256 signature of a webhook body
+func SignWebhookPayload(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// NotifyStationVerified delivers a station verification webhook in the background.
+// Delivery failures are logged and never block the caller.
+func (w *WebhookService) NotifyStationVerified(url string, payload WebhookPayload) {
+	payload.Event = WebhookEventStationVerified
+	if payload.Timestamp.IsZero() {
+		payload.Timestamp = time.Now()
+	}
+
+	w.pending.Add(1)
+	go func() {
+		defer w.pending.Done()
+
+		if err := w.Deliver(url, payload); err != nil {
+			w.logger.WithFields(logrus.Fields{
+				"voting_process_id":  payload.VotingProcessID,
+				"polling_station_id": payload.PollingStationID,
+				"service":            "webhook",
+			}).WithError(err).Error("Webhook delivery failed")
+		}
+	}()
+}
+
+// Deliver POSTs a webhook payload, retrying failed attempts with exponential backoff
+func (w *WebhookService) Deliver(url string, payload WebhookPayload) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode webhook payload: %w", err)
+	}
+
+	_, err = w.notifier.Notify("Webhook delivery", logrus.Fields{
+		"voting_process_id":  payload.VotingProcessID,
+		"polling_station_id": payload.PollingStationID,
+		"event":              payload.Event,
+		"service":            "webhook",
+	}, func() error {
+		return w.send(url, payload.Event, body)
+	})
+	return err
+}
+
+// Wait blocks until every background delivery has finished
+func (w *WebhookService) Wait() {
+	w.pending.Wait()
+}
+
+// send performs a single delivery attempt
+func (w *WebhookService) send(url, event string, body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Oyah-Event", event)
+	req.Header.Set("X-Oyah-Signature", "sha256="+SignWebhookPayload(w.secret, body))
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		err := fmt.Errorf("webhook endpoint responded with status %d", resp.StatusCode)
+		// Client errors will not change on a retry, except a timed out or rate limited request
+		if resp.StatusCode >= 400 && resp.StatusCode < 500 &&
+			resp.StatusCode != http.StatusRequestTimeout && resp.StatusCode != http.StatusTooManyRequests {
+			return Permanent(err)
+		}
+		return err
+	}
+	return nil
+}
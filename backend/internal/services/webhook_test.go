@@ -0,0 +1,230 @@
+package services
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"oyah-backend/internal/models"
+)
+
+func newTestWebhookService() *WebhookService {
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+
+	webhookService := NewWebhookService(logger)
+	webhookService.SetSecret("test-secret")
+	webhookService.SetRetryPolicy(2, time.Millisecond)
+	return webhookService
+}
+
+func TestWebhookService_StationVerifiedDelivery(t *testing.T) {
+	var (
+		mutex     sync.Mutex
+		received  []WebhookPayload
+		signature string
+		rawBody   []byte
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		var payload WebhookPayload
+		assert.NoError(t, json.Unmarshal(body, &payload))
+
+		mutex.Lock()
+		received = append(received, payload)
+		signature = r.Header.Get("X-Oyah-Signature")
+		rawBody = body
+		mutex.Unlock()
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	storage := NewStorageService()
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+
+	require.NoError(t, storage.StoreVotingProcess(models.VotingProcess{
+		ID:              "vp-webhook",
+		PollingStations: []string{"HOOK001"},
+		Status:          "Active",
+		WebhookURL:      server.URL,
+	}))
+
+	webhookService := newTestWebhookService()
+	consensus := NewConsensusService(storage, logger)
+	consensus.SetWebhookService(webhookService)
+
+	results := map[string]int{"Alice": 120, "Bob": 80}
+	for i := 0; i < 3; i++ {
+		require.NoError(t, storage.StoreSubmission(models.Submission{
+			ID:               "hook-sub-" + string(rune('a'+i)),
+			WalletAddress:    generateWalletAddress(i),
+			PollingStationID: "HOOK001",
+			Results:          copyResults(results),
+			SubmissionType:   "image_ocr",
+		}))
+		_, err := consensus.ProcessConsensus("HOOK001")
+		require.NoError(t, err)
+	}
+
+	// Reprocessing an already verified station does not notify again
+	_, err := consensus.ProcessConsensus("HOOK001")
+	require.NoError(t, err)
+
+	webhookService.Wait()
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	require.Len(t, received, 1)
+	payload := received[0]
+	assert.Equal(t, WebhookEventStationVerified, payload.Event)
+	assert.Equal(t, "vp-webhook", payload.VotingProcessID)
+	assert.Equal(t, "HOOK001", payload.PollingStationID)
+	assert.Equal(t, results, payload.Results)
+	assert.Greater(t, payload.ConfidenceLevel, 0.0)
+	assert.Equal(t, "sha256="+SignWebhookPayload([]byte("test-secret"), rawBody), signature)
+}
+
+func TestWebhookService_ConcurrentConsensusNotifiesOnce(t *testing.T) {
+	// Let the runs overlap even on single-CPU hosts
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(4))
+
+	var deliveries int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&deliveries, 1)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	storage := NewStorageService()
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+
+	require.NoError(t, storage.StoreVotingProcess(models.VotingProcess{
+		ID:              "vp-webhook-concurrent",
+		PollingStations: []string{"HOOK002"},
+		Status:          "Active",
+		WebhookURL:      server.URL,
+	}))
+
+	webhookService := newTestWebhookService()
+	consensus := NewConsensusService(storage, logger)
+	consensus.SetWebhookService(webhookService)
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, storage.StoreSubmission(models.Submission{
+			ID:               "hook-concurrent-" + string(rune('a'+i)),
+			WalletAddress:    generateWalletAddress(i),
+			PollingStationID: "HOOK002",
+			Results:          map[string]int{"Alice": 120, "Bob": 80},
+			SubmissionType:   "image_ocr",
+		}))
+	}
+
+	// Overlapping runs from different callers, e.g. a queued job, the synchronous fallback and a repair,
+	// are serialized, so only the first sees the station as newly verified
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			_, err := consensus.ProcessConsensus("HOOK002")
+			assert.NoError(t, err)
+		}()
+	}
+	close(start)
+	wg.Wait()
+	webhookService.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&deliveries))
+}
+
+func TestWebhookService_RetriesFailingEndpoint(t *testing.T) {
+	t.Run("RecoversAfterRetries", func(t *testing.T) {
+		var attempts atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if attempts.Add(1) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		err := newTestWebhookService().Deliver(server.URL, WebhookPayload{VotingProcessID: "vp", PollingStationID: "PS001"})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(3), attempts.Load())
+	})
+
+	t.Run("GivesUpAfterMaxRetries", func(t *testing.T) {
+		var attempts atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempts.Add(1)
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		err := newTestWebhookService().Deliver(server.URL, WebhookPayload{VotingProcessID: "vp", PollingStationID: "PS001"})
+		assert.Error(t, err)
+		assert.Equal(t, int32(3), attempts.Load())
+	})
+
+	t.Run("DoesNotRetryClientErrors", func(t *testing.T) {
+		var attempts atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempts.Add(1)
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		err := newTestWebhookService().Deliver(server.URL, WebhookPayload{VotingProcessID: "vp", PollingStationID: "PS001"})
+		assert.Error(t, err)
+		assert.Equal(t, int32(1), attempts.Load(), "a missing endpoint is attempted exactly once")
+	})
+
+	t.Run("RetriesRateLimitedAndTimedOutRequests", func(t *testing.T) {
+		for _, status := range []int{http.StatusTooManyRequests, http.StatusRequestTimeout} {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if attempts.Add(1) < 2 {
+					w.WriteHeader(status)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			err := newTestWebhookService().Deliver(server.URL, WebhookPayload{VotingProcessID: "vp", PollingStationID: "PS001"})
+			server.Close()
+			assert.NoError(t, err, "status %d", status)
+			assert.Equal(t, int32(2), attempts.Load(), "status %d", status)
+		}
+	})
+
+	t.Run("TimesOutSlowEndpoint", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(200 * time.Millisecond)
+		}))
+		defer server.Close()
+
+		webhookService := newTestWebhookService()
+		webhookService.SetTimeout(20 * time.Millisecond)
+		webhookService.SetRetryPolicy(0, 0)
+
+		assert.Error(t, webhookService.Deliver(server.URL, WebhookPayload{VotingProcessID: "vp", PollingStationID: "PS001"}))
+	})
+}
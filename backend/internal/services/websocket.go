@@ -25,6 +25,9 @@ type WebSocketHub struct {
 	// Registered clients
 	clients map[*WebSocketClient]bool
 
+	// Server-sent event streams, which receive the tally updates of a single voting process
+	streams map[*TallyStream]bool
+
 	// Inbound messages from clients
 	broadcast chan []byte
 
@@ -37,16 +40,29 @@ type WebSocketHub struct {
 	// Mutex for thread-safe operations
 	mutex sync.RWMutex
 
+	// Provides the current tally for client resync requests
+	tallyProvider TallyProvider
+
 	// Logger
 	logger *logrus.Logger
 }
 
+// TallyProvider returns the current tally data for a voting process
+type TallyProvider func(votingProcessID string) (interface{}, error)
+
 // TallyUpdate represents a WebSocket message for tally updates
 type TallyUpdate struct {
 	Type            string      `json:"type"` // "tally_update"
 	VotingProcessID string      `json:"votingProcessId"`
 	Data            interface{} `json:"data"`
 	Timestamp       time.Time   `json:"timestamp"`
+	Resync          bool        `json:"resync,omitempty"`
+}
+
+// ClientMessage represents a message sent from a client to the server
+type ClientMessage struct {
+	Action          string `json:"action"` // "resync"
+	VotingProcessID string `json:"votingProcessId"`
 }
 
 // WebSocketMessage represents a generic WebSocket message
@@ -71,6 +87,7 @@ var upgrader = websocket.Upgrader{
 func NewWebSocketHub(logger *logrus.Logger) *WebSocketHub {
 	return &WebSocketHub{
 		clients:    make(map[*WebSocketClient]bool),
+		streams:    make(map[*TallyStream]bool),
 		broadcast:  make(chan []byte, 256),
 		register:   make(chan *WebSocketClient),
 		unregister: make(chan *WebSocketClient),
@@ -163,6 +180,8 @@ func (h *WebSocketHub) BroadcastTallyUpdate(votingProcessID string, tallyData in
 		return err
 	}
 
+	h.publishToStreams(votingProcessID, message)
+
 	select {
 	case h.broadcast <- message:
 		h.logger.WithFields(logrus.Fields{
@@ -176,6 +195,13 @@ func (h *WebSocketHub) BroadcastTallyUpdate(votingProcessID string, tallyData in
 	}
 }
 
+// SetTallyProvider sets the function used to answer client resync requests
+func (h *WebSocketHub) SetTallyProvider(provider TallyProvider) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.tallyProvider = provider
+}
+
 // GetClientCount returns the current number of connected clients
 func (h *WebSocketHub) GetClientCount() int {
 	h.mutex.RLock()
@@ -276,8 +302,72 @@ func (c *WebSocketClient) readPump() {
 		// Log received message (for debugging)
 		c.Logger.WithField("message", string(message)).Debug("Received message from client")
 
-		// For now, we don't process client messages, but this is where
-		// we would handle client-to-server communication if needed
+		c.handleMessage(message)
+	}
+}
+
+// handleMessage dispatches a message received from the client
+func (c *WebSocketClient) handleMessage(message []byte) {
+	var clientMessage ClientMessage
+	if err := json.Unmarshal(message, &clientMessage); err != nil {
+		c.Logger.WithError(err).Debug("Ignoring malformed client message")
+		return
+	}
+
+	switch clientMessage.Action {
+	case "resync":
+		c.handleResync(clientMessage.VotingProcessID)
+	default:
+		c.Logger.WithField("action", clientMessage.Action).Debug("Ignoring unknown client action")
+	}
+}
+
+// handleResync sends the current full tally for a voting process to a reconnecting client
+func (c *WebSocketClient) handleResync(votingProcessID string) {
+	logger := c.Logger.WithField("voting_process_id", votingProcessID)
+
+	c.Hub.mutex.RLock()
+	provider := c.Hub.tallyProvider
+	c.Hub.mutex.RUnlock()
+
+	if votingProcessID == "" || provider == nil {
+		c.SendMessage("error", map[string]string{
+			"action": "resync",
+			"error":  "resync requires a votingProcessId",
+		})
+		return
+	}
+
+	tallyData, err := provider(votingProcessID)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to get tally data for resync")
+		c.SendMessage("error", map[string]string{
+			"action":          "resync",
+			"votingProcessId": votingProcessID,
+			"error":           err.Error(),
+		})
+		return
+	}
+
+	update := TallyUpdate{
+		Type:            "tally_update",
+		VotingProcessID: votingProcessID,
+		Data:            tallyData,
+		Timestamp:       time.Now(),
+		Resync:          true,
+	}
+
+	message, err := json.Marshal(update)
+	if err != nil {
+		logger.WithError(err).Error("Failed to marshal resync tally update")
+		return
+	}
+
+	select {
+	case c.Send <- message:
+		logger.Info("Resync tally update sent to client")
+	default:
+		logger.Error("Client send channel is full, dropping resync tally update")
 	}
 }
 
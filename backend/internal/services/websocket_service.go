@@ -18,6 +18,9 @@ type WebSocketService struct {
 // NewWebSocketService creates a new WebSocket service
 func NewWebSocketService(tallyService *TallyService, logger *logrus.Logger) *WebSocketService {
 	hub := NewWebSocketHub(logger)
+	hub.SetTallyProvider(func(votingProcessID string) (interface{}, error) {
+		return tallyService.GetTallyData(votingProcessID)
+	})
 	
 	service := &WebSocketService{
 		hub:          hub,
@@ -61,6 +64,14 @@ func (ws *WebSocketService) BroadcastTallyUpdate(votingProcessID string) error {
 	}
 
 	logger.WithField("client_count", ws.hub.GetClientCount()).Info("Tally update broadcast completed")
+
+	// Follow every tally update with the re-ranked candidates for live leaderboards
+	if leaderboard := BuildLeaderboard(tallyData); leaderboard != nil {
+		if err := ws.BroadcastMessage("leaderboard_update", leaderboard); err != nil {
+			logger.WithError(err).Error("Failed to broadcast leaderboard update")
+		}
+	}
+
 	return nil
 }
 
@@ -259,4 +259,80 @@ func TestRandomString(t *testing.T) {
 	// Strings should have correct length
 	assert.Equal(t, 6, len(str1))
 	assert.Equal(t, 6, len(str2))
-}
\ No newline at end of file
+}
+
+func TestWebSocketService_ResyncRequest(t *testing.T) {
+	logger := logrus.New()
+	logger.SetLevel(logrus.FatalLevel) // Suppress logs during testing
+
+	// Create dependencies with a voting process that already has a verified station
+	storageService := NewStorageService()
+	tallyService := NewTallyService(storageService, logger)
+	wsService := NewWebSocketService(tallyService, logger)
+
+	votingProcess := models.VotingProcess{
+		ID:       "resync-process",
+		Title:    "Resync Election",
+		Position: "President",
+		Candidates: []models.Candidate{
+			{ID: "c1", Name: "Alice"},
+			{ID: "c2", Name: "Bob"},
+		},
+		PollingStations: []string{"RS001", "RS002"},
+		Status:          "Active",
+		CreatedAt:       time.Now(),
+	}
+	require.NoError(t, storageService.StoreVotingProcess(votingProcess))
+	require.NoError(t, storageService.UpdatePollingStationStatus("RS001", "Verified", map[string]int{"Alice": 70, "Bob": 30}, 0.9))
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/ws", wsService.HandleConnection)
+
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/ws"
+
+	conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	if err != nil {
+		t.Skipf("WebSocket connection failed (expected in test environment): %v", err)
+		return
+	}
+	defer conn.Close()
+
+	t.Run("KnownProcess", func(t *testing.T) {
+		require.NoError(t, conn.WriteJSON(ClientMessage{Action: "resync", VotingProcessID: "resync-process"}))
+
+		conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+		_, message, err := conn.ReadMessage()
+		require.NoError(t, err)
+
+		var update struct {
+			Type            string        `json:"type"`
+			VotingProcessID string        `json:"votingProcessId"`
+			Resync          bool          `json:"resync"`
+			Data            TallyResponse `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal(message, &update))
+
+		assert.Equal(t, "tally_update", update.Type)
+		assert.Equal(t, "resync-process", update.VotingProcessID)
+		assert.True(t, update.Resync)
+		assert.Equal(t, 70, update.Data.AggregatedTally["Alice"])
+		assert.Equal(t, 30, update.Data.AggregatedTally["Bob"])
+		assert.Len(t, update.Data.PollingStations, 2)
+	})
+
+	t.Run("UnknownProcess", func(t *testing.T) {
+		require.NoError(t, conn.WriteJSON(ClientMessage{Action: "resync", VotingProcessID: "missing"}))
+
+		conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+		_, message, err := conn.ReadMessage()
+		require.NoError(t, err)
+
+		var response WebSocketMessage
+		require.NoError(t, json.Unmarshal(message, &response))
+		assert.Equal(t, "error", response.Type)
+	})
+}